@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// AnalyzeOptions selects which failure analysis the analyze command runs
+type AnalyzeOptions struct {
+	// CoOccurrence counts tasks that failed in each pair of categories
+	CoOccurrence bool
+	// Format is the output format: "text" (default) or "json"
+	Format string
+}
+
+// CategoryPair is how many tasks failed in both categories
+type CategoryPair struct {
+	First  string `json:"first"`
+	Second string `json:"second"`
+	Tasks  int    `json:"tasks"`
+}
+
+// categoryPairs flattens a symmetric co-occurrence map into unique pairs, most frequent first
+func categoryPairs(counts map[string]map[string]int) []CategoryPair {
+	var pairs []CategoryPair
+	for first, others := range counts {
+		for second, tasks := range others {
+			if first < second {
+				pairs = append(pairs, CategoryPair{First: first, Second: second, Tasks: tasks})
+			}
+		}
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Tasks != pairs[j].Tasks {
+			return pairs[i].Tasks > pairs[j].Tasks
+		}
+		if pairs[i].First != pairs[j].First {
+			return pairs[i].First < pairs[j].First
+		}
+		return pairs[i].Second < pairs[j].Second
+	})
+	return pairs
+}
+
+// runAnalyzeCommandWithConfig runs the selected analyses against the failures database
+func runAnalyzeCommandWithConfig(dbPath string, opts AnalyzeOptions) (string, error) {
+	if !opts.CoOccurrence {
+		return "", fmt.Errorf("no analysis selected (use --co-occurrence)")
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = "text"
+	}
+	if format != "text" && format != "json" {
+		return "", fmt.Errorf("unsupported format: %s (use 'text' or 'json')", format)
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return "", fmt.Errorf("opening database: %w", err)
+	}
+	defer store.Close()
+
+	counts, err := store.CoOccurrences()
+	if err != nil {
+		return "", fmt.Errorf("computing co-occurrences: %w", err)
+	}
+	pairs := categoryPairs(counts)
+
+	if format == "json" {
+		if pairs == nil {
+			pairs = []CategoryPair{}
+		}
+		data, err := json.MarshalIndent(struct {
+			CoOccurrences []CategoryPair `json:"co_occurrences"`
+		}{pairs}, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("encoding co-occurrences: %w", err)
+		}
+		return string(data) + "\n", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Category Co-occurrence\n")
+	sb.WriteString("======================\n\n")
+	if len(pairs) == 0 {
+		sb.WriteString("No tasks have failures in more than one category.\n")
+		return sb.String(), nil
+	}
+	sb.WriteString(fmt.Sprintf("%-24s %-24s %s\n", "Category", "Category", "Tasks"))
+	for _, pair := range pairs {
+		sb.WriteString(fmt.Sprintf("%-24s %-24s %d\n", pair.First, pair.Second, pair.Tasks))
+	}
+	return sb.String(), nil
+}
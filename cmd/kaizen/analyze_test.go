@@ -0,0 +1,95 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// seedCoOccurrenceStore creates a failures database where tasks share categories
+func seedCoOccurrenceStore(t *testing.T) string {
+	t.Helper()
+	dbPath := filepath.Join(t.TempDir(), "failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	records := []struct{ taskID, category string }{
+		{"task-1", "missing-tests"},
+		{"task-1", "scope-creep"},
+		{"task-2", "missing-tests"},
+		{"task-2", "scope-creep"},
+		{"task-2", "regression"},
+		{"task-3", "regression"},
+	}
+	for _, r := range records {
+		f := failures.Failure{TaskID: r.taskID, Category: r.category, Details: "d", Source: "spec-review", CreatedAt: time.Now()}
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+	return dbPath
+}
+
+// TestRunAnalyzeCoOccurrenceText verifies pairs are listed most frequent first
+func TestRunAnalyzeCoOccurrenceText(t *testing.T) {
+	dbPath := seedCoOccurrenceStore(t)
+
+	output, err := runAnalyzeCommandWithConfig(dbPath, AnalyzeOptions{CoOccurrence: true})
+	if err != nil {
+		t.Fatalf("runAnalyzeCommandWithConfig failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("Expected header and 3 pairs, got:\n%s", output)
+	}
+	if fields := strings.Fields(lines[4]); strings.Join(fields, " ") != "missing-tests scope-creep 2" {
+		t.Errorf("Expected most frequent pair first, got %q", lines[4])
+	}
+}
+
+// TestRunAnalyzeCoOccurrenceJSON verifies the JSON output lists each pair once
+func TestRunAnalyzeCoOccurrenceJSON(t *testing.T) {
+	dbPath := seedCoOccurrenceStore(t)
+
+	output, err := runAnalyzeCommandWithConfig(dbPath, AnalyzeOptions{CoOccurrence: true, Format: "json"})
+	if err != nil {
+		t.Fatalf("runAnalyzeCommandWithConfig failed: %v", err)
+	}
+
+	var result struct {
+		CoOccurrences []CategoryPair `json:"co_occurrences"`
+	}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, output)
+	}
+
+	expected := []CategoryPair{
+		{First: "missing-tests", Second: "scope-creep", Tasks: 2},
+		{First: "missing-tests", Second: "regression", Tasks: 1},
+		{First: "regression", Second: "scope-creep", Tasks: 1},
+	}
+	if len(result.CoOccurrences) != len(expected) {
+		t.Fatalf("Expected %d pairs, got %+v", len(expected), result.CoOccurrences)
+	}
+	for i, pair := range expected {
+		if result.CoOccurrences[i] != pair {
+			t.Errorf("Pair %d: expected %+v, got %+v", i, pair, result.CoOccurrences[i])
+		}
+	}
+}
+
+// TestRunAnalyzeRequiresAnalysis verifies an analysis flag must be given
+func TestRunAnalyzeRequiresAnalysis(t *testing.T) {
+	_, err := runAnalyzeCommandWithConfig(filepath.Join(t.TempDir(), "failures.db"), AnalyzeOptions{})
+	if err == nil || !strings.Contains(err.Error(), "--co-occurrence") {
+		t.Errorf("Expected missing analysis error, got %v", err)
+	}
+}
@@ -1,10 +1,14 @@
 package main
 
 import (
+	"bufio"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/srstomp/kaizen/internal/failures"
 )
@@ -14,12 +18,26 @@ type CaptureOutput struct {
 	Success  bool   `json:"success"`
 	TaskID   string `json:"task_id,omitempty"`
 	Category string `json:"category,omitempty"`
+	Details  string `json:"details,omitempty"`
+	Source   string `json:"source,omitempty"`
+	DryRun   bool   `json:"dry_run,omitempty"`
 	Message  string `json:"message,omitempty"`
 	Error    string `json:"error,omitempty"`
 }
 
+// CaptureOptions holds the settings for the capture command
+type CaptureOptions struct {
+	// DryRun validates and prints the record that would be captured without writing to the database
+	DryRun bool
+}
+
 // runCaptureCommand executes the capture CLI command with default config paths
 func runCaptureCommand(taskID, category, details, source string) (string, error) {
+	return runCaptureCommandWithOptions(taskID, category, details, source, CaptureOptions{})
+}
+
+// runCaptureCommandWithOptions executes the capture CLI command with default config paths and the given options
+func runCaptureCommandWithOptions(taskID, category, details, source string, opts CaptureOptions) (string, error) {
 	// Get home directory and build config path
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -29,12 +47,36 @@ func runCaptureCommand(taskID, category, details, source string) (string, error)
 	configDir := filepath.Join(homeDir, ".config", "kaizen")
 	dbPath := filepath.Join(configDir, "failures.db")
 
-	return runCaptureCommandWithConfig(taskID, category, details, source, dbPath)
+	return runCaptureCommandWithConfigOptions(taskID, category, details, source, dbPath, opts)
 }
 
 // runCaptureCommandWithConfig executes the capture command with explicit config paths
 // This is separated for testing purposes
 func runCaptureCommandWithConfig(taskID, category, details, source, dbPath string) (string, error) {
+	return runCaptureCommandWithConfigOptions(taskID, category, details, source, dbPath, CaptureOptions{})
+}
+
+// runCaptureCommandWithConfigOptions executes the capture command with explicit config paths and options.
+// Under DryRun the database is not opened and nothing is inserted or counted.
+func runCaptureCommandWithConfigOptions(taskID, category, details, source, dbPath string, opts CaptureOptions) (string, error) {
+	failure, err := newCaptureFailure(taskID, category, details, source)
+	if err != nil {
+		return buildErrorOutput(err)
+	}
+	taskID, category = failure.TaskID, failure.Category
+
+	if opts.DryRun {
+		return buildCaptureOutput(CaptureOutput{
+			Success:  true,
+			TaskID:   failure.TaskID,
+			Category: failure.Category,
+			Details:  failure.Details,
+			Source:   failure.Source,
+			DryRun:   true,
+			Message:  "Dry run: failure not captured",
+		})
+	}
+
 	// Open the failures store
 	store, err := failures.NewStore(dbPath)
 	if err != nil {
@@ -42,14 +84,6 @@ func runCaptureCommandWithConfig(taskID, category, details, source, dbPath strin
 	}
 	defer store.Close()
 
-	// Create failure record
-	failure := failures.Failure{
-		TaskID:   taskID,
-		Category: category,
-		Details:  details,
-		Source:   source,
-	}
-
 	// Insert failure into database
 	if err := store.Insert(failure); err != nil {
 		return buildErrorOutput(fmt.Errorf("inserting failure: %w", err))
@@ -61,13 +95,40 @@ func runCaptureCommandWithConfig(taskID, category, details, source, dbPath strin
 	}
 
 	// Build success output
-	output := CaptureOutput{
+	return buildCaptureOutput(CaptureOutput{
 		Success:  true,
 		TaskID:   taskID,
 		Category: category,
 		Message:  "Failure captured successfully",
+	})
+}
+
+// newCaptureFailure validates the captured fields and builds the failure record to insert.
+// Only the category is normalized, trimmed and lowercased so "Missing-Tests " and "missing-tests"
+// are counted together; the other fields are stored as given.
+func newCaptureFailure(taskID, category, details, source string) (failures.Failure, error) {
+	failure := failures.Failure{
+		TaskID:   taskID,
+		Category: strings.ToLower(strings.TrimSpace(category)),
+		Details:  details,
+		Source:   source,
+	}
+
+	for _, field := range []struct{ name, value string }{
+		{"task-id", failure.TaskID},
+		{"category", failure.Category},
+		{"details", failure.Details},
+		{"source", failure.Source},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			return failures.Failure{}, fmt.Errorf("--%s must not be empty", field.name)
+		}
 	}
+	return failure, nil
+}
 
+// buildCaptureOutput encodes a successful capture response as indented JSON
+func buildCaptureOutput(output CaptureOutput) (string, error) {
 	jsonBytes, err := json.MarshalIndent(output, "", "  ")
 	if err != nil {
 		return buildErrorOutput(fmt.Errorf("encoding JSON output: %w", err))
@@ -91,3 +152,135 @@ func buildErrorOutput(err error) (string, error) {
 
 	return string(jsonBytes), err
 }
+
+// defaultCaptureSources are the failure sources offered by the interactive capture wizard
+var defaultCaptureSources = []string{"spec-review", "quality-review", "kaizen-fix"}
+
+// buildCaptureMenu returns the menu options: known values first, then defaults not already
+// known, then extra configured values. Blank and duplicate values are dropped.
+func buildCaptureMenu(known, defaults, extra []string) []string {
+	var options []string
+	seen := make(map[string]bool)
+	for _, list := range [][]string{known, defaults, extra} {
+		for _, value := range list {
+			value = strings.TrimSpace(value)
+			if value == "" || seen[value] {
+				continue
+			}
+			seen[value] = true
+			options = append(options, value)
+		}
+	}
+	return options
+}
+
+// promptLine prints a prompt and reads one trimmed line of input
+func promptLine(reader *bufio.Reader, out io.Writer, prompt string) (string, error) {
+	fmt.Fprint(out, prompt)
+	line, err := reader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", fmt.Errorf("reading input: %w", err)
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// promptRequired prompts until a non-empty value is entered
+func promptRequired(reader *bufio.Reader, out io.Writer, prompt string) (string, error) {
+	for {
+		value, err := promptLine(reader, out, prompt)
+		if err != nil {
+			return "", err
+		}
+		if value != "" {
+			return value, nil
+		}
+		fmt.Fprintln(out, "A value is required.")
+	}
+}
+
+// promptChoice shows a numbered menu and returns the chosen option, or the free text typed instead
+func promptChoice(reader *bufio.Reader, out io.Writer, label string, options []string) (string, error) {
+	fmt.Fprintf(out, "\n%s:\n", label)
+	for i, option := range options {
+		fmt.Fprintf(out, "  %d) %s\n", i+1, option)
+	}
+	for {
+		value, err := promptRequired(reader, out, fmt.Sprintf("Choose 1-%d or type a new %s: ", len(options), strings.ToLower(label)))
+		if err != nil {
+			return "", err
+		}
+		n, convErr := strconv.Atoi(value)
+		if convErr != nil {
+			return value, nil
+		}
+		if n >= 1 && n <= len(options) {
+			return options[n-1], nil
+		}
+		fmt.Fprintf(out, "Invalid choice: %d\n", n)
+	}
+}
+
+// loadCaptureMenus builds the category and source menus from previously captured failures,
+// the built-in defaults and the configured extras
+func loadCaptureMenus(dbPath string, config CaptureConfig) ([]string, []string, error) {
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening database: %w", err)
+	}
+	defer store.Close()
+
+	knownCategories, err := store.ListCategories()
+	if err != nil {
+		return nil, nil, err
+	}
+	knownSources, err := store.ListSources()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	categories := buildCaptureMenu(knownCategories, validFailureCategories, config.Categories)
+	sources := buildCaptureMenu(knownSources, defaultCaptureSources, config.Sources)
+	return categories, sources, nil
+}
+
+// runInteractiveCapture prompts for a failure record, confirms it, and captures it into the database
+func runInteractiveCapture(in io.Reader, out io.Writer, dbPath string, config CaptureConfig) (string, error) {
+	return runInteractiveCaptureWithOptions(in, out, dbPath, config, CaptureOptions{})
+}
+
+// runInteractiveCaptureWithOptions runs the interactive capture wizard with the given options
+func runInteractiveCaptureWithOptions(in io.Reader, out io.Writer, dbPath string, config CaptureConfig, opts CaptureOptions) (string, error) {
+	categories, sources, err := loadCaptureMenus(dbPath, config)
+	if err != nil {
+		return buildErrorOutput(err)
+	}
+
+	reader := bufio.NewReader(in)
+	taskID, err := promptRequired(reader, out, "Task ID: ")
+	if err != nil {
+		return buildErrorOutput(err)
+	}
+	category, err := promptChoice(reader, out, "Category", categories)
+	if err != nil {
+		return buildErrorOutput(err)
+	}
+	source, err := promptChoice(reader, out, "Source", sources)
+	if err != nil {
+		return buildErrorOutput(err)
+	}
+	details, err := promptRequired(reader, out, "\nDetails: ")
+	if err != nil {
+		return buildErrorOutput(err)
+	}
+
+	fmt.Fprintf(out, "\nTask: %s\nCategory: %s\nSource: %s\nDetails: %s\n", taskID, category, source, details)
+	confirm, err := promptLine(reader, out, "Capture this failure? [y/N]: ")
+	if err != nil {
+		return buildErrorOutput(err)
+	}
+	if answer := strings.ToLower(confirm); answer != "y" && answer != "yes" {
+		return buildErrorOutput(fmt.Errorf("capture cancelled by user"))
+	}
+
+	return runCaptureCommandWithConfigOptions(taskID, category, details, source, dbPath, opts)
+}
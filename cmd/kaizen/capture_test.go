@@ -41,6 +41,16 @@ func TestCaptureCommand(t *testing.T) {
 			wantCat:    "scope-creep",
 			wantErr:    false,
 		},
+		{
+			name:       "category normalized, other fields kept as given",
+			taskID:     "TASK-789",
+			category:   " Missing-Tests ",
+			details:    "No tests for the parser\n",
+			source:     "spec-review",
+			wantTaskID: "TASK-789",
+			wantCat:    "missing-tests",
+			wantErr:    false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -96,7 +106,7 @@ func TestCaptureCommand(t *testing.T) {
 			}
 			defer store.Close()
 
-			failures, err := store.GetByCategory(tt.category)
+			failures, err := store.GetByCategory(tt.wantCat)
 			if err != nil {
 				t.Fatalf("Failed to get failures: %v", err)
 			}
@@ -109,8 +119,8 @@ func TestCaptureCommand(t *testing.T) {
 			if f.TaskID != tt.taskID {
 				t.Errorf("Failure.TaskID = %q, want %q", f.TaskID, tt.taskID)
 			}
-			if f.Category != tt.category {
-				t.Errorf("Failure.Category = %q, want %q", f.Category, tt.category)
+			if f.Category != tt.wantCat {
+				t.Errorf("Failure.Category = %q, want %q", f.Category, tt.wantCat)
 			}
 			if f.Details != tt.details {
 				t.Errorf("Failure.Details = %q, want %q", f.Details, tt.details)
@@ -120,7 +130,7 @@ func TestCaptureCommand(t *testing.T) {
 			}
 
 			// Verify category count was incremented
-			count, err := store.GetOccurrenceCount(tt.category)
+			count, err := store.GetOccurrenceCount(tt.wantCat)
 			if err != nil {
 				t.Fatalf("Failed to get occurrence count: %v", err)
 			}
@@ -150,6 +160,15 @@ func TestCaptureCommandError(t *testing.T) {
 			dbPath:   "/nonexistent/path/failures.db",
 			wantErr:  "opening database",
 		},
+		{
+			name:     "blank details",
+			taskID:   "TASK-999",
+			category: "test-category",
+			details:  "   ",
+			source:   "test-source",
+			dbPath:   "/nonexistent/path/failures.db",
+			wantErr:  "--details must not be empty",
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,6 +199,59 @@ func TestCaptureCommandError(t *testing.T) {
 	}
 }
 
+// TestCaptureCommandDryRun verifies dry-run prints the normalized record without touching the database
+func TestCaptureCommandDryRun(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test-failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create test store: %v", err)
+	}
+	store.Close()
+
+	output, err := runCaptureCommandWithConfigOptions("TASK-123", " Missing-Tests ", "No tests for the parser", "spec-review", dbPath, CaptureOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("runCaptureCommandWithConfigOptions failed: %v\nOutput: %s", err, output)
+	}
+
+	var result CaptureOutput
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, output)
+	}
+	want := CaptureOutput{
+		Success:  true,
+		TaskID:   "TASK-123",
+		Category: "missing-tests",
+		Details:  "No tests for the parser",
+		Source:   "spec-review",
+		DryRun:   true,
+		Message:  "Dry run: failure not captured",
+	}
+	if result != want {
+		t.Errorf("Output = %+v, want %+v", result, want)
+	}
+
+	store, err = failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to reopen store: %v", err)
+	}
+	defer store.Close()
+
+	all, err := store.GetAll()
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if len(all) != 0 {
+		t.Errorf("Expected no failures after dry run, got %d", len(all))
+	}
+	count, err := store.GetOccurrenceCount("missing-tests")
+	if err != nil {
+		t.Fatalf("GetOccurrenceCount failed: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected occurrence count 0 after dry run, got %d", count)
+	}
+}
+
 func TestCaptureCommandMultipleCaptures(t *testing.T) {
 	// Create a temporary database for testing
 	tmpDir := t.TempDir()
@@ -304,3 +376,78 @@ func TestCaptureCommandIntegration(t *testing.T) {
 		t.Errorf("Category = %q, want %q", result.Category, "missing-tests")
 	}
 }
+
+func TestBuildCaptureMenu(t *testing.T) {
+	known := []string{"scope-creep", "custom-category", "missing-tests"}
+	defaults := []string{"missed-tasks", "missing-tests", "scope-creep"}
+	extra := []string{"team-specific", " ", "missed-tasks"}
+
+	got := buildCaptureMenu(known, defaults, extra)
+	want := []string{"scope-creep", "custom-category", "missing-tests", "missed-tasks", "team-specific"}
+
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected menu %v, got %v", want, got)
+	}
+}
+
+func TestRunInteractiveCapture(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "failures.db")
+
+	// Seed a previously captured category so it leads the menu
+	if _, err := runCaptureCommandWithConfig("TASK-1", "custom-category", "earlier failure", "ci", dbPath); err != nil {
+		t.Fatalf("Failed to seed capture: %v", err)
+	}
+
+	// Task ID, category choice 1 (custom-category), free-text source, details, confirm
+	input := strings.NewReader("TASK-2\n1\nnightly-run\nTests were skipped\ny\n")
+	var out strings.Builder
+
+	output, err := runInteractiveCapture(input, &out, dbPath, CaptureConfig{})
+	if err != nil {
+		t.Fatalf("runInteractiveCapture failed: %v\n%s", err, output)
+	}
+
+	if !strings.Contains(out.String(), "1) custom-category") || !strings.Contains(out.String(), "1) ci") {
+		t.Errorf("Expected known values first in menus, got:\n%s", out.String())
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	defer store.Close()
+
+	captured, err := store.GetByCategory("custom-category")
+	if err != nil {
+		t.Fatalf("GetByCategory failed: %v", err)
+	}
+	if len(captured) != 2 || captured[0].TaskID != "TASK-2" || captured[0].Source != "nightly-run" {
+		t.Errorf("Expected TASK-2 captured with source nightly-run, got %+v", captured)
+	}
+}
+
+func TestRunInteractiveCaptureCancelled(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "failures.db")
+
+	input := strings.NewReader("TASK-3\n2\n1\nSome details\nn\n")
+	var out strings.Builder
+
+	if _, err := runInteractiveCapture(input, &out, dbPath, CaptureConfig{}); err == nil || !strings.Contains(err.Error(), "cancelled") {
+		t.Fatalf("Expected cancellation error, got %v", err)
+	}
+
+	count, err := func() (int, error) {
+		store, err := failures.NewStore(dbPath)
+		if err != nil {
+			return 0, err
+		}
+		defer store.Close()
+		return store.GetOccurrenceCount(validFailureCategories[1])
+	}()
+	if err != nil {
+		t.Fatalf("Failed to read occurrence count: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("Expected nothing captured after cancelling, got count %d", count)
+	}
+}
@@ -0,0 +1,309 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Skill comparison statuses
+const (
+	skillStatusAdded       = "added"
+	skillStatusRemoved     = "removed"
+	skillStatusNewlyFailed = "newly_failed"
+	skillStatusNewlyPassed = "newly_passed"
+	skillStatusPassing     = "passing"
+	skillStatusFailing     = "failing"
+)
+
+// CompareOptions holds the settings for the compare command
+type CompareOptions struct {
+	// From and To are report paths or report dates (YYYY-MM-DD)
+	From string
+	To   string
+	// Format is the output format: markdown or json
+	Format string
+	// ReportsDir is where report dates are looked up
+	ReportsDir string
+	// FilenamePattern maps a report date to a filename (default: skill-clarity-{date}.md)
+	FilenamePattern string
+}
+
+// SkillComparison is the change in one skill between two reports.
+// Scores are nil on the side where the skill is missing.
+type SkillComparison struct {
+	Name      string               `json:"name"`
+	Status    string               `json:"status"`
+	FromScore *float64             `json:"from_score,omitempty"`
+	ToScore   *float64             `json:"to_score,omitempty"`
+	Score     *TrendData           `json:"score,omitempty"`
+	Criteria  map[string]TrendData `json:"criteria,omitempty"`
+}
+
+// GradeReportComparison is the difference between two skill-clarity reports
+type GradeReportComparison struct {
+	From         string               `json:"from"`
+	To           string               `json:"to"`
+	TotalSkills  TrendData            `json:"total_skills"`
+	AverageScore TrendData            `json:"average_score"`
+	PassRate     TrendData            `json:"pass_rate"`
+	Criteria     map[string]TrendData `json:"criteria"`
+	NewlyFailed  []string             `json:"newly_failed"`
+	NewlyPassed  []string             `json:"newly_passed"`
+	Added        []string             `json:"added"`
+	Removed      []string             `json:"removed"`
+	Skills       []SkillComparison    `json:"skills"`
+}
+
+// runCompareCommand compares two skill-clarity reports and returns the formatted diff
+func runCompareCommand(opts CompareOptions) (string, error) {
+	format := opts.Format
+	if format == "" {
+		format = "markdown"
+	}
+	if format != "markdown" && format != "json" {
+		return "", fmt.Errorf("unsupported format: %s (use 'markdown' or 'json')", format)
+	}
+	if opts.From == "" || opts.To == "" {
+		return "", fmt.Errorf("both --from and --to are required")
+	}
+	pattern := opts.FilenamePattern
+	if pattern == "" {
+		pattern = defaultGradeReportPattern
+	}
+
+	fromPath, err := resolveGradeReportRef(opts.From, opts.ReportsDir, pattern)
+	if err != nil {
+		return "", fmt.Errorf("resolving --from: %w", err)
+	}
+	toPath, err := resolveGradeReportRef(opts.To, opts.ReportsDir, pattern)
+	if err != nil {
+		return "", fmt.Errorf("resolving --to: %w", err)
+	}
+
+	from, err := parseGradeReport(fromPath)
+	if err != nil {
+		return "", fmt.Errorf("parsing %s: %w", fromPath, err)
+	}
+	to, err := parseGradeReport(toPath)
+	if err != nil {
+		return "", fmt.Errorf("parsing %s: %w", toPath, err)
+	}
+
+	comparison := compareGradeReports(from, to)
+	if format == "json" {
+		data, err := json.MarshalIndent(comparison, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("marshaling comparison: %w", err)
+		}
+		return string(data) + "\n", nil
+	}
+	return formatComparisonMarkdown(comparison), nil
+}
+
+// resolveGradeReportRef returns ref if it is an existing file, otherwise treats it as a
+// report date (YYYY-MM-DD) and returns the matching report in reportsDir
+func resolveGradeReportRef(ref, reportsDir, pattern string) (string, error) {
+	if info, err := os.Stat(ref); err == nil && !info.IsDir() {
+		return ref, nil
+	}
+
+	date, err := time.Parse("2006-01-02", ref)
+	if err != nil {
+		return "", fmt.Errorf("report not found: %s (use a report path or a YYYY-MM-DD date)", ref)
+	}
+	filename, err := gradeReportFilename(pattern, date)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(reportsDir, filename)
+	if _, err := os.Stat(path); err != nil {
+		return "", fmt.Errorf("no grade report for %s in %s", ref, reportsDir)
+	}
+	return path, nil
+}
+
+// compareGradeReports computes aggregate, per-criteria and per-skill deltas from one report to
+// another. Skills present in only one report are marked added or removed rather than scored as zero.
+func compareGradeReports(from, to GradeReport) GradeReportComparison {
+	comparison := GradeReportComparison{
+		From:         from.FilePath,
+		To:           to.FilePath,
+		TotalSkills:  calculateDelta(float64(from.TotalSkills), float64(to.TotalSkills)),
+		AverageScore: calculateDelta(from.AverageScore, to.AverageScore),
+		PassRate:     calculateDelta(from.PassRate, to.PassRate),
+		Criteria:     make(map[string]TrendData),
+		NewlyFailed:  []string{},
+		NewlyPassed:  []string{},
+		Added:        []string{},
+		Removed:      []string{},
+		Skills:       []SkillComparison{},
+	}
+
+	fromCriteria := make(map[string]float64)
+	for _, criteria := range from.CriteriaScores {
+		fromCriteria[criteria.Name] = criteria.Average
+	}
+	for _, criteria := range to.CriteriaScores {
+		if previous, exists := fromCriteria[criteria.Name]; exists {
+			comparison.Criteria[criteria.Name] = calculateDelta(previous, criteria.Average)
+		}
+	}
+
+	fromSkills := make(map[string]GradeReportSkill)
+	for _, skill := range from.Skills {
+		fromSkills[skill.Name] = skill
+	}
+	toSkills := make(map[string]GradeReportSkill)
+	for _, skill := range to.Skills {
+		toSkills[skill.Name] = skill
+	}
+
+	for _, skill := range to.Skills {
+		toScore := skill.Score
+		previous, exists := fromSkills[skill.Name]
+		if !exists {
+			comparison.Added = append(comparison.Added, skill.Name)
+			comparison.Skills = append(comparison.Skills, SkillComparison{Name: skill.Name, Status: skillStatusAdded, ToScore: &toScore})
+			continue
+		}
+
+		fromScore := previous.Score
+		delta := calculateDelta(previous.Score, skill.Score)
+		entry := SkillComparison{
+			Name:      skill.Name,
+			FromScore: &fromScore,
+			ToScore:   &toScore,
+			Score:     &delta,
+			Criteria:  make(map[string]TrendData),
+		}
+		for name, score := range skill.Criteria {
+			if previousScore, ok := previous.Criteria[name]; ok {
+				entry.Criteria[name] = calculateDelta(previousScore, score)
+			}
+		}
+
+		switch {
+		case previous.Passed && !skill.Passed:
+			entry.Status = skillStatusNewlyFailed
+			comparison.NewlyFailed = append(comparison.NewlyFailed, skill.Name)
+		case !previous.Passed && skill.Passed:
+			entry.Status = skillStatusNewlyPassed
+			comparison.NewlyPassed = append(comparison.NewlyPassed, skill.Name)
+		case skill.Passed:
+			entry.Status = skillStatusPassing
+		default:
+			entry.Status = skillStatusFailing
+		}
+		comparison.Skills = append(comparison.Skills, entry)
+	}
+
+	for _, skill := range from.Skills {
+		if _, exists := toSkills[skill.Name]; !exists {
+			fromScore := skill.Score
+			comparison.Removed = append(comparison.Removed, skill.Name)
+			comparison.Skills = append(comparison.Skills, SkillComparison{Name: skill.Name, Status: skillStatusRemoved, FromScore: &fromScore})
+		}
+	}
+
+	sort.SliceStable(comparison.Skills, func(i, j int) bool {
+		return comparison.Skills[i].Name < comparison.Skills[j].Name
+	})
+
+	return comparison
+}
+
+// formatComparisonMarkdown formats a report comparison as markdown
+func formatComparisonMarkdown(comparison GradeReportComparison) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Skill Clarity Comparison\n\n")
+	sb.WriteString(fmt.Sprintf("**From**: %s\n", filepath.Base(comparison.From)))
+	sb.WriteString(fmt.Sprintf("**To**: %s\n\n", filepath.Base(comparison.To)))
+
+	sb.WriteString("## Summary\n\n")
+	sb.WriteString("| Metric | Previous | Current | Change | Status |\n")
+	sb.WriteString("|--------|----------|---------|--------|--------|\n")
+	sb.WriteString(formatTrendMarkdown("Average Score", comparison.AverageScore, false) + "\n")
+	sb.WriteString(formatTrendMarkdown("Pass Rate", comparison.PassRate, false) + "\n")
+	sb.WriteString(formatTrendMarkdown("Total Skills", comparison.TotalSkills, false) + "\n")
+
+	if len(comparison.Criteria) > 0 {
+		sb.WriteString("\n### Per-Criteria Changes\n\n")
+		sb.WriteString("| Criteria | Previous | Current | Change | Status |\n")
+		sb.WriteString("|----------|----------|---------|--------|--------|\n")
+		for _, name := range sortedTrendNames(comparison.Criteria) {
+			sb.WriteString(formatTrendMarkdown(name, comparison.Criteria[name], false) + "\n")
+		}
+	}
+
+	statusLists := []struct {
+		title string
+		names []string
+	}{
+		{"Newly Failed", comparison.NewlyFailed},
+		{"Newly Passed", comparison.NewlyPassed},
+		{"Added", comparison.Added},
+		{"Removed", comparison.Removed},
+	}
+	for _, list := range statusLists {
+		if len(list.names) == 0 {
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("\n## %s (%d)\n\n", list.title, len(list.names)))
+		for _, name := range list.names {
+			sb.WriteString(fmt.Sprintf("- %s\n", name))
+		}
+	}
+
+	sb.WriteString("\n## Per-Skill Changes\n\n")
+	sb.WriteString("| Skill | Previous | Current | Change | Status |\n")
+	sb.WriteString("|-------|----------|---------|--------|--------|\n")
+	for _, skill := range comparison.Skills {
+		previous, current, change := "-", "-", "-"
+		if skill.FromScore != nil {
+			previous = fmt.Sprintf("%.1f", *skill.FromScore)
+		}
+		if skill.ToScore != nil {
+			current = fmt.Sprintf("%.1f", *skill.ToScore)
+		}
+		if skill.Score != nil {
+			change = fmt.Sprintf("%+.1f %s", skill.Score.AbsoluteDelta, trendIndicator(skill.Score.Direction))
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s | %s | %s |\n", skill.Name, previous, current, change, strings.ReplaceAll(skill.Status, "_", " ")))
+	}
+
+	var criteriaRows []string
+	for _, skill := range comparison.Skills {
+		for _, name := range sortedTrendNames(skill.Criteria) {
+			trend := skill.Criteria[name]
+			if trend.Direction == "stable" {
+				continue
+			}
+			criteriaRows = append(criteriaRows, fmt.Sprintf("| %s | %s | %.1f | %.1f | %+.1f %s |",
+				skill.Name, name, trend.PreviousValue, trend.CurrentValue, trend.AbsoluteDelta, trendIndicator(trend.Direction)))
+		}
+	}
+	if len(criteriaRows) > 0 {
+		sb.WriteString("\n## Criteria Changes by Skill\n\n")
+		sb.WriteString("| Skill | Criteria | Previous | Current | Change |\n")
+		sb.WriteString("|-------|----------|----------|---------|--------|\n")
+		sb.WriteString(strings.Join(criteriaRows, "\n") + "\n")
+	}
+
+	return sb.String()
+}
+
+// sortedTrendNames returns the keys of trends in alphabetical order
+func sortedTrendNames(trends map[string]TrendData) []string {
+	names := make([]string, 0, len(trends))
+	for name := range trends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeCompareReport writes a markdown skill-clarity report for skill name -> score, where each
+// skill's clear_instructions criterion scores the same as the skill
+func writeCompareReport(t *testing.T, path string, scores map[string]float64) {
+	t.Helper()
+
+	results := make([]skillResult, 0, len(scores))
+	for name, score := range scores {
+		results = append(results, skillResult{
+			Name:    name,
+			Score:   score,
+			Passed:  score >= 70,
+			Message: "graded",
+			Details: map[string]any{
+				"clear_instructions": map[string]any{"score": score, "feedback": "ok", "weight": 0.30},
+			},
+		})
+	}
+	if err := generateReport(results, path, SkillReportOptions{}); err != nil {
+		t.Fatalf("generateReport failed: %v", err)
+	}
+}
+
+// seedCompareReports writes two dated reports to a temp reports directory:
+// alpha improves, beta newly fails, gamma newly passes, delta is removed and epsilon added
+func seedCompareReports(t *testing.T) string {
+	t.Helper()
+
+	reportsDir := t.TempDir()
+	writeCompareReport(t, filepath.Join(reportsDir, "skill-clarity-2026-01-01.md"), map[string]float64{
+		"alpha": 85, "beta": 75, "gamma": 60, "delta": 80,
+	})
+	writeCompareReport(t, filepath.Join(reportsDir, "skill-clarity-2026-02-01.md"), map[string]float64{
+		"alpha": 88, "beta": 65, "gamma": 72, "epsilon": 90,
+	})
+	return reportsDir
+}
+
+func TestParseGradeReportSkills(t *testing.T) {
+	reportsDir := seedCompareReports(t)
+
+	report, err := parseGradeReport(filepath.Join(reportsDir, "skill-clarity-2026-01-01.md"))
+	if err != nil {
+		t.Fatalf("parseGradeReport failed: %v", err)
+	}
+	if len(report.Skills) != 4 {
+		t.Fatalf("Expected 4 skills, got %+v", report.Skills)
+	}
+
+	// Skills are in ranked order
+	alpha := report.Skills[0]
+	if alpha.Name != "alpha" || alpha.Score != 85 || !alpha.Passed {
+		t.Errorf("Expected alpha 85 passing first, got %+v", alpha)
+	}
+	if alpha.Criteria["Clear Instructions"] != 85 {
+		t.Errorf("Expected alpha Clear Instructions 85, got %v", alpha.Criteria)
+	}
+	if gamma := report.Skills[3]; gamma.Name != "gamma" || gamma.Passed {
+		t.Errorf("Expected gamma failing last, got %+v", gamma)
+	}
+}
+
+func TestRunCompareCommandJSON(t *testing.T) {
+	reportsDir := seedCompareReports(t)
+
+	// Mix a report path and a report date
+	output, err := runCompareCommand(CompareOptions{
+		From:       filepath.Join(reportsDir, "skill-clarity-2026-01-01.md"),
+		To:         "2026-02-01",
+		Format:     "json",
+		ReportsDir: reportsDir,
+	})
+	if err != nil {
+		t.Fatalf("runCompareCommand failed: %v", err)
+	}
+
+	var comparison GradeReportComparison
+	if err := json.Unmarshal([]byte(output), &comparison); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, output)
+	}
+
+	lists := map[string][]string{
+		"newly_failed": comparison.NewlyFailed,
+		"newly_passed": comparison.NewlyPassed,
+		"added":        comparison.Added,
+		"removed":      comparison.Removed,
+	}
+	want := map[string]string{"newly_failed": "beta", "newly_passed": "gamma", "added": "epsilon", "removed": "delta"}
+	for list, name := range want {
+		if got := lists[list]; len(got) != 1 || got[0] != name {
+			t.Errorf("Expected %s [%s], got %v", list, name, got)
+		}
+	}
+
+	skills := make(map[string]SkillComparison)
+	for _, skill := range comparison.Skills {
+		skills[skill.Name] = skill
+	}
+	if len(skills) != 5 {
+		t.Fatalf("Expected 5 skills, got %+v", comparison.Skills)
+	}
+
+	alpha := skills["alpha"]
+	if alpha.Status != "passing" || alpha.Score == nil || alpha.Score.AbsoluteDelta != 3 {
+		t.Errorf("Expected alpha passing with +3 delta, got %+v", alpha)
+	}
+	if criterion := alpha.Criteria["Clear Instructions"]; criterion.AbsoluteDelta != 3 {
+		t.Errorf("Expected alpha Clear Instructions +3, got %+v", alpha.Criteria)
+	}
+
+	// Added and removed skills have no score on the missing side rather than zero
+	added := skills["epsilon"]
+	if added.Status != "added" || added.FromScore != nil || added.Score != nil || added.ToScore == nil || *added.ToScore != 90 {
+		t.Errorf("Expected epsilon added with only a to score, got %+v", added)
+	}
+	removed := skills["delta"]
+	if removed.Status != "removed" || removed.ToScore != nil || removed.FromScore == nil || *removed.FromScore != 80 {
+		t.Errorf("Expected delta removed with only a from score, got %+v", removed)
+	}
+	if !strings.Contains(output, `"status": "removed"`) || strings.Contains(output, `"to_score": 0`) {
+		t.Errorf("Expected removed skill without a zero to_score, got:\n%s", output)
+	}
+
+	if comparison.TotalSkills.PreviousValue != 4 || comparison.TotalSkills.CurrentValue != 4 {
+		t.Errorf("Expected 4 skills in both reports, got %+v", comparison.TotalSkills)
+	}
+	if _, ok := comparison.Criteria["Clear Instructions"]; !ok {
+		t.Errorf("Expected aggregate Clear Instructions delta, got %v", comparison.Criteria)
+	}
+}
+
+func TestRunCompareCommandMarkdown(t *testing.T) {
+	reportsDir := seedCompareReports(t)
+
+	output, err := runCompareCommand(CompareOptions{From: "2026-01-01", To: "2026-02-01", ReportsDir: reportsDir})
+	if err != nil {
+		t.Fatalf("runCompareCommand failed: %v", err)
+	}
+
+	for _, want := range []string{
+		"# Skill Clarity Comparison",
+		"**From**: skill-clarity-2026-01-01.md",
+		"## Newly Failed (1)\n\n- beta",
+		"## Newly Passed (1)\n\n- gamma",
+		"## Added (1)\n\n- epsilon",
+		"## Removed (1)\n\n- delta",
+		"| alpha | 85.0 | 88.0 | +3.0 ↑ | passing |",
+		"| beta | 75.0 | 65.0 | -10.0 ↓ | newly failed |",
+		"| delta | 80.0 | - | - | removed |",
+		"| epsilon | - | 90.0 | - | added |",
+		"| beta | Clear Instructions | 75.0 | 65.0 | -10.0 ↓ |",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestRunCompareCommandErrors(t *testing.T) {
+	reportsDir := seedCompareReports(t)
+
+	tests := []struct {
+		name    string
+		opts    CompareOptions
+		wantErr string
+	}{
+		{"invalid format", CompareOptions{From: "2026-01-01", To: "2026-02-01", Format: "xml", ReportsDir: reportsDir}, "unsupported format"},
+		{"missing to", CompareOptions{From: "2026-01-01", ReportsDir: reportsDir}, "--from and --to are required"},
+		{"unknown date", CompareOptions{From: "2026-03-01", To: "2026-02-01", ReportsDir: reportsDir}, "no grade report for 2026-03-01"},
+		{"unknown path", CompareOptions{From: "2026-01-01", To: "missing.md", ReportsDir: reportsDir}, "report not found: missing.md"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runCompareCommand(tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
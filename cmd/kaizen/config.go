@@ -0,0 +1,171 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/srstomp/kaizen/internal/llm"
+	"gopkg.in/yaml.v3"
+)
+
+// KaizenConfig represents the structure of ~/.config/kaizen/config.yaml
+type KaizenConfig struct {
+	TemplatesDir string    `yaml:"templates_dir"`
+	LLM          LLMConfig `yaml:"llm"`
+	// GraderPipelines maps a task type to the code graders grade-task runs for it.
+	// Task types without an entry use the default pipeline.
+	GraderPipelines map[string][]string `yaml:"grader_pipelines"`
+	// Graders configures how individual code graders report their findings
+	Graders     GradersConfig     `yaml:"graders"`
+	Capture     CaptureConfig     `yaml:"capture"`
+	TaskQuality TaskQualityConfig `yaml:"task_quality"`
+	// AgentRunner is the command meta uses to run an agent, e.g. "mycli run {agent}".
+	// The prompt is piped via stdin; empty uses the claude CLI.
+	AgentRunner string            `yaml:"agent_runner"`
+	GradeSkills GradeSkillsConfig `yaml:"grade_skills"`
+	Meta        MetaConfig        `yaml:"meta"`
+}
+
+// MetaConfig configures the meta command's cost estimate; the --cost-per-call and --max-cost flags override it
+type MetaConfig struct {
+	// CostPerCall is the assumed dollar cost of one agent run (default: 0.015)
+	CostPerCall float64 `yaml:"cost_per_call"`
+	// MaxCost aborts a meta run whose worst-case estimate exceeds it (0 disables)
+	MaxCost float64 `yaml:"max_cost"`
+}
+
+// GradersConfig configures individual code graders run by grade-task
+type GradersConfig struct {
+	DebugStatements DebugStatementsConfig `yaml:"debug_statements"`
+	FlakyTests      FlakyTestsConfig      `yaml:"flaky_tests"`
+	TestCoverage    TestCoverageConfig    `yaml:"test_coverage"`
+}
+
+// DebugStatementsConfig configures the debug-statements grader
+type DebugStatementsConfig struct {
+	// WarnOnly passes the grader and reports debug statements as a warning instead of failing
+	WarnOnly bool `yaml:"warn_only"`
+}
+
+// FlakyTestsConfig configures the flaky-tests grader
+type FlakyTestsConfig struct {
+	// FailOnFindings fails the grader on non-deterministic test patterns instead of warning
+	FailOnFindings bool `yaml:"fail_on_findings"`
+}
+
+// TestCoverageConfig configures the test-coverage grader
+type TestCoverageConfig struct {
+	// Threshold is the minimum coverage percentage of the changed packages; 0 keeps the default of 80
+	Threshold float64 `yaml:"threshold"`
+}
+
+// GradeSkillsConfig configures the grade-skills report
+type GradeSkillsConfig struct {
+	// ReportNote replaces the report header note; --report-note overrides it
+	ReportNote string `yaml:"report_note"`
+	// Labels replace the report's "Needs Improvement" and "**FAILED**" statuses
+	Labels SkillReportLabels `yaml:"labels"`
+}
+
+// TaskQualityConfig tunes the task-quality grader's heuristics
+type TaskQualityConfig struct {
+	// VagueScopeKeywords replaces the default vague-scope words when set
+	VagueScopeKeywords []string `yaml:"vague_scope_keywords"`
+	// AcceptanceMarkers replaces the default acceptance-section headings when set
+	AcceptanceMarkers []string `yaml:"acceptance_markers"`
+}
+
+// CaptureConfig adds options to the interactive capture menus
+type CaptureConfig struct {
+	Categories []string `yaml:"categories"`
+	Sources    []string `yaml:"sources"`
+}
+
+// LLMConfig configures LLM-backed grading.
+// The API key is referenced by environment variable name and never stored in the config.
+type LLMConfig struct {
+	APIKeyEnv string `yaml:"api_key_env"`
+	// Provider selects the client: "anthropic" (default) or "fake" for offline runs.
+	// The KAIZEN_LLM_PROVIDER environment variable overrides it.
+	Provider string `yaml:"provider"`
+	// FakeResponses are canned responses for the fake provider, tried in order before the built-in defaults
+	FakeResponses []llm.FakeResponse `yaml:"fake_responses"`
+}
+
+// llmProviderEnv overrides LLMConfig.Provider when set
+const llmProviderEnv = "KAIZEN_LLM_PROVIDER"
+
+// defaultConfigPath returns the path of the global kaizen config file
+func defaultConfigPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".config", "kaizen", "config.yaml"), nil
+}
+
+// loadConfig loads the kaizen config from the given path.
+// A missing config file is not an error; defaults are returned instead.
+func loadConfig(configPath string) (*KaizenConfig, error) {
+	config := &KaizenConfig{}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return config, nil
+		}
+		return nil, fmt.Errorf("reading config: %w", err)
+	}
+
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("parsing config: %w", err)
+	}
+
+	return config, nil
+}
+
+// errNoAPIKey means the default API key variable is unset, so commands fall back to heuristic grading
+var errNoAPIKey = errors.New("no LLM API key configured")
+
+// newLLMClient constructs an LLM client, resolving the API key from the
+// environment variable named in the config (ANTHROPIC_API_KEY by default).
+// The key value is never included in errors or logs. Only a missing default key
+// (ANTHROPIC_API_KEY, whether left implicit or named as kaizen init writes it) returns
+// errNoAPIKey; any other variable named in api_key_env must be set.
+// The fake provider needs no key and answers from the configured canned responses.
+func newLLMClient(config LLMConfig) (llm.Client, error) {
+	provider := config.Provider
+	if env := os.Getenv(llmProviderEnv); env != "" {
+		provider = env
+	}
+
+	switch provider {
+	case "", "anthropic":
+	case "fake":
+		responses := append(append([]llm.FakeResponse{}, config.FakeResponses...), llm.DefaultFakeResponses()...)
+		client, err := llm.NewFakeClient(responses)
+		if err != nil {
+			return nil, fmt.Errorf("creating fake LLM client: %w", err)
+		}
+		return client, nil
+	default:
+		return nil, fmt.Errorf("unknown LLM provider %q (expected anthropic or fake)", provider)
+	}
+
+	var opts []llm.ClientOption
+	if config.APIKeyEnv != "" {
+		opts = append(opts, llm.WithAPIKeyEnv(config.APIKeyEnv))
+	}
+
+	client, err := llm.NewClient(opts...)
+	if err != nil {
+		if config.APIKeyEnv == "" || config.APIKeyEnv == llm.DefaultAPIKeyEnv {
+			return nil, fmt.Errorf("%w: %v", errNoAPIKey, err)
+		}
+		return nil, fmt.Errorf("creating LLM client from api_key_env: %w", err)
+	}
+
+	return client, nil
+}
@@ -1,10 +1,14 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/srstomp/kaizen/internal/llm"
 	"gopkg.in/yaml.v3"
 )
 
@@ -162,3 +166,245 @@ func TestThresholdsFileExists(t *testing.T) {
 		t.Fatalf("thresholds.yaml does not exist at: %s", configPath)
 	}
 }
+
+func TestLoadConfig_LLMAPIKeyEnv(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	content := "llm:\n  api_key_env: KAIZEN_TEST_API_KEY\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	config, err := loadConfig(configPath)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if config.LLM.APIKeyEnv != "KAIZEN_TEST_API_KEY" {
+		t.Errorf("llm.api_key_env = %q, expected KAIZEN_TEST_API_KEY", config.LLM.APIKeyEnv)
+	}
+}
+
+func TestLoadConfig_TaskQualityVagueScopeKeywords(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	content := "task_quality:\n  vague_scope_keywords: [various, several]\n  acceptance_markers: [definition of done]\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	config, err := loadConfig(configPath)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if got := strings.Join(config.TaskQuality.VagueScopeKeywords, ","); got != "various,several" {
+		t.Errorf("task_quality.vague_scope_keywords = %q, expected various,several", got)
+	}
+	if got := strings.Join(config.TaskQuality.AcceptanceMarkers, ","); got != "definition of done" {
+		t.Errorf("task_quality.acceptance_markers = %q, expected definition of done", got)
+	}
+}
+
+func TestLoadConfig_Graders(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	content := "graders:\n  debug_statements:\n    warn_only: true\n  flaky_tests:\n    fail_on_findings: true\n  test_coverage:\n    threshold: 65.5\n"
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	config, err := loadConfig(configPath)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	if !config.Graders.DebugStatements.WarnOnly {
+		t.Error("expected graders.debug_statements.warn_only to be true")
+	}
+	if !config.Graders.FlakyTests.FailOnFindings {
+		t.Error("expected graders.flaky_tests.fail_on_findings to be true")
+	}
+	if config.Graders.TestCoverage.Threshold != 65.5 {
+		t.Errorf("graders.test_coverage.threshold = %g, expected 65.5", config.Graders.TestCoverage.Threshold)
+	}
+}
+
+func TestLoadConfig_MissingFileReturnsDefaults(t *testing.T) {
+	config, err := loadConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil {
+		t.Fatalf("expected no error for missing config, got: %v", err)
+	}
+	if config.LLM.APIKeyEnv != "" {
+		t.Errorf("expected empty api_key_env default, got %q", config.LLM.APIKeyEnv)
+	}
+}
+
+func TestNewLLMClient_ResolvesKeyFromEnv(t *testing.T) {
+	const envVar = "KAIZEN_TEST_API_KEY"
+	const secret = "sk-ant-secret-value"
+
+	t.Setenv(envVar, secret)
+	client, err := newLLMClient(LLMConfig{APIKeyEnv: envVar})
+	if err != nil {
+		t.Fatalf("expected client construction to succeed, got: %v", err)
+	}
+	if client == nil {
+		t.Fatal("expected non-nil client")
+	}
+
+	os.Unsetenv(envVar)
+	client, err = newLLMClient(LLMConfig{APIKeyEnv: envVar})
+	if err == nil {
+		t.Fatal("expected error when referenced env var is unset")
+	}
+	if client != nil {
+		t.Error("expected nil client on error")
+	}
+	if !strings.Contains(err.Error(), envVar) {
+		t.Errorf("expected error to name %s, got: %v", envVar, err)
+	}
+	if strings.Contains(err.Error(), secret) {
+		t.Error("error message must never contain the API key")
+	}
+}
+
+// TestNewLLMClient_MissingKeyFallback verifies only a missing default key allows the heuristic
+// fallback, so a variable named in api_key_env can't silently be left unset
+func TestNewLLMClient_MissingKeyFallback(t *testing.T) {
+	t.Setenv(llmProviderEnv, "")
+	t.Setenv("ANTHROPIC_API_KEY", "")
+	t.Setenv("KAIZEN_TEST_UNSET_KEY", "")
+
+	if _, err := newLLMClient(LLMConfig{}); !errors.Is(err, errNoAPIKey) {
+		t.Errorf("expected errNoAPIKey for a missing default key, got: %v", err)
+	}
+
+	_, err := newLLMClient(LLMConfig{APIKeyEnv: "KAIZEN_TEST_UNSET_KEY"})
+	if err == nil || errors.Is(err, errNoAPIKey) {
+		t.Errorf("expected a configuration error for an unset api_key_env variable, got: %v", err)
+	}
+}
+
+// TestNewLLMClient_InitConfigFallback verifies the config kaizen init writes falls back to
+// heuristics when ANTHROPIC_API_KEY is unset instead of failing
+func TestNewLLMClient_InitConfigFallback(t *testing.T) {
+	t.Setenv(llmProviderEnv, "")
+	t.Setenv("ANTHROPIC_API_KEY", "")
+
+	var config KaizenConfig
+	if err := yaml.Unmarshal([]byte(defaultConfigYAML), &config); err != nil {
+		t.Fatalf("failed to parse defaultConfigYAML: %v", err)
+	}
+	if config.LLM.APIKeyEnv != "ANTHROPIC_API_KEY" {
+		t.Fatalf("expected the init config to name ANTHROPIC_API_KEY, got %q", config.LLM.APIKeyEnv)
+	}
+	if _, err := newLLMClient(config.LLM); !errors.Is(err, errNoAPIKey) {
+		t.Errorf("expected errNoAPIKey for the init config without a key, got: %v", err)
+	}
+}
+
+func TestNewLLMClient_FakeProvider(t *testing.T) {
+	t.Setenv(llmProviderEnv, "")
+	t.Setenv("KAIZEN_TEST_UNSET_KEY", "")
+
+	client, err := newLLMClient(LLMConfig{Provider: "fake", APIKeyEnv: "KAIZEN_TEST_UNSET_KEY"})
+	if err != nil {
+		t.Fatalf("expected the fake provider to need no API key, got: %v", err)
+	}
+	if _, ok := client.(*llm.FakeClient); !ok {
+		t.Errorf("expected *llm.FakeClient, got %T", client)
+	}
+
+	t.Setenv(llmProviderEnv, "fake")
+	client, err = newLLMClient(LLMConfig{APIKeyEnv: "KAIZEN_TEST_UNSET_KEY"})
+	if err != nil {
+		t.Fatalf("expected %s=fake to select the fake provider, got: %v", llmProviderEnv, err)
+	}
+	if _, ok := client.(*llm.FakeClient); !ok {
+		t.Errorf("expected *llm.FakeClient, got %T", client)
+	}
+
+	t.Setenv(llmProviderEnv, "")
+	if _, err := newLLMClient(LLMConfig{Provider: "openai"}); err == nil || !strings.Contains(err.Error(), "unknown LLM provider") {
+		t.Errorf("expected an unknown provider error, got: %v", err)
+	}
+	if _, err := newLLMClient(LLMConfig{Provider: "fake", FakeResponses: []llm.FakeResponse{{Pattern: "("}}}); err == nil {
+		t.Error("expected an error for an invalid fake response pattern")
+	}
+}
+
+// TestNewLLMClient_FakeResponsesDriveScores verifies configured canned responses reach the
+// grader's real parse and score path, falling back to the defaults for other prompts
+func TestNewLLMClient_FakeResponsesDriveScores(t *testing.T) {
+	t.Setenv(llmProviderEnv, "")
+	content := `llm:
+  provider: fake
+  fake_responses:
+    - pattern: "# weak-skill"
+      response: |
+        CLEAR_INSTRUCTIONS: 20
+        CLEAR_INSTRUCTIONS_FEEDBACK: Vague
+        ACTIONABLE_STEPS: 20
+        ACTIONABLE_STEPS_FEEDBACK: No steps
+        GOOD_EXAMPLES: 20
+        GOOD_EXAMPLES_FEEDBACK: No examples
+        APPROPRIATE_SCOPE: 20
+        APPROPRIATE_SCOPE_FEEDBACK: Unbounded
+`
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	config, err := loadConfig(configPath)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	client, err := newLLMClient(config.LLM)
+	if err != nil {
+		t.Fatalf("newLLMClient failed: %v", err)
+	}
+
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	for _, skill := range []string{"weak-skill", "strong-skill"} {
+		if err := os.MkdirAll(filepath.Join(skillsDir, skill), 0755); err != nil {
+			t.Fatalf("failed to create skill dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(skillsDir, skill, "SKILL.md"), []byte("# "+skill+"\n"), 0644); err != nil {
+			t.Fatalf("failed to write skill: %v", err)
+		}
+	}
+
+	reportPath := filepath.Join(tmpDir, "skill-clarity.json")
+	if err := gradeSkillsWithOptions(skillsDir, reportPath, GradeSkillsOptions{Format: "json", LLMClient: client}); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	data, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("failed to read report: %v", err)
+	}
+	var report SkillReportJSON
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatalf("report is not valid JSON: %v", err)
+	}
+
+	scores := map[string]float64{}
+	for _, skill := range report.Skills {
+		scores[skill.Name] = skill.Score
+	}
+	if scores["weak-skill"] != 20 || scores["strong-skill"] != 80 {
+		t.Errorf("expected weak-skill 20 and strong-skill 80, got %v", scores)
+	}
+}
+
+func TestLoadConfig_GradeSkillsLabels(t *testing.T) {
+	content := "grade_skills:\n  labels:\n    needs_improvement: Revise soon\n    failed: Blocked\n"
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	config, err := loadConfig(configPath)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+	want := SkillReportLabels{NeedsImprovement: "Revise soon", Failed: "Blocked"}
+	if config.GradeSkills.Labels != want {
+		t.Errorf("grade_skills.labels = %+v, expected %+v", config.GradeSkills.Labels, want)
+	}
+}
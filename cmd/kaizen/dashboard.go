@@ -1,30 +1,31 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	htmlpkg "html"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
 )
 
 // DashboardSummary contains overall summary statistics
 type DashboardSummary struct {
-	EvalTotalCount     int
-	EvalPassCount      int
-	EvalFailCount      int
-	EvalAvgScore       float64
-	MetaTotalCount     int
-	MetaAvgConsistency float64
-	GeneratedAt        string
+	EvalTotalCount     int     `json:"eval_total_count"`
+	EvalPassCount      int     `json:"eval_pass_count"`
+	EvalFailCount      int     `json:"eval_fail_count"`
+	EvalPassRate       float64 `json:"eval_pass_rate"`
+	EvalAvgScore       float64 `json:"eval_avg_score"`
+	MetaTotalCount     int     `json:"meta_total_count"`
+	MetaAvgConsistency float64 `json:"meta_avg_consistency"`
 }
 
 // calculateDashboardSummary computes summary statistics from eval and meta results
 func calculateDashboardSummary(evalResults []GradeTaskOutput, metaResults []ConsistencyResult) DashboardSummary {
-	summary := DashboardSummary{
-		GeneratedAt: time.Now().Format("2006-01-02 15:04:05"),
-	}
+	var summary DashboardSummary
 
 	// Calculate eval summary
 	summary.EvalTotalCount = len(evalResults)
@@ -38,6 +39,7 @@ func calculateDashboardSummary(evalResults []GradeTaskOutput, metaResults []Cons
 		totalScore += result.OverallScore
 	}
 	if summary.EvalTotalCount > 0 {
+		summary.EvalPassRate = float64(summary.EvalPassCount) / float64(summary.EvalTotalCount) * 100
 		summary.EvalAvgScore = totalScore / float64(summary.EvalTotalCount)
 	}
 
@@ -54,9 +56,13 @@ func calculateDashboardSummary(evalResults []GradeTaskOutput, metaResults []Cons
 	return summary
 }
 
-// generateDashboardHTML creates the HTML content for the dashboard
-func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []ConsistencyResult) string {
-	summary := calculateDashboardSummary(evalResults, metaResults)
+// generateDashboardHTML creates the HTML content for the dashboard from its data model
+func generateDashboardHTML(data DashboardData) string {
+	summary := data.Summary
+	generatedAt := data.Metadata.GeneratedAt
+	if t, err := time.Parse(time.RFC3339, generatedAt); err == nil {
+		generatedAt = t.Format("2006-01-02 15:04:05 MST")
+	}
 
 	var html strings.Builder
 
@@ -236,7 +242,7 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
 <body>
   <div class="container">
     <h1>Yokay Evals Dashboard</h1>
-    <div class="timestamp">Generated: ` + htmlpkg.EscapeString(summary.GeneratedAt) + `</div>
+    <div class="timestamp">Generated: ` + htmlpkg.EscapeString(generatedAt) + `</div>
 `)
 
 	// Summary section
@@ -245,10 +251,7 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
 `)
 
 	// Eval pass rate card
-	evalPassRate := 0.0
-	if summary.EvalTotalCount > 0 {
-		evalPassRate = float64(summary.EvalPassCount) / float64(summary.EvalTotalCount) * 100
-	}
+	evalPassRate := summary.EvalPassRate
 	evalCardClass := "summary-card"
 	if evalPassRate >= 90 {
 		evalCardClass += " success"
@@ -300,7 +303,7 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
 	html.WriteString(`    <h2>Eval Results</h2>
 `)
 
-	if len(evalResults) == 0 {
+	if len(data.EvalResults) == 0 {
 		html.WriteString(`    <div class="no-data">No eval results available</div>
 `)
 	} else {
@@ -316,18 +319,18 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
       <tbody>
 `)
 
-		for _, result := range evalResults {
+		for _, result := range data.EvalResults {
 			status := "pass"
 			statusText := "PASS"
-			if !result.OverallPassed {
+			if !result.Passed {
 				status = "fail"
 				statusText = "FAIL"
 			}
 
 			scoreClass := "score"
-			if result.OverallScore >= 90 {
+			if result.Score >= 90 {
 				scoreClass += " high"
-			} else if result.OverallScore >= 70 {
+			} else if result.Score >= 70 {
 				scoreClass += " medium"
 			} else {
 				scoreClass += " low"
@@ -345,7 +348,7 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
           <td><span class="status %s">%s</span></td>
           <td><span class="%s">%.1f</span></td>
         </tr>
-`, htmlpkg.EscapeString(result.TaskID), htmlpkg.EscapeString(timestamp), status, statusText, scoreClass, result.OverallScore))
+`, htmlpkg.EscapeString(result.TaskID), htmlpkg.EscapeString(timestamp), status, statusText, scoreClass, result.Score))
 		}
 
 		html.WriteString(`      </tbody>
@@ -357,7 +360,7 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
 	html.WriteString(`    <h2>Meta Results</h2>
 `)
 
-	if len(metaResults) == 0 {
+	if len(data.MetaResults) == 0 {
 		html.WriteString(`    <div class="no-data">No meta results available</div>
 `)
 	} else {
@@ -374,7 +377,7 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
       <tbody>
 `)
 
-		for _, result := range metaResults {
+		for _, result := range data.MetaResults {
 			scoreClass := "score"
 			if result.ConsistencyPercentage >= 90 {
 				scoreClass += " high"
@@ -405,6 +408,37 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
 `)
 	}
 
+	// Failure Categories section
+	html.WriteString(`    <h2>Failure Categories</h2>
+`)
+
+	if len(data.FailureCategories) == 0 {
+		html.WriteString(`    <div class="no-data">No failures captured</div>
+`)
+	} else {
+		html.WriteString(`    <table>
+      <thead>
+        <tr>
+          <th>Category</th>
+          <th>Failures</th>
+        </tr>
+      </thead>
+      <tbody>
+`)
+
+		for _, category := range data.FailureCategories {
+			html.WriteString(fmt.Sprintf(`        <tr>
+          <td>%s</td>
+          <td>%d</td>
+        </tr>
+`, htmlpkg.EscapeString(category.Category), category.Count))
+		}
+
+		html.WriteString(`      </tbody>
+    </table>
+`)
+	}
+
 	// Trends section (simple bar chart)
 	html.WriteString(`    <h2>Trends</h2>
     <div class="chart-container">
@@ -412,33 +446,13 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
       <div class="bar-chart">
 `)
 
-	// Create score distribution chart for eval results
-	if len(evalResults) > 0 {
-		// Count scores by ranges
-		scoreRanges := map[string]int{
-			"90-100": 0,
-			"70-89":  0,
-			"50-69":  0,
-			"0-49":   0,
-		}
-
-		for _, result := range evalResults {
-			if result.OverallScore >= 90 {
-				scoreRanges["90-100"]++
-			} else if result.OverallScore >= 70 {
-				scoreRanges["70-89"]++
-			} else if result.OverallScore >= 50 {
-				scoreRanges["50-69"]++
-			} else {
-				scoreRanges["0-49"]++
-			}
-		}
-
+	// Render the score distribution of the eval results
+	if summary.EvalTotalCount > 0 {
 		// Calculate max for scaling
 		maxCount := 0
-		for _, count := range scoreRanges {
-			if count > maxCount {
-				maxCount = count
+		for _, bucket := range data.ScoreDistribution {
+			if bucket.Count > maxCount {
+				maxCount = bucket.Count
 			}
 		}
 		if maxCount == 0 {
@@ -446,11 +460,9 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
 		}
 
 		// Render bars
-		ranges := []string{"90-100", "70-89", "50-69", "0-49"}
-		for _, r := range ranges {
-			count := scoreRanges[r]
-			height := float64(count) / float64(maxCount) * 100
-			if count > 0 && height < 10 {
+		for _, bucket := range data.ScoreDistribution {
+			height := float64(bucket.Count) / float64(maxCount) * 100
+			if bucket.Count > 0 && height < 10 {
 				height = 10 // Minimum visible height
 			}
 
@@ -460,7 +472,7 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
           </div>
           <div class="bar-label">%s</div>
         </div>
-`, height, count, r))
+`, height, bucket.Count, bucket.Range))
 		}
 	} else {
 		html.WriteString(`        <div class="no-data">No data to display</div>
@@ -480,8 +492,29 @@ func generateDashboardHTML(evalResults []GradeTaskOutput, metaResults []Consiste
 	return html.String()
 }
 
+// DashboardOptions holds optional settings for the dashboard command
+type DashboardOptions struct {
+	// DataOnly emits the aggregated dashboard data instead of HTML
+	DataOnly bool
+	// Format is the output format for data-only mode
+	Format string
+	// FailuresDB is the failures database the failure categories are read from; when empty or
+	// missing the dashboard has no failure categories
+	FailuresDB string
+}
+
 // runDashboardCommand executes the dashboard generation command
 func runDashboardCommand(reportsDir, outputPath string) error {
+	return runDashboardCommandWithOptions(reportsDir, outputPath, DashboardOptions{})
+}
+
+// runDashboardCommandWithOptions executes the dashboard command with optional settings.
+// In data-only mode an empty outputPath writes to stdout.
+func runDashboardCommandWithOptions(reportsDir, outputPath string, opts DashboardOptions) error {
+	if opts.DataOnly && opts.Format != "json" {
+		return fmt.Errorf("unsupported data format: %s (use 'json')", opts.Format)
+	}
+
 	// Validate reports directory exists
 	if _, err := os.Stat(reportsDir); os.IsNotExist(err) {
 		return fmt.Errorf("reports directory not found: %s", reportsDir)
@@ -509,11 +542,43 @@ func runDashboardCommand(reportsDir, outputPath string) error {
 		metaResults = results
 	}
 
-	// Generate HTML
-	html := generateDashboardHTML(evalResults, metaResults)
+	// Load failure category stats
+	var categories []failures.CategoryStat
+	if opts.FailuresDB != "" {
+		if _, err := os.Stat(opts.FailuresDB); err == nil {
+			store, err := failures.NewStore(opts.FailuresDB)
+			if err != nil {
+				return fmt.Errorf("opening failures database: %w", err)
+			}
+			categories, err = store.CategoryStats()
+			store.Close()
+			if err != nil {
+				return fmt.Errorf("loading failure categories: %w", err)
+			}
+		}
+	}
+
+	data := buildDashboardData(evalResults, metaResults, categories, time.Now())
+
+	var content []byte
+	if opts.DataOnly {
+		jsonData, err := json.MarshalIndent(data, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshaling dashboard data: %w", err)
+		}
+		content = append(jsonData, '\n')
+
+		if outputPath == "" {
+			fmt.Print(string(content))
+			return nil
+		}
+	} else {
+		// Generate HTML
+		content = []byte(generateDashboardHTML(data))
+	}
 
 	// Write to output file
-	if err := os.WriteFile(outputPath, []byte(html), 0644); err != nil {
+	if err := os.WriteFile(outputPath, content, 0644); err != nil {
 		return fmt.Errorf("writing dashboard file: %w", err)
 	}
 
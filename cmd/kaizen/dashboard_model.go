@@ -2,16 +2,27 @@ package main
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
 )
 
-// DashboardData represents the complete dashboard data model
+// dashboardDataVersion is the schema version of the exported dashboard data
+const dashboardDataVersion = "2.0.0"
+
+// DashboardData represents the complete dashboard data model. Both the HTML dashboard and
+// --data-only render from it, so the two outputs can't disagree.
 type DashboardData struct {
 	Metadata          DashboardMetadata        `json:"metadata"`
+	Summary           DashboardSummary         `json:"summary"`
 	TimeSeries        []TimeSeriesPoint        `json:"time_series"`
 	Agents            []AgentMetrics           `json:"agents"`
 	FailureCategories []FailureCategoryMetrics `json:"failure_categories"`
+	EvalResults       []DashboardEvalResult    `json:"eval_results"`
+	MetaResults       []DashboardMetaResult    `json:"meta_results"`
+	ScoreDistribution []ScoreBucket            `json:"score_distribution"`
 }
 
 // DashboardMetadata contains metadata about the dashboard data
@@ -64,9 +75,46 @@ type AgentMetricsData struct {
 
 // FailureCategoryMetrics contains metrics for a failure category
 type FailureCategoryMetrics struct {
-	Category string  `json:"category"`
-	Count    int     `json:"count"`
-	PassRate float64 `json:"pass_rate"`
+	Category string `json:"category"`
+	// Count is the category's occurrence count in the failures database
+	Count int `json:"count"`
+	// PassRate is the eval pass rate for the category; nil when no eval result covers it
+	PassRate *float64 `json:"pass_rate,omitempty"`
+}
+
+// DashboardEvalResult is a single grade-task result listed on the dashboard
+type DashboardEvalResult struct {
+	TaskID    string  `json:"task_id"`
+	Timestamp string  `json:"timestamp"`
+	Passed    bool    `json:"passed"`
+	Score     float64 `json:"score"`
+}
+
+// DashboardMetaResult is a single meta-evaluation result listed on the dashboard
+type DashboardMetaResult struct {
+	Agent                 string  `json:"agent"`
+	BoundaryType          string  `json:"boundary_type,omitempty"`
+	Timestamp             string  `json:"timestamp"`
+	ConsistencyPercentage float64 `json:"consistency_percentage"`
+	ConsistentCount       int     `json:"consistent_count"`
+	TotalCount            int     `json:"total_count"`
+}
+
+// ScoreBucket is the number of eval results whose score falls in a range, e.g. "90-100"
+type ScoreBucket struct {
+	Range string `json:"range"`
+	Count int    `json:"count"`
+}
+
+// scoreBuckets are the score distribution ranges, highest first, with the lowest score of each
+var scoreBuckets = []struct {
+	label string
+	min   float64
+}{
+	{"90-100", 90},
+	{"70-89", 70},
+	{"50-69", 50},
+	{"0-49", 0},
 }
 
 // validFailureCategories is the list of valid failure categories from failures/schema.yaml
@@ -220,9 +268,174 @@ func validateFailureCategoryMetrics(fc FailureCategoryMetrics, index int) error
 		return fmt.Errorf("failure_categories[%d].count must be non-negative", index)
 	}
 
-	if fc.PassRate < 0 || fc.PassRate > 100 {
+	if fc.PassRate != nil && (*fc.PassRate < 0 || *fc.PassRate > 100) {
 		return fmt.Errorf("failure_categories[%d].pass_rate must be between 0 and 100", index)
 	}
 
 	return nil
 }
+
+// buildDashboardData aggregates eval and meta results and the failure category stats into the
+// dashboard data model. Results are bucketed by day; entries with unparseable timestamps are left
+// out of the time series but still count towards the summary, agent metrics and result lists.
+func buildDashboardData(evalResults []GradeTaskOutput, metaResults []ConsistencyResult, categories []failures.CategoryStat, generatedAt time.Time) DashboardData {
+	type bucket struct {
+		evalTotal        int
+		evalPass         int
+		scoreSum         float64
+		consistencySum   float64
+		consistencyCount int
+		agents           map[string]bool
+	}
+
+	buckets := make(map[time.Time]*bucket)
+	var earliest, latest time.Time
+	getBucket := func(timestamp string) *bucket {
+		t, err := time.Parse(time.RFC3339, timestamp)
+		if err != nil {
+			return nil
+		}
+		t = t.UTC()
+		if earliest.IsZero() || t.Before(earliest) {
+			earliest = t
+		}
+		if latest.IsZero() || t.After(latest) {
+			latest = t
+		}
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		b, ok := buckets[day]
+		if !ok {
+			b = &bucket{agents: make(map[string]bool)}
+			buckets[day] = b
+		}
+		return b
+	}
+
+	for _, result := range evalResults {
+		b := getBucket(result.Timestamp)
+		if b == nil {
+			continue
+		}
+		b.evalTotal++
+		if result.OverallPassed {
+			b.evalPass++
+		}
+		b.scoreSum += result.OverallScore
+	}
+
+	agentRuns := make(map[string]*AgentMetrics)
+	for _, result := range metaResults {
+		if b := getBucket(result.Timestamp); b != nil {
+			b.consistencySum += result.ConsistencyPercentage
+			b.consistencyCount++
+			b.agents[result.Agent] = true
+		}
+
+		agent, ok := agentRuns[result.Agent]
+		if !ok {
+			agent = &AgentMetrics{AgentName: result.Agent}
+			agentRuns[result.Agent] = agent
+		}
+		agent.Metrics.RunCount++
+		// Consistency-log entries are appended chronologically, so the last one wins
+		agent.Metrics.ConsistencyPercentage = result.ConsistencyPercentage
+		agent.Metrics.LastRun = result.Timestamp
+	}
+
+	days := make([]time.Time, 0, len(buckets))
+	for day := range buckets {
+		days = append(days, day)
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	timeSeries := make([]TimeSeriesPoint, 0, len(days))
+	for _, day := range days {
+		b := buckets[day]
+		point := TimeSeriesPoint{
+			Timestamp: day.Format(time.RFC3339),
+			EvalMetrics: EvalMetrics{
+				TotalCount: b.evalTotal,
+				PassCount:  b.evalPass,
+				FailCount:  b.evalTotal - b.evalPass,
+			},
+			MetaMetrics: MetaMetrics{
+				TotalAgents: len(b.agents),
+			},
+		}
+		if b.evalTotal > 0 {
+			point.EvalMetrics.PassRate = float64(b.evalPass) / float64(b.evalTotal) * 100
+			point.EvalMetrics.AvgScore = b.scoreSum / float64(b.evalTotal)
+		}
+		if b.consistencyCount > 0 {
+			point.MetaMetrics.AvgConsistency = b.consistencySum / float64(b.consistencyCount)
+		}
+		timeSeries = append(timeSeries, point)
+	}
+
+	agents := make([]AgentMetrics, 0, len(agentRuns))
+	for _, agent := range agentRuns {
+		agents = append(agents, *agent)
+	}
+	sort.Slice(agents, func(i, j int) bool { return agents[i].AgentName < agents[j].AgentName })
+
+	generatedAt = generatedAt.UTC()
+	if earliest.IsZero() {
+		earliest, latest = generatedAt, generatedAt
+	}
+
+	// Eval results don't record failure categories, so no category has a pass rate yet
+	failureCategories := make([]FailureCategoryMetrics, 0, len(categories))
+	for _, stat := range categories {
+		failureCategories = append(failureCategories, FailureCategoryMetrics{Category: stat.Category, Count: stat.OccurrenceCount})
+	}
+
+	evalRows := make([]DashboardEvalResult, 0, len(evalResults))
+	distribution := make([]ScoreBucket, len(scoreBuckets))
+	for i, b := range scoreBuckets {
+		distribution[i].Range = b.label
+	}
+	for _, result := range evalResults {
+		evalRows = append(evalRows, DashboardEvalResult{
+			TaskID:    result.TaskID,
+			Timestamp: result.Timestamp,
+			Passed:    result.OverallPassed,
+			Score:     result.OverallScore,
+		})
+		for i, b := range scoreBuckets {
+			if result.OverallScore >= b.min || i == len(scoreBuckets)-1 {
+				distribution[i].Count++
+				break
+			}
+		}
+	}
+
+	metaRows := make([]DashboardMetaResult, 0, len(metaResults))
+	for _, result := range metaResults {
+		metaRows = append(metaRows, DashboardMetaResult{
+			Agent:                 result.Agent,
+			BoundaryType:          result.BoundaryType,
+			Timestamp:             result.Timestamp,
+			ConsistencyPercentage: result.ConsistencyPercentage,
+			ConsistentCount:       result.ConsistentCount,
+			TotalCount:            result.TotalCount,
+		})
+	}
+
+	return DashboardData{
+		Metadata: DashboardMetadata{
+			GeneratedAt: generatedAt.Format(time.RFC3339),
+			Version:     dashboardDataVersion,
+			DateRange: DateRange{
+				Start: earliest.Format(time.RFC3339),
+				End:   latest.Format(time.RFC3339),
+			},
+		},
+		Summary:           calculateDashboardSummary(evalResults, metaResults),
+		TimeSeries:        timeSeries,
+		Agents:            agents,
+		FailureCategories: failureCategories,
+		EvalResults:       evalRows,
+		MetaResults:       metaRows,
+		ScoreDistribution: distribution,
+	}
+}
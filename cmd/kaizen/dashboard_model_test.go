@@ -51,7 +51,7 @@ func TestDashboardData_JSONSerialization(t *testing.T) {
 			{
 				Category: "missing-tests",
 				Count:    15,
-				PassRate: 80.0,
+				PassRate: passRate(80.0),
 			},
 		},
 	}
@@ -252,13 +252,34 @@ func TestDashboardData_Validate(t *testing.T) {
 					{
 						Category: "invalid-category",
 						Count:    5,
-						PassRate: 50.0,
+						PassRate: passRate(50.0),
 					},
 				},
 			},
 			wantErr: true,
 			errMsg:  "invalid failure category",
 		},
+		{
+			name: "invalid failure category pass rate",
+			data: DashboardData{
+				Metadata: DashboardMetadata{
+					GeneratedAt: "2026-01-28T12:00:00Z",
+					Version:     "2.0.0",
+					DateRange: DateRange{
+						Start: "2026-01-01T00:00:00Z",
+						End:   "2026-01-28T23:59:59Z",
+					},
+				},
+				TimeSeries: []TimeSeriesPoint{},
+				Agents:     []AgentMetrics{},
+				FailureCategories: []FailureCategoryMetrics{
+					{Category: "missing-tests", Count: 5, PassRate: passRate(120.0)},
+					{Category: "regression", Count: 2},
+				},
+			},
+			wantErr: true,
+			errMsg:  "failure_categories[0].pass_rate must be between 0 and 100",
+		},
 		{
 			name: "negative pass rate",
 			data: DashboardData{
@@ -407,3 +428,8 @@ func TestDashboardData_Validate(t *testing.T) {
 		})
 	}
 }
+
+// passRate returns a pointer to a failure category pass rate
+func passRate(rate float64) *float64 {
+	return &rate
+}
@@ -1,10 +1,14 @@
 package main
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
 )
 
 // extractSnippet extracts a snippet of HTML around a search term for debugging
@@ -167,7 +171,7 @@ func TestGenerateDashboardHTML(t *testing.T) {
 	}
 
 	// Generate HTML
-	html := generateDashboardHTML(evalResults, metaResults)
+	html := generateDashboardHTML(buildDashboardData(evalResults, metaResults, nil, time.Now()))
 
 	// Verify HTML structure
 	if !strings.Contains(html, "<!DOCTYPE html>") {
@@ -293,6 +297,172 @@ func TestRunDashboardCommand_EmptyLogs(t *testing.T) {
 	}
 }
 
+func TestRunDashboardCommand_DataOnlyJSON(t *testing.T) {
+	tempDir := t.TempDir()
+	reportsDir := filepath.Join(tempDir, "reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create test reports dir: %v", err)
+	}
+
+	evalLog := `[
+  {"task_id": "task-1", "timestamp": "2026-01-26T10:00:00Z", "results": [], "overall_passed": true, "overall_score": 90},
+  {"task_id": "task-2", "timestamp": "2026-01-27T09:00:00Z", "results": [], "overall_passed": false, "overall_score": 40},
+  {"task_id": "task-3", "timestamp": "2026-01-27T15:00:00Z", "results": [], "overall_passed": true, "overall_score": 80}
+]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), []byte(evalLog), 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	metaLog := `[
+  {"timestamp": "2026-01-26T12:00:00Z", "agent": "yokay-spec-reviewer", "boundary_type": "story", "consistency_percentage": 70.0, "consistent_count": 7, "total_count": 10},
+  {"timestamp": "2026-01-27T12:00:00Z", "agent": "yokay-spec-reviewer", "boundary_type": "story", "consistency_percentage": 90.0, "consistent_count": 9, "total_count": 10}
+]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "consistency-log.json"), []byte(metaLog), 0644); err != nil {
+		t.Fatalf("Failed to write meta log: %v", err)
+	}
+
+	outputPath := filepath.Join(tempDir, "dashboard.json")
+	opts := DashboardOptions{DataOnly: true, Format: "json"}
+	if err := runDashboardCommandWithOptions(reportsDir, outputPath, opts); err != nil {
+		t.Fatalf("runDashboardCommandWithOptions failed: %v", err)
+	}
+
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read dashboard data: %v", err)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(content, &raw); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	for _, key := range []string{"metadata", "time_series", "agents", "failure_categories"} {
+		if _, ok := raw[key]; !ok {
+			t.Errorf("Expected key %q in dashboard data", key)
+		}
+	}
+	if strings.Contains(string(content), "<html") {
+		t.Error("Data-only output should not contain HTML")
+	}
+
+	var data DashboardData
+	if err := json.Unmarshal(content, &data); err != nil {
+		t.Fatalf("Failed to decode dashboard data: %v", err)
+	}
+	if err := data.Validate(); err != nil {
+		t.Errorf("Dashboard data should be valid: %v", err)
+	}
+
+	if len(data.TimeSeries) != 2 {
+		t.Fatalf("Expected 2 daily time series points, got %d", len(data.TimeSeries))
+	}
+	day2 := data.TimeSeries[1]
+	if day2.Timestamp != "2026-01-27T00:00:00Z" {
+		t.Errorf("Expected second point on 2026-01-27, got %s", day2.Timestamp)
+	}
+	if day2.EvalMetrics.TotalCount != 2 || day2.EvalMetrics.PassCount != 1 || day2.EvalMetrics.FailCount != 1 {
+		t.Errorf("Unexpected eval counts for 2026-01-27: %+v", day2.EvalMetrics)
+	}
+	if day2.EvalMetrics.PassRate != 50 || day2.EvalMetrics.AvgScore != 60 {
+		t.Errorf("Expected pass rate 50 and avg score 60, got %+v", day2.EvalMetrics)
+	}
+
+	if len(data.Agents) != 1 {
+		t.Fatalf("Expected 1 agent, got %d", len(data.Agents))
+	}
+	agent := data.Agents[0].Metrics
+	if agent.RunCount != 2 || agent.ConsistencyPercentage != 90 || agent.LastRun != "2026-01-27T12:00:00Z" {
+		t.Errorf("Unexpected agent metrics: %+v", agent)
+	}
+
+	if data.Metadata.DateRange.Start != "2026-01-26T10:00:00Z" || data.Metadata.DateRange.End != "2026-01-27T15:00:00Z" {
+		t.Errorf("Unexpected date range: %+v", data.Metadata.DateRange)
+	}
+}
+
+// TestRunDashboardCommand_FailureCategories verifies failure categories are read from the store and
+// that the HTML and data-only outputs render the same dashboard data
+func TestRunDashboardCommand_FailureCategories(t *testing.T) {
+	tempDir := t.TempDir()
+	reportsDir := filepath.Join(tempDir, "reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create test reports dir: %v", err)
+	}
+	evalLog := `[{"task_id": "task-1", "timestamp": "2026-01-27T10:00:00Z", "results": [], "overall_passed": true, "overall_score": 75}]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), []byte(evalLog), 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	dbPath := filepath.Join(tempDir, "failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	seen := time.Date(2026, 1, 20, 9, 0, 0, 0, time.UTC)
+	for category, count := range map[string]int{"missing-tests": 4, "scope-creep": 9} {
+		if err := store.UpsertCategoryStats(category, count, seen, seen); err != nil {
+			t.Fatalf("UpsertCategoryStats failed: %v", err)
+		}
+	}
+	store.Close()
+
+	dataPath := filepath.Join(tempDir, "dashboard.json")
+	if err := runDashboardCommandWithOptions(reportsDir, dataPath, DashboardOptions{DataOnly: true, Format: "json", FailuresDB: dbPath}); err != nil {
+		t.Fatalf("runDashboardCommandWithOptions (data-only) failed: %v", err)
+	}
+	htmlPath := filepath.Join(tempDir, "dashboard.html")
+	if err := runDashboardCommandWithOptions(reportsDir, htmlPath, DashboardOptions{FailuresDB: dbPath}); err != nil {
+		t.Fatalf("runDashboardCommandWithOptions (html) failed: %v", err)
+	}
+
+	content, err := os.ReadFile(dataPath)
+	if err != nil {
+		t.Fatalf("Failed to read dashboard data: %v", err)
+	}
+	var data DashboardData
+	if err := json.Unmarshal(content, &data); err != nil {
+		t.Fatalf("Failed to decode dashboard data: %v", err)
+	}
+	if err := data.Validate(); err != nil {
+		t.Errorf("Dashboard data should be valid: %v", err)
+	}
+	if len(data.FailureCategories) != 2 || data.FailureCategories[0].Category != "scope-creep" || data.FailureCategories[0].Count != 9 ||
+		data.FailureCategories[1].Category != "missing-tests" || data.FailureCategories[1].Count != 4 {
+		t.Errorf("Expected scope-creep (9) then missing-tests (4), got %+v", data.FailureCategories)
+	}
+	if strings.Contains(string(content), "pass_rate\": 0") {
+		t.Error("Expected no category pass rate without eval results for the category")
+	}
+	if data.Summary.EvalTotalCount != 1 || data.Summary.EvalAvgScore != 75 || len(data.EvalResults) != 1 {
+		t.Errorf("Unexpected summary %+v or eval results %+v", data.Summary, data.EvalResults)
+	}
+	if data.ScoreDistribution[1].Range != "70-89" || data.ScoreDistribution[1].Count != 1 {
+		t.Errorf("Expected the score in the 70-89 bucket, got %+v", data.ScoreDistribution)
+	}
+
+	html, err := os.ReadFile(htmlPath)
+	if err != nil {
+		t.Fatalf("Failed to read dashboard HTML: %v", err)
+	}
+	for _, want := range []string{"<h2>Failure Categories</h2>", "<td>scope-creep</td>\n          <td>9</td>", "<td>missing-tests</td>\n          <td>4</td>", "75.0"} {
+		if !strings.Contains(string(html), want) {
+			t.Errorf("Expected HTML to contain %q", want)
+		}
+	}
+}
+
+func TestRunDashboardCommand_DataOnlyUnsupportedFormat(t *testing.T) {
+	tempDir := t.TempDir()
+
+	err := runDashboardCommandWithOptions(tempDir, "", DashboardOptions{DataOnly: true, Format: "csv"})
+	if err == nil {
+		t.Fatal("Expected error for unsupported data format, got nil")
+	}
+	if !strings.Contains(err.Error(), "unsupported data format") {
+		t.Errorf("Expected unsupported format error, got: %v", err)
+	}
+}
+
 func TestGenerateDashboardHTML_XSSPrevention(t *testing.T) {
 	// Create test data with XSS attack vectors
 	evalResults := []GradeTaskOutput{
@@ -322,7 +492,7 @@ func TestGenerateDashboardHTML_XSSPrevention(t *testing.T) {
 	}
 
 	// Generate HTML
-	html := generateDashboardHTML(evalResults, metaResults)
+	html := generateDashboardHTML(buildDashboardData(evalResults, metaResults, nil, time.Now()))
 
 	// Verify that dangerous HTML tags are escaped (not executable)
 	// Check that < and > are escaped, making tags non-functional
@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/srstomp/kaizen/internal/failures"
@@ -12,12 +13,38 @@ type DetectOutput struct {
 	DetectedCategory string   `json:"detected_category"`
 	AllCategories    []string `json:"all_categories"`
 	Matched          bool     `json:"matched"`
+	Confidence       float64  `json:"confidence"`
+	LowConfidence    bool     `json:"low_confidence,omitempty"`
 }
 
-// runDetectCategoryCommand executes the detect-category command logic
+// errLowConfidence is returned alongside the output when the top match is below --min-confidence
+var errLowConfidence = errors.New("category confidence below minimum")
+
+// DetectOptions holds optional settings for the detect-category command
+type DetectOptions struct {
+	// Normalize strips timestamps, paths, hex/uuids and extra whitespace before matching
+	Normalize bool
+	// MinConfidence reports "unknown" and errLowConfidence when the top match scores below it (0 disables)
+	MinConfidence float64
+}
+
+// runDetectCategoryCommand executes the detect-category command logic with normalization enabled
 func runDetectCategoryCommand(details string) (string, error) {
+	return runDetectCategoryCommandWithOptions(details, DetectOptions{Normalize: true})
+}
+
+// runDetectCategoryCommandWithOptions executes the detect-category command logic with optional settings
+func runDetectCategoryCommandWithOptions(details string, opts DetectOptions) (string, error) {
+	if opts.MinConfidence < 0 || opts.MinConfidence > 1 {
+		return "", fmt.Errorf("min-confidence must be between 0 and 1, got: %.2f", opts.MinConfidence)
+	}
+
+	if opts.Normalize {
+		details = failures.NormalizeDetails(details)
+	}
+
 	// Detect the primary category
-	primaryCategory, matched := failures.DetectCategory(details)
+	primaryCategory, confidence, matched := failures.DetectCategoryWithConfidence(details)
 
 	// Detect all matching categories
 	allCategories := failures.DetectAllCategories(details)
@@ -27,6 +54,7 @@ func runDetectCategoryCommand(details string) (string, error) {
 		DetectedCategory: string(primaryCategory),
 		AllCategories:    make([]string, len(allCategories)),
 		Matched:          matched,
+		Confidence:       confidence,
 	}
 
 	// Convert Category types to strings
@@ -39,11 +67,21 @@ func runDetectCategoryCommand(details string) (string, error) {
 		output.DetectedCategory = "unknown"
 	}
 
+	// A low-confidence guess is routed to manual triage instead
+	if matched && confidence < opts.MinConfidence {
+		output.DetectedCategory = "unknown"
+		output.LowConfidence = true
+	}
+
 	// Encode to JSON with pretty printing
 	jsonBytes, err := json.MarshalIndent(output, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("encoding JSON output: %w", err)
 	}
 
+	if output.LowConfidence {
+		return string(jsonBytes), errLowConfidence
+	}
+
 	return string(jsonBytes), nil
 }
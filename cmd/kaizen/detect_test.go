@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 )
 
@@ -117,3 +118,76 @@ func TestDetectCategoryCommandEmptyDetails(t *testing.T) {
 		t.Errorf("AllCategories length = %d, want %d", len(result.AllCategories), 0)
 	}
 }
+
+func TestDetectCategoryCommandNormalize(t *testing.T) {
+	// A raw log paste: the path contains "untested" and the real cause is split across lines
+	noisy := "2026-01-27T10:15:30Z panic at /repo/legacy/untested/handler.go:42\n" +
+		"  goroutine 0xc000123abc\n" +
+		"  reason: wrong\n\t  file   was edited (run 3f2504e0-4f89-11d3-9a0c-0305e82c3301)"
+
+	tests := []struct {
+		name         string
+		normalize    bool
+		wantCategory string
+	}{
+		{name: "without normalization the path causes a false match", normalize: false, wantCategory: "missing-tests"},
+		{name: "with normalization the actual cause is detected", normalize: true, wantCategory: "wrong-product"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := runDetectCategoryCommandWithOptions(noisy, DetectOptions{Normalize: tt.normalize})
+			if err != nil {
+				t.Fatalf("runDetectCategoryCommandWithOptions() error = %v", err)
+			}
+
+			var result DetectOutput
+			if err := json.Unmarshal([]byte(output), &result); err != nil {
+				t.Fatalf("Failed to parse JSON output: %v", err)
+			}
+
+			if result.DetectedCategory != tt.wantCategory {
+				t.Errorf("DetectedCategory = %q, want %q", result.DetectedCategory, tt.wantCategory)
+			}
+		})
+	}
+}
+
+// TestDetectCategoryCommandMinConfidence verifies ambiguous input below the threshold is reported as unknown
+func TestDetectCategoryCommandMinConfidence(t *testing.T) {
+	// Matches both missing-tests and scope-creep patterns, so confidence is 0.5
+	ambiguous := "Untested change that is out of scope"
+
+	output, err := runDetectCategoryCommandWithOptions(ambiguous, DetectOptions{Normalize: true, MinConfidence: 0.7})
+	if !errors.Is(err, errLowConfidence) {
+		t.Fatalf("Expected errLowConfidence, got %v", err)
+	}
+
+	var result DetectOutput
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v", err)
+	}
+	if result.DetectedCategory != "unknown" || !result.LowConfidence {
+		t.Errorf("Expected unknown low-confidence result, got %+v", result)
+	}
+	if result.Confidence != 0.5 {
+		t.Errorf("Confidence = %v, want 0.5", result.Confidence)
+	}
+
+	// The same input passes a lower threshold
+	output, err = runDetectCategoryCommandWithOptions(ambiguous, DetectOptions{Normalize: true, MinConfidence: 0.5})
+	if err != nil {
+		t.Fatalf("runDetectCategoryCommandWithOptions() error = %v", err)
+	}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v", err)
+	}
+	if result.DetectedCategory != "missing-tests" {
+		t.Errorf("DetectedCategory = %q, want missing-tests", result.DetectedCategory)
+	}
+
+	// Out-of-range thresholds are rejected
+	if _, err := runDetectCategoryCommandWithOptions(ambiguous, DetectOptions{MinConfidence: 1.5}); err == nil || errors.Is(err, errLowConfidence) {
+		t.Errorf("Expected validation error for min-confidence 1.5, got %v", err)
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/srstomp/kaizen/internal/failures"
 	"github.com/srstomp/kaizen/internal/harness"
 	"gopkg.in/yaml.v3"
 )
@@ -81,6 +83,21 @@ func loadFailureCase(path string) (*FailureCase, error) {
 // findFailureCases finds all failure case YAML files in the failures directory
 // If category is not empty, only returns cases matching that category
 func findFailureCases(failuresDir string, category string) ([]FailureCase, error) {
+	var categories []string
+	if category != "" {
+		categories = []string{category}
+	}
+	return findFailureCasesInCategories(failuresDir, categories)
+}
+
+// findFailureCasesInCategories finds all failure case YAML files in the failures directory
+// If categories is not empty, only returns cases in one of those categories
+func findFailureCasesInCategories(failuresDir string, categories []string) ([]FailureCase, error) {
+	wanted := make(map[string]bool, len(categories))
+	for _, category := range categories {
+		wanted[category] = true
+	}
+
 	var cases []FailureCase
 
 	// Walk the failures directory
@@ -107,7 +124,7 @@ func findFailureCases(failuresDir string, category string) ([]FailureCase, error
 		}
 
 		// Filter by category if specified
-		if category != "" && failureCase.Category != category {
+		if len(wanted) > 0 && !wanted[failureCase.Category] {
 			return nil
 		}
 
@@ -122,6 +139,37 @@ func findFailureCases(failuresDir string, category string) ([]FailureCase, error
 	return cases, nil
 }
 
+// parseEvalCategories splits a comma-separated --category value into distinct category names
+func parseEvalCategories(value string) []string {
+	var categories []string
+	seen := make(map[string]bool)
+	for _, category := range strings.Split(value, ",") {
+		category = strings.TrimSpace(category)
+		if category == "" || seen[category] {
+			continue
+		}
+		seen[category] = true
+		categories = append(categories, category)
+	}
+	return categories
+}
+
+// unknownFailureCategories returns the categories that are not in failures/schema.yaml
+func unknownFailureCategories(categories []string) []string {
+	known := make(map[string]bool, len(validFailureCategories))
+	for _, category := range validFailureCategories {
+		known[category] = true
+	}
+
+	var unknown []string
+	for _, category := range categories {
+		if !known[category] {
+			unknown = append(unknown, category)
+		}
+	}
+	return unknown
+}
+
 // runEvaluation runs evaluation on a failure case k times
 // Each run is executed in an isolated context with its own temp directory
 func runEvaluation(failureCase FailureCase, k int) (EvalResult, error) {
@@ -169,15 +217,7 @@ func calculateEvalMetrics(results []EvalResult) map[string]CategoryMetrics {
 		m := metrics[cat]
 		m.Total++
 
-		// Determine pass/fail based on majority vote
-		passCount := 0
-		for _, run := range result.Runs {
-			if run {
-				passCount++
-			}
-		}
-
-		if passCount > len(result.Runs)/2 {
+		if evalResultPassed(result) {
 			m.Pass++
 		} else {
 			m.Fail++
@@ -197,10 +237,67 @@ func calculateEvalMetrics(results []EvalResult) map[string]CategoryMetrics {
 	return metrics
 }
 
-// formatEvalSummary formats evaluation results into a summary table or JSON
-func formatEvalSummary(results []EvalResult, format string) string {
+// failedCasesByCategory groups the IDs of failed cases by category
+func failedCasesByCategory(results []EvalResult) map[string][]string {
+	failed := make(map[string][]string)
+	for _, result := range results {
+		if !evalResultPassed(result) {
+			failed[result.Category] = append(failed[result.Category], result.CaseID)
+		}
+	}
+	return failed
+}
+
+// evalResultPassed determines pass/fail of a result based on majority vote
+func evalResultPassed(result EvalResult) bool {
+	passCount := 0
+	for _, run := range result.Runs {
+		if run {
+			passCount++
+		}
+	}
+	return passCount > len(result.Runs)/2
+}
+
+// loadFailedCaseIDs reads a prior `eval --format json` output and returns the IDs of failed cases
+func loadFailedCaseIDs(logPath string) (map[string]bool, error) {
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading previous results: %w", err)
+	}
+
+	var previous struct {
+		Results []EvalResult `json:"results"`
+	}
+	if err := json.Unmarshal(data, &previous); err != nil {
+		return nil, fmt.Errorf("parsing previous results: %w", err)
+	}
+
+	failed := make(map[string]bool)
+	for _, result := range previous.Results {
+		if !evalResultPassed(result) {
+			failed[result.CaseID] = true
+		}
+	}
+	return failed, nil
+}
+
+// filterFailureCasesByID keeps only the cases whose ID is in ids
+func filterFailureCasesByID(cases []FailureCase, ids map[string]bool) []FailureCase {
+	filtered := make([]FailureCase, 0, len(cases))
+	for _, failureCase := range cases {
+		if ids[failureCase.ID] {
+			filtered = append(filtered, failureCase)
+		}
+	}
+	return filtered
+}
+
+// formatEvalSummary formats evaluation results into a summary table or JSON; the JSON lists
+// warnings when non-nil, the table leaves them to stderr
+func formatEvalSummary(results []EvalResult, format string, warnings *Warnings) string {
 	if format == "json" {
-		return formatEvalSummaryJSON(results)
+		return formatEvalSummaryJSON(results, warnings)
 	}
 	return formatEvalSummaryTable(results)
 }
@@ -249,17 +346,32 @@ func formatEvalSummaryTable(results []EvalResult) string {
 	sb.WriteString(fmt.Sprintf("Total: %d cases, %d pass, %d fail (%.1f%%)\n",
 		totalCases, totalPass, totalFail, overallPassRate))
 
+	// Failed cases by category, so it's clear where failures came from
+	if totalFail > 0 {
+		failed := failedCasesByCategory(results)
+		sb.WriteString("\nFailed Cases\n")
+		for _, cat := range categories {
+			if ids := failed[cat]; len(ids) > 0 {
+				sb.WriteString(fmt.Sprintf("  %s: %s\n", cat, strings.Join(ids, ", ")))
+			}
+		}
+	}
+
 	return sb.String()
 }
 
-// formatEvalSummaryJSON formats results as JSON
-func formatEvalSummaryJSON(results []EvalResult) string {
+// formatEvalSummaryJSON formats results as JSON, listing warnings when non-nil
+func formatEvalSummaryJSON(results []EvalResult, warnings *Warnings) string {
 	metrics := calculateEvalMetrics(results)
 
 	output := map[string]interface{}{
-		"categories": metrics,
-		"results":    results,
+		"schema_version": reportSchemaVersion,
+		"timestamp":      time.Now().UTC().Format(time.RFC3339),
+		"categories":     metrics,
+		"failed_cases":   failedCasesByCategory(results),
+		"results":        results,
 	}
+	addWarnings(output, warnings)
 
 	data, err := json.MarshalIndent(output, "", "  ")
 	if err != nil {
@@ -269,22 +381,152 @@ func formatEvalSummaryJSON(results []EvalResult) string {
 	return string(data)
 }
 
-// runEvalCommand executes the eval CLI command
+// EvalOptions holds optional settings for the eval command
+type EvalOptions struct {
+	// RerunFailed is the path to a previous `eval --format json` output; only its failed cases are evaluated
+	RerunFailed string
+	// OnlyChangedCategories is the path to a previous `eval --format json` output; only categories
+	// with failures captured in FailuresDB since that run are evaluated
+	OnlyChangedCategories string
+	// FailuresDB is the failures database read for OnlyChangedCategories
+	FailuresDB string
+}
+
+// loadLastEvalTimestamp returns when a previous `eval --format json` output was produced: its
+// timestamp field, or the file's modification time for outputs written before the field existed
+func loadLastEvalTimestamp(logPath string) (time.Time, error) {
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("reading previous results: %w", err)
+	}
+
+	var previous struct {
+		Timestamp string `json:"timestamp"`
+	}
+	if err := json.Unmarshal(data, &previous); err != nil {
+		return time.Time{}, fmt.Errorf("parsing previous results: %w", err)
+	}
+	if previous.Timestamp != "" {
+		timestamp, err := time.Parse(time.RFC3339, previous.Timestamp)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("parsing previous results timestamp: %w", err)
+		}
+		return timestamp, nil
+	}
+
+	info, err := os.Stat(logPath)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("reading previous results: %w", err)
+	}
+	return info.ModTime(), nil
+}
+
+// findChangedCategories returns the sorted categories with failures captured in the database at or after since
+func findChangedCategories(dbPath string, since time.Time) ([]string, error) {
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening failures database: %w", err)
+	}
+	defer store.Close()
+
+	recent, err := store.GetRecent(since)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var categories []string
+	for _, failure := range recent {
+		if !seen[failure.Category] {
+			seen[failure.Category] = true
+			categories = append(categories, failure.Category)
+		}
+	}
+	sort.Strings(categories)
+	return categories, nil
+}
+
+// intersectCategories keeps the categories in changed that are also requested; no request keeps all
+func intersectCategories(changed, requested []string) []string {
+	if len(requested) == 0 {
+		return changed
+	}
+	wanted := make(map[string]bool, len(requested))
+	for _, category := range requested {
+		wanted[category] = true
+	}
+	var kept []string
+	for _, category := range changed {
+		if wanted[category] {
+			kept = append(kept, category)
+		}
+	}
+	return kept
+}
+
+// runEvalCommand executes the eval CLI command. category may be a comma-separated list;
+// empty means all categories.
 func runEvalCommand(failuresDir string, category string, k int, format string) error {
+	return runEvalCommandWithOptions(failuresDir, category, k, format, EvalOptions{})
+}
+
+// runEvalCommandWithOptions executes the eval CLI command with optional settings
+func runEvalCommandWithOptions(failuresDir string, category string, k int, format string, opts EvalOptions) error {
 	// Check if failures directory exists
 	if _, err := os.Stat(failuresDir); os.IsNotExist(err) {
 		return fmt.Errorf("failures directory not found: %s", failuresDir)
 	}
 
+	warnings := newWarnings(format)
+
+	// Unknown categories are warned about but still used as filters
+	categories := parseEvalCategories(category)
+	for _, unknown := range unknownFailureCategories(categories) {
+		warnings.Addf("unknown category %q", unknown)
+	}
+
+	// Narrow to the categories that gained failures since the previous run
+	if opts.OnlyChangedCategories != "" {
+		since, err := loadLastEvalTimestamp(opts.OnlyChangedCategories)
+		if err != nil {
+			return err
+		}
+		changed, err := findChangedCategories(opts.FailuresDB, since)
+		if err != nil {
+			return err
+		}
+		categories = intersectCategories(changed, categories)
+		if len(categories) == 0 {
+			fmt.Printf("No categories with new failures since %s\n", since.UTC().Format(time.RFC3339))
+			return nil
+		}
+		fmt.Printf("Categories with new failures since %s: %s\n", since.UTC().Format(time.RFC3339), strings.Join(categories, ", "))
+	}
+
 	// Find failure cases
-	cases, err := findFailureCases(failuresDir, category)
+	cases, err := findFailureCasesInCategories(failuresDir, categories)
 	if err != nil {
 		return fmt.Errorf("finding failure cases: %w", err)
 	}
 
+	// Narrow to the cases that failed in the previous run
+	if opts.RerunFailed != "" {
+		failedIDs, err := loadFailedCaseIDs(opts.RerunFailed)
+		if err != nil {
+			return err
+		}
+		if len(failedIDs) == 0 {
+			fmt.Printf("No failed cases in %s\n", opts.RerunFailed)
+			return nil
+		}
+		cases = filterFailureCasesByID(cases, failedIDs)
+	}
+
 	if len(cases) == 0 {
-		if category != "" {
-			fmt.Printf("No failure cases found for category: %s\n", category)
+		if len(categories) == 1 {
+			fmt.Printf("No failure cases found for category: %s\n", categories[0])
+		} else if len(categories) > 1 {
+			fmt.Printf("No failure cases found for categories: %s\n", strings.Join(categories, ", "))
 		} else {
 			fmt.Println("No failure cases found")
 		}
@@ -300,7 +542,7 @@ func runEvalCommand(failuresDir string, category string, k int, format string) e
 
 		result, err := runEvaluation(failureCase, k)
 		if err != nil {
-			fmt.Printf("Warning: Failed to evaluate %s: %v\n", failureCase.ID, err)
+			warnings.Addf("Failed to evaluate %s: %v", failureCase.ID, err)
 			continue
 		}
 
@@ -309,8 +551,7 @@ func runEvalCommand(failuresDir string, category string, k int, format string) e
 
 	// Print summary
 	fmt.Println()
-	summary := formatEvalSummary(results, format)
-	fmt.Println(summary)
+	fmt.Println(formatEvalSummary(results, format, warnings))
 
 	return nil
 }
@@ -1,10 +1,15 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
 )
 
 // TestLoadFailureCase tests loading a single failure case from YAML
@@ -288,7 +293,7 @@ func TestFormatEvalSummary(t *testing.T) {
 	}
 
 	// Execute
-	summary := formatEvalSummary(results, "table")
+	summary := formatEvalSummary(results, "table", nil)
 
 	// Verify summary contains expected sections
 	if !strings.Contains(summary, "Eval Results Summary") {
@@ -316,7 +321,7 @@ func TestFormatEvalSummaryJSON(t *testing.T) {
 	}
 
 	// Execute
-	summary := formatEvalSummary(results, "json")
+	summary := formatEvalSummary(results, "json", nil)
 
 	// Verify it's valid JSON (contains expected JSON syntax)
 	if !strings.Contains(summary, "{") || !strings.Contains(summary, "}") {
@@ -464,6 +469,250 @@ eval_criteria:
 	}
 }
 
+// TestRunEvalCommandMultipleCategories verifies a comma-separated --category runs each category,
+// groups results by category and only warns about unknown categories
+func TestRunEvalCommandMultipleCategories(t *testing.T) {
+	tmpDir := t.TempDir()
+	failuresDir := filepath.Join(tmpDir, "failures")
+
+	caseIDs := map[string]string{"missed-tasks": "MT-001", "missing-tests": "TS-001", "scope-creep": "SC-001"}
+	for cat, id := range caseIDs {
+		catDir := filepath.Join(failuresDir, cat)
+		if err := os.MkdirAll(catDir, 0755); err != nil {
+			t.Fatalf("Failed to create category dir: %v", err)
+		}
+
+		content := `id: ` + id + `
+category: ` + cat + `
+discovered: 2026-01-25
+severity: medium
+
+context:
+  task: "Test"
+
+failure:
+  description: "Test"
+  root_cause: "Test"
+
+evidence:
+  task_spec: "Test"
+  what_was_built: "Test"
+
+eval_criteria:
+  - type: code-based
+    check: "test()"
+`
+		if err := os.WriteFile(filepath.Join(catDir, id+".yaml"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write failure case: %v", err)
+		}
+	}
+
+	// Capture stdout
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runEvalCommand(failuresDir, "missing-tests, scope-creep,no-such-category,missing-tests", 1, "json")
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	output := buf.String()
+
+	if err != nil {
+		t.Fatalf("runEvalCommand failed: %v", err)
+	}
+	if !strings.Contains(output, "Found 2 failure case(s)") || strings.Contains(output, "Evaluating MT-001") {
+		t.Errorf("Expected only missing-tests and scope-creep cases, got: %s", output)
+	}
+
+	var summary struct {
+		Categories  map[string]CategoryMetrics `json:"categories"`
+		FailedCases map[string][]string        `json:"failed_cases"`
+		Results     []EvalResult               `json:"results"`
+		Warnings    []string                   `json:"warnings"`
+	}
+	if err := json.Unmarshal([]byte(output[strings.Index(output, "{"):]), &summary); err != nil {
+		t.Fatalf("Summary is not valid JSON: %v\n%s", err, output)
+	}
+	if len(summary.Warnings) != 1 || summary.Warnings[0] != `unknown category "no-such-category"` {
+		t.Errorf("Expected warning for unknown category in JSON output, got: %v", summary.Warnings)
+	}
+	if len(summary.Categories) != 2 || summary.Categories["missing-tests"].Total != 1 || summary.Categories["scope-creep"].Total != 1 {
+		t.Errorf("Expected one case in each requested category, got %+v", summary.Categories)
+	}
+	for cat, ids := range summary.FailedCases {
+		for _, id := range ids {
+			if caseIDs[cat] != id {
+				t.Errorf("Failed case %s grouped under wrong category %s", id, cat)
+			}
+		}
+	}
+
+	// Only unknown categories: warned, but the run completes with no cases
+	if err := runEvalCommand(failuresDir, "no-such-category", 1, "table"); err != nil {
+		t.Errorf("Expected unknown category not to abort, got %v", err)
+	}
+}
+
+// TestFormatEvalSummaryTableFailedCases verifies failed cases are listed under their category
+func TestFormatEvalSummaryTableFailedCases(t *testing.T) {
+	results := []EvalResult{
+		{CaseID: "MT-001", Category: "missing-tests", Runs: []bool{false}},
+		{CaseID: "MT-002", Category: "missing-tests", Runs: []bool{true}},
+		{CaseID: "SC-001", Category: "scope-creep", Runs: []bool{false, false, true}},
+	}
+
+	table := formatEvalSummaryTable(results)
+	for _, want := range []string{"Failed Cases\n", "  missing-tests: MT-001\n", "  scope-creep: SC-001\n"} {
+		if !strings.Contains(table, want) {
+			t.Errorf("Expected table to contain %q, got:\n%s", want, table)
+		}
+	}
+
+	if table := formatEvalSummaryTable(results[1:2]); strings.Contains(table, "Failed Cases") {
+		t.Errorf("Expected no failed cases section when all pass, got:\n%s", table)
+	}
+}
+
+// TestRunEvalCommandOnlyChangedCategories verifies only categories with failures captured since the
+// previous run are re-evaluated
+func TestRunEvalCommandOnlyChangedCategories(t *testing.T) {
+	tmpDir := t.TempDir()
+	failuresDir := filepath.Join(tmpDir, "failures")
+
+	caseIDs := map[string]string{"missing-tests": "TS-001", "scope-creep": "SC-001"}
+	for cat, id := range caseIDs {
+		catDir := filepath.Join(failuresDir, cat)
+		if err := os.MkdirAll(catDir, 0755); err != nil {
+			t.Fatalf("Failed to create category dir: %v", err)
+		}
+		content := `id: ` + id + `
+category: ` + cat + `
+discovered: 2026-01-25
+severity: medium
+
+context:
+  task: "Test"
+
+failure:
+  description: "Test"
+  root_cause: "Test"
+
+evidence:
+  task_spec: "Test"
+  what_was_built: "Test"
+
+eval_criteria:
+  - type: code-based
+    check: "test()"
+`
+		if err := os.WriteFile(filepath.Join(catDir, id+".yaml"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write failure case: %v", err)
+		}
+	}
+
+	// The previous eval ran an hour ago
+	lastRun := time.Now().Add(-time.Hour).UTC()
+	previousLog := filepath.Join(tmpDir, "previous.json")
+	if err := os.WriteFile(previousLog, []byte(`{"timestamp": "`+lastRun.Format(time.RFC3339)+`", "results": []}`), 0644); err != nil {
+		t.Fatalf("Failed to write previous log: %v", err)
+	}
+
+	// scope-creep failures predate the last run; missing-tests gained a new one since
+	dbPath := filepath.Join(tmpDir, "failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewStore failed: %v", err)
+	}
+	for _, failure := range []failures.Failure{
+		{TaskID: "task-1", Category: "scope-creep", Details: "old", CreatedAt: lastRun.Add(-24 * time.Hour)},
+		{TaskID: "task-2", Category: "missing-tests", Details: "old", CreatedAt: lastRun.Add(-24 * time.Hour)},
+		{TaskID: "task-3", Category: "missing-tests", Details: "new", CreatedAt: lastRun.Add(30 * time.Minute)},
+	} {
+		if err := store.Insert(failure); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+	store.Close()
+
+	// Capture stdout
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	opts := EvalOptions{OnlyChangedCategories: previousLog, FailuresDB: dbPath}
+	err = runEvalCommandWithOptions(failuresDir, "", 1, "table", opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	output := buf.String()
+
+	if err != nil {
+		t.Fatalf("runEvalCommandWithOptions failed: %v", err)
+	}
+	if !strings.Contains(output, "Categories with new failures since "+lastRun.Format(time.RFC3339)+": missing-tests") {
+		t.Errorf("Expected missing-tests as the only changed category, got: %s", output)
+	}
+	if !strings.Contains(output, "Evaluating TS-001") || strings.Contains(output, "Evaluating SC-001") {
+		t.Errorf("Expected only missing-tests to be re-evaluated, got: %s", output)
+	}
+
+	// Restricting --category to an unchanged category leaves nothing to run
+	oldStdout = os.Stdout
+	r, w, _ = os.Pipe()
+	os.Stdout = w
+
+	err = runEvalCommandWithOptions(failuresDir, "scope-creep", 1, "table", opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+	buf.Reset()
+	buf.ReadFrom(r)
+	output = buf.String()
+
+	if err != nil {
+		t.Fatalf("runEvalCommandWithOptions failed: %v", err)
+	}
+	if !strings.Contains(output, "No categories with new failures since") || strings.Contains(output, "Evaluating") {
+		t.Errorf("Expected nothing to evaluate, got: %s", output)
+	}
+}
+
+// TestLoadLastEvalTimestamp verifies the timestamp field is used, falling back to the file's modification time
+func TestLoadLastEvalTimestamp(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	withTimestamp := filepath.Join(tmpDir, "with-timestamp.json")
+	if err := os.WriteFile(withTimestamp, []byte(`{"timestamp": "2026-03-01T12:00:00Z"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := loadLastEvalTimestamp(withTimestamp)
+	if err != nil || !got.Equal(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2026-03-01T12:00:00Z, got %v (err %v)", got, err)
+	}
+
+	withoutTimestamp := filepath.Join(tmpDir, "without-timestamp.json")
+	if err := os.WriteFile(withoutTimestamp, []byte(`{"results": []}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	modTime := time.Date(2026, 2, 1, 8, 0, 0, 0, time.UTC)
+	if err := os.Chtimes(withoutTimestamp, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+	got, err = loadLastEvalTimestamp(withoutTimestamp)
+	if err != nil || !got.Equal(modTime) {
+		t.Errorf("Expected modification time %v, got %v (err %v)", modTime, got, err)
+	}
+
+	if _, err := loadLastEvalTimestamp(filepath.Join(tmpDir, "missing.json")); err == nil {
+		t.Error("Expected error for missing previous results")
+	}
+}
+
 // TestRunEvalCommandErrors tests error handling
 func TestRunEvalCommandErrors(t *testing.T) {
 	tests := []struct {
@@ -490,3 +739,78 @@ func TestRunEvalCommandErrors(t *testing.T) {
 		})
 	}
 }
+
+// TestRunEvalCommandRerunFailed verifies only the failed cases from a previous run are re-evaluated
+func TestRunEvalCommandRerunFailed(t *testing.T) {
+	tmpDir := t.TempDir()
+	failuresDir := filepath.Join(tmpDir, "failures")
+	catDir := filepath.Join(failuresDir, "missing-tests")
+	if err := os.MkdirAll(catDir, 0755); err != nil {
+		t.Fatalf("Failed to create category dir: %v", err)
+	}
+
+	for _, id := range []string{"MT-001", "MT-002", "MT-003"} {
+		content := `id: ` + id + `
+category: missing-tests
+discovered: 2026-01-25
+severity: medium
+
+context:
+  task: "Test task"
+
+failure:
+  description: "Test description"
+  root_cause: "Test root cause"
+
+evidence:
+  task_spec: "Test spec"
+  what_was_built: "Test implementation"
+
+eval_criteria:
+  - type: code-based
+    check: "test_check()"
+`
+		if err := os.WriteFile(filepath.Join(catDir, id+".yaml"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write failure case: %v", err)
+		}
+	}
+
+	// MT-001 passed, MT-002 failed, MT-003 failed by majority vote
+	previousLog := filepath.Join(tmpDir, "previous.json")
+	previous := `{
+  "results": [
+    {"CaseID": "MT-001", "Category": "missing-tests", "Runs": [true]},
+    {"CaseID": "MT-002", "Category": "missing-tests", "Runs": [false]},
+    {"CaseID": "MT-003", "Category": "missing-tests", "Runs": [true, false, false]}
+  ]
+}`
+	if err := os.WriteFile(previousLog, []byte(previous), 0644); err != nil {
+		t.Fatalf("Failed to write previous log: %v", err)
+	}
+
+	// Capture stdout
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runEvalCommandWithOptions(failuresDir, "", 1, "table", EvalOptions{RerunFailed: previousLog})
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	output := buf.String()
+
+	if err != nil {
+		t.Fatalf("runEvalCommandWithOptions failed: %v", err)
+	}
+	if !strings.Contains(output, "Found 2 failure case(s)") {
+		t.Errorf("Expected 2 cases to be re-run, got: %s", output)
+	}
+	if !strings.Contains(output, "Evaluating MT-002") || !strings.Contains(output, "Evaluating MT-003") {
+		t.Errorf("Expected failed cases to be re-run, got: %s", output)
+	}
+	if strings.Contains(output, "Evaluating MT-001") {
+		t.Errorf("Passed case should not be re-run, got: %s", output)
+	}
+}
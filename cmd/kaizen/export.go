@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// ExportOptions holds the settings for the export command
+type ExportOptions struct {
+	// Format is the output format: "json" (default) or "csv"
+	Format string
+	// Output is the file to write to (empty writes to stdout)
+	Output string
+	// Category restricts the export to failures in this category
+	Category string
+}
+
+// ExportedFailure is a failure record as written by export
+type ExportedFailure struct {
+	ID        int    `json:"id"`
+	TaskID    string `json:"task_id"`
+	Category  string `json:"category"`
+	Details   string `json:"details"`
+	Source    string `json:"source"`
+	CreatedAt string `json:"created_at"`
+}
+
+// exportCSVHeader is the header row of the CSV export
+var exportCSVHeader = []string{"id", "task_id", "category", "details", "source", "created_at"}
+
+// newExportedFailure converts a stored failure, formatting created_at as RFC3339 in UTC
+func newExportedFailure(f failures.Failure) ExportedFailure {
+	return ExportedFailure{
+		ID:        f.ID,
+		TaskID:    f.TaskID,
+		Category:  f.Category,
+		Details:   f.Details,
+		Source:    f.Source,
+		CreatedAt: f.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// writeFailuresJSON writes failures as a JSON array
+func writeFailuresJSON(w io.Writer, records []ExportedFailure) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(records); err != nil {
+		return fmt.Errorf("encoding failures: %w", err)
+	}
+	return nil
+}
+
+// writeFailuresCSV writes failures as CSV with a header row; fields with commas,
+// quotes or newlines are quoted
+func writeFailuresCSV(w io.Writer, records []ExportedFailure) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(exportCSVHeader); err != nil {
+		return fmt.Errorf("writing CSV header: %w", err)
+	}
+	for _, r := range records {
+		row := []string{strconv.Itoa(r.ID), r.TaskID, r.Category, r.Details, r.Source, r.CreatedAt}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("writing CSV row: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("writing CSV: %w", err)
+	}
+	return nil
+}
+
+// runExportCommandWithConfig exports the failures in the database to JSON or CSV
+func runExportCommandWithConfig(dbPath string, opts ExportOptions) error {
+	format := opts.Format
+	if format == "" {
+		format = "json"
+	}
+	if format != "json" && format != "csv" {
+		return fmt.Errorf("unsupported format: %s (use 'json' or 'csv')", format)
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return fmt.Errorf("opening database: %w", err)
+	}
+	defer store.Close()
+
+	all, err := store.GetAll()
+	if err != nil {
+		return fmt.Errorf("reading failures: %w", err)
+	}
+
+	records := []ExportedFailure{}
+	for _, f := range all {
+		if opts.Category != "" && f.Category != opts.Category {
+			continue
+		}
+		records = append(records, newExportedFailure(f))
+	}
+
+	var w io.Writer = os.Stdout
+	var file *os.File
+	if opts.Output != "" {
+		file, err = os.Create(opts.Output)
+		if err != nil {
+			return fmt.Errorf("creating output file: %w", err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	if format == "csv" {
+		err = writeFailuresCSV(w, records)
+	} else {
+		err = writeFailuresJSON(w, records)
+	}
+	if err != nil {
+		return err
+	}
+
+	if file != nil {
+		if err := file.Close(); err != nil {
+			return fmt.Errorf("writing output file: %w", err)
+		}
+		fmt.Printf("Exported %d failures to %s\n", len(records), opts.Output)
+	}
+	return nil
+}
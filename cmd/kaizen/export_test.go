@@ -0,0 +1,122 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// seedExportStore creates a failures database, inserting the given failures
+func seedExportStore(t *testing.T, records []failures.Failure) string {
+	t.Helper()
+	dbPath := filepath.Join(t.TempDir(), "failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	for _, f := range records {
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+	return dbPath
+}
+
+// TestRunExportCommandJSON verifies the JSON export and the --category filter
+func TestRunExportCommandJSON(t *testing.T) {
+	createdAt := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+	dbPath := seedExportStore(t, []failures.Failure{
+		{TaskID: "task-1", Category: "missing-tests", Details: "no tests", Source: "quality-review", CreatedAt: createdAt},
+		{TaskID: "task-2", Category: "scope-creep", Details: "extra work", Source: "spec-review", CreatedAt: createdAt.Add(time.Hour)},
+	})
+
+	outputPath := filepath.Join(t.TempDir(), "failures.json")
+	opts := ExportOptions{Format: "json", Output: outputPath, Category: "missing-tests"}
+	if err := runExportCommandWithConfig(dbPath, opts); err != nil {
+		t.Fatalf("runExportCommandWithConfig failed: %v", err)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read export: %v", err)
+	}
+	var records []ExportedFailure
+	if err := json.Unmarshal(data, &records); err != nil {
+		t.Fatalf("Export is not valid JSON: %v\n%s", err, data)
+	}
+
+	want := ExportedFailure{ID: 1, TaskID: "task-1", Category: "missing-tests", Details: "no tests", Source: "quality-review", CreatedAt: "2026-03-02T09:30:00Z"}
+	if len(records) != 1 || records[0] != want {
+		t.Errorf("Expected only %+v, got %+v", want, records)
+	}
+	if !strings.Contains(string(data), `"created_at"`) {
+		t.Error("Expected created_at key in JSON export")
+	}
+}
+
+// TestRunExportCommandCSVQuoting verifies details with commas and newlines survive a CSV round trip
+func TestRunExportCommandCSVQuoting(t *testing.T) {
+	details := "expected 3 tests, found 1\nsee \"auth_test.go\""
+	dbPath := seedExportStore(t, []failures.Failure{
+		{TaskID: "task-1", Category: "missing-tests", Details: details, Source: "quality-review", CreatedAt: time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)},
+	})
+
+	outputPath := filepath.Join(t.TempDir(), "failures.csv")
+	if err := runExportCommandWithConfig(dbPath, ExportOptions{Format: "csv", Output: outputPath}); err != nil {
+		t.Fatalf("runExportCommandWithConfig failed: %v", err)
+	}
+
+	file, err := os.Open(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to open export: %v", err)
+	}
+	defer file.Close()
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		t.Fatalf("Export is not valid CSV: %v", err)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("Expected header and 1 row, got %d rows", len(rows))
+	}
+	if strings.Join(rows[0], ",") != "id,task_id,category,details,source,created_at" {
+		t.Errorf("Unexpected header: %v", rows[0])
+	}
+	if rows[1][3] != details {
+		t.Errorf("Expected details %q, got %q", details, rows[1][3])
+	}
+}
+
+// TestRunExportCommandEmpty verifies an empty database exports an empty array or a header-only CSV
+func TestRunExportCommandEmpty(t *testing.T) {
+	dbPath := seedExportStore(t, nil)
+	dir := t.TempDir()
+
+	jsonPath := filepath.Join(dir, "empty.json")
+	if err := runExportCommandWithConfig(dbPath, ExportOptions{Format: "json", Output: jsonPath}); err != nil {
+		t.Fatalf("JSON export of empty database failed: %v", err)
+	}
+	if data, _ := os.ReadFile(jsonPath); strings.TrimSpace(string(data)) != "[]" {
+		t.Errorf("Expected empty JSON array, got %q", data)
+	}
+
+	csvPath := filepath.Join(dir, "empty.csv")
+	if err := runExportCommandWithConfig(dbPath, ExportOptions{Format: "csv", Output: csvPath}); err != nil {
+		t.Fatalf("CSV export of empty database failed: %v", err)
+	}
+	if data, _ := os.ReadFile(csvPath); string(data) != "id,task_id,category,details,source,created_at\n" {
+		t.Errorf("Expected header-only CSV, got %q", data)
+	}
+
+	if err := runExportCommandWithConfig(dbPath, ExportOptions{Format: "xml"}); err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+//go:build !unix
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+)
+
+// lockFile takes an exclusive lock on path by creating path+".lock", retrying until timeout while
+// another process holds it. The returned function releases the lock. Without flock a lock left by
+// a crashed run is not taken over automatically, since removing it could race with a live holder;
+// the timeout error says to remove it by hand.
+func lockFile(path string, timeout time.Duration) (func(), error) {
+	lockPath := path + ".lock"
+	deadline := time.Now().Add(timeout)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			f.Close()
+			return func() { os.Remove(lockPath) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("creating lock file: %w", err)
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out after %s waiting for lock %s (remove it if no other kaizen process is running)", timeout, lockPath)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
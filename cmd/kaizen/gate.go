@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // ConsistencyResult represents a consistency evaluation result from meta-evals
@@ -17,6 +21,30 @@ type ConsistencyResult struct {
 	TotalCount            int     `json:"total_count"`
 }
 
+// metaAgentKeyFunc returns a function naming each result by its agent. Agents evaluated at more
+// than one boundary level are qualified with the boundary type, e.g. "spec-reviewer (epic)", so
+// results at different levels are not collapsed together.
+func metaAgentKeyFunc(results []ConsistencyResult) func(ConsistencyResult) string {
+	boundaries := make(map[string]map[string]bool)
+	for _, result := range results {
+		if boundaries[result.Agent] == nil {
+			boundaries[result.Agent] = make(map[string]bool)
+		}
+		boundaries[result.Agent][result.BoundaryType] = true
+	}
+
+	return func(result ConsistencyResult) string {
+		if len(boundaries[result.Agent]) <= 1 {
+			return result.Agent
+		}
+		boundaryType := result.BoundaryType
+		if boundaryType == "" {
+			boundaryType = "unspecified"
+		}
+		return fmt.Sprintf("%s (%s)", result.Agent, boundaryType)
+	}
+}
+
 // loadEvalResults loads eval results from task-eval-log.json
 func loadEvalResults(logPath string) ([]GradeTaskOutput, error) {
 	data, err := os.ReadFile(logPath)
@@ -32,6 +60,136 @@ func loadEvalResults(logPath string) ([]GradeTaskOutput, error) {
 	return results, nil
 }
 
+// evalLogFilename is the eval log grade-task appends to in the reports directory
+const evalLogFilename = "task-eval-log.json"
+
+// logLockTimeout is how long an append to the eval or meta log waits for another process's lock
+var logLockTimeout = 30 * time.Second
+
+// writeFileAtomic writes data to a temporary file next to path and renames it over path, so
+// readers never see a partially written file
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// appendEvalResult appends a result to the eval log, creating the log if needed.
+// If the result has a run ID that is already present in the log, the append is a
+// no-op so retried CI jobs don't produce duplicate entries.
+// The log is locked for the read-modify-write and replaced atomically, so parallel
+// grade-task runs don't lose entries or corrupt the JSON array.
+// Returns true if the result was written.
+func appendEvalResult(logPath string, result GradeTaskOutput) (bool, error) {
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return false, fmt.Errorf("creating eval log directory: %w", err)
+	}
+
+	unlock, err := lockFile(logPath, logLockTimeout)
+	if err != nil {
+		return false, fmt.Errorf("locking eval log: %w", err)
+	}
+	defer unlock()
+
+	var results []GradeTaskOutput
+	if _, err := os.Stat(logPath); err == nil {
+		existing, err := loadEvalResults(logPath)
+		if err != nil {
+			return false, err
+		}
+		results = existing
+	}
+
+	if result.RunID != "" {
+		for _, existing := range results {
+			if existing.RunID == result.RunID {
+				return false, nil
+			}
+		}
+	}
+
+	results = append(results, result)
+
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return false, fmt.Errorf("encoding eval log: %w", err)
+	}
+
+	if err := writeFileAtomic(logPath, data); err != nil {
+		return false, fmt.Errorf("writing eval log: %w", err)
+	}
+
+	return true, nil
+}
+
+// appendMetaResult appends a result to the consistency log, creating the log if needed
+func appendMetaResult(logPath string, result ConsistencyResult) error {
+	if err := os.MkdirAll(filepath.Dir(logPath), 0755); err != nil {
+		return fmt.Errorf("creating meta log directory: %w", err)
+	}
+
+	unlock, err := lockFile(logPath, logLockTimeout)
+	if err != nil {
+		return fmt.Errorf("locking meta log: %w", err)
+	}
+	defer unlock()
+
+	var results []ConsistencyResult
+	if _, err := os.Stat(logPath); err == nil {
+		existing, err := loadMetaResults(logPath)
+		if err != nil {
+			return err
+		}
+		results = existing
+	}
+
+	results = append(results, result)
+
+	data, err := json.MarshalIndent(results, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding meta log: %w", err)
+	}
+
+	if err := writeFileAtomic(logPath, data); err != nil {
+		return fmt.Errorf("writing meta log: %w", err)
+	}
+
+	return nil
+}
+
+// applyPassThreshold returns a copy of results with OverallPassed recomputed
+// from OverallScore, so a changed threshold re-evaluates historical entries
+func applyPassThreshold(results []GradeTaskOutput, threshold float64) []GradeTaskOutput {
+	recomputed := make([]GradeTaskOutput, len(results))
+	for i, result := range results {
+		result.OverallPassed = result.OverallScore >= threshold
+		recomputed[i] = result
+	}
+	return recomputed
+}
+
+// validatePassThreshold checks that a pass threshold is a valid percentage
+func validatePassThreshold(threshold float64) error {
+	if threshold < 0.0 || threshold > 100.0 {
+		return fmt.Errorf("pass-threshold must be between 0 and 100, got: %.1f", threshold)
+	}
+	return nil
+}
+
 // loadMetaResults loads meta-eval results from consistency-log.json
 func loadMetaResults(logPath string) ([]ConsistencyResult, error) {
 	data, err := os.ReadFile(logPath)
@@ -49,16 +207,8 @@ func loadMetaResults(logPath string) ([]ConsistencyResult, error) {
 
 // calculateEvalGateScore calculates the average overall score from eval results
 func calculateEvalGateScore(results []GradeTaskOutput) float64 {
-	if len(results) == 0 {
-		return 0.0
-	}
-
-	totalScore := 0.0
-	for _, result := range results {
-		totalScore += result.OverallScore
-	}
-
-	return totalScore / float64(len(results))
+	avgScore, _, _ := summarizeEvalResults(results)
+	return avgScore
 }
 
 // calculateMetaGateScore calculates the average consistency percentage from meta results
@@ -75,6 +225,30 @@ func calculateMetaGateScore(results []ConsistencyResult) float64 {
 	return totalConsistency / float64(len(results))
 }
 
+// findAgentsBelowMinRuns returns the agents whose latest result has fewer than minRuns runs.
+// Agents are returned sorted by name for stable output.
+func findAgentsBelowMinRuns(results []ConsistencyResult, minRuns int) []ConsistencyResult {
+	// Keep the last occurrence for each agent and boundary level
+	agentKey := metaAgentKeyFunc(results)
+	latestByAgent := make(map[string]ConsistencyResult)
+	for _, result := range results {
+		latestByAgent[agentKey(result)] = result
+	}
+
+	var below []ConsistencyResult
+	for _, result := range latestByAgent {
+		if result.TotalCount < minRuns {
+			below = append(below, result)
+		}
+	}
+
+	sort.Slice(below, func(i, j int) bool {
+		return agentKey(below[i]) < agentKey(below[j])
+	})
+
+	return below
+}
+
 // checkGate checks if a score meets the threshold
 func checkGate(score, threshold float64) bool {
 	return score >= threshold
@@ -93,13 +267,158 @@ func formatGateResult(checkType string, score, threshold float64, pass bool) str
 		symbol, checkType, score, threshold, status)
 }
 
+// untaggedGateCategory is the category of eval entries without tags
+const untaggedGateCategory = "untagged"
+
+// parseCategoryThresholds parses --thresholds pairs like "security=99,docs=70"
+func parseCategoryThresholds(spec string) (map[string]float64, error) {
+	thresholds := make(map[string]float64)
+	for _, pair := range strings.Split(spec, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid threshold %q (use category=value)", pair)
+		}
+		threshold, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold for %s: %q", name, value)
+		}
+		if threshold < 0.0 || threshold > 100.0 {
+			return nil, fmt.Errorf("threshold for %s must be between 0 and 100, got: %.1f", name, threshold)
+		}
+		if _, exists := thresholds[name]; exists {
+			return nil, fmt.Errorf("duplicate threshold for %s", name)
+		}
+		thresholds[name] = threshold
+	}
+	return thresholds, nil
+}
+
+// CategoryGateResult is the eval gate outcome for one category (eval entry tag)
+type CategoryGateResult struct {
+	Category  string  `json:"category"`
+	Threshold float64 `json:"threshold"`
+	AvgScore  float64 `json:"avg_score"`
+	Entries   int     `json:"entries"`
+	Passed    bool    `json:"passed"`
+	// Shortfall is how far AvgScore is below Threshold (0 when passed)
+	Shortfall float64 `json:"shortfall"`
+}
+
+// evaluateCategoryGates checks the average score of each eval category against its threshold.
+// Categories are entry tags; untagged entries form the "untagged" category, and an entry with
+// several tags counts toward each. Categories without a specific threshold use defaultThreshold.
+// A category with a specific threshold but no entries fails. Results are sorted by category.
+func evaluateCategoryGates(results []GradeTaskOutput, thresholds map[string]float64, defaultThreshold float64) []CategoryGateResult {
+	byCategory := make(map[string][]GradeTaskOutput)
+	for category := range thresholds {
+		byCategory[category] = nil
+	}
+	for _, result := range results {
+		if len(result.Tags) == 0 {
+			byCategory[untaggedGateCategory] = append(byCategory[untaggedGateCategory], result)
+			continue
+		}
+		for _, tag := range result.Tags {
+			byCategory[tag] = append(byCategory[tag], result)
+		}
+	}
+
+	gates := make([]CategoryGateResult, 0, len(byCategory))
+	for category, entries := range byCategory {
+		threshold, ok := thresholds[category]
+		if !ok {
+			threshold = defaultThreshold
+		}
+		gate := CategoryGateResult{Category: category, Threshold: threshold, Entries: len(entries)}
+		if len(entries) > 0 {
+			gate.AvgScore = calculateEvalGateScore(entries)
+			gate.Passed = checkGate(gate.AvgScore, threshold)
+		}
+		if !gate.Passed {
+			gate.Shortfall = threshold - gate.AvgScore
+		}
+		gates = append(gates, gate)
+	}
+
+	sort.Slice(gates, func(i, j int) bool {
+		return gates[i].Category < gates[j].Category
+	})
+	return gates
+}
+
+// GateOptions holds optional settings for the gate command
+type GateOptions struct {
+	// MinRuns is the minimum total_count each agent needs for the meta gate (0 disables the check)
+	MinRuns int
+	// Format is the output format: "text" (default) or "json"
+	Format string
+	// RecomputePassed re-evaluates each eval entry's pass/fail against PassThreshold
+	// instead of trusting the stored overall_passed
+	RecomputePassed bool
+	PassThreshold   float64
+	// CategoryThresholds gates each eval category (entry tag) separately, replacing the
+	// aggregate eval check; unlisted categories use the global threshold
+	CategoryThresholds map[string]float64
+}
+
+// GateJSONResult is the structured gate output for CI logging.
+// Actual values are only set for the checks that ran.
+type GateJSONResult struct {
+	Type              string   `json:"type"`
+	Threshold         float64  `json:"threshold"`
+	ActualPassRate    *float64 `json:"actual_pass_rate,omitempty"`
+	ActualAvgScore    *float64 `json:"actual_avg_score,omitempty"`
+	ActualConsistency *float64 `json:"actual_consistency,omitempty"`
+	BelowMinRuns      []string `json:"below_min_runs,omitempty"`
+	// Categories holds the per-category eval gates when category thresholds are set
+	Categories []CategoryGateResult `json:"categories,omitempty"`
+	Passed     bool                 `json:"passed"`
+}
+
 // runGateCommand executes the gate check command
 func runGateCommand(checkType string, threshold float64, reportsDir string) error {
+	return runGateCommandWithOptions(checkType, threshold, reportsDir, GateOptions{})
+}
+
+// runGateCommandWithOptions executes the gate check command with optional settings
+func runGateCommandWithOptions(checkType string, threshold float64, reportsDir string, opts GateOptions) error {
 	// Validate threshold
 	if threshold < 0.0 || threshold > 100.0 {
 		return fmt.Errorf("threshold must be between 0 and 100, got: %.1f", threshold)
 	}
 
+	// Validate minimum runs
+	if opts.MinRuns < 0 {
+		return fmt.Errorf("min-runs must be non-negative, got: %d", opts.MinRuns)
+	}
+
+	if opts.RecomputePassed {
+		if err := validatePassThreshold(opts.PassThreshold); err != nil {
+			return err
+		}
+	}
+
+	for category, categoryThreshold := range opts.CategoryThresholds {
+		if categoryThreshold < 0.0 || categoryThreshold > 100.0 {
+			return fmt.Errorf("threshold for %s must be between 0 and 100, got: %.1f", category, categoryThreshold)
+		}
+	}
+
+	// Validate output format
+	format := opts.Format
+	if format == "" {
+		format = "text"
+	}
+	if format != "text" && format != "json" {
+		return fmt.Errorf("unsupported format: %s (use 'text' or 'json')", format)
+	}
+	textOutput := format == "text"
+
 	// Validate check type
 	validTypes := []string{"eval", "meta", "all"}
 	isValid := false
@@ -113,13 +432,15 @@ func runGateCommand(checkType string, threshold float64, reportsDir string) erro
 		return fmt.Errorf("invalid type: %s (must be 'eval', 'meta', or 'all')", checkType)
 	}
 
-	fmt.Printf("Release Gate Check\n")
-	fmt.Printf("==================\n\n")
-	fmt.Printf("Threshold: %.1f%%\n", threshold)
-	fmt.Printf("Reports directory: %s\n\n", reportsDir)
+	if textOutput {
+		fmt.Printf("Release Gate Check\n")
+		fmt.Printf("==================\n\n")
+		fmt.Printf("Threshold: %.1f%%\n", threshold)
+		fmt.Printf("Reports directory: %s\n\n", reportsDir)
+	}
 
 	allPass := true
-	results := []string{}
+	jsonResult := GateJSONResult{Type: checkType, Threshold: threshold}
 
 	// Check eval results if requested
 	if checkType == "eval" || checkType == "all" {
@@ -133,15 +454,43 @@ func runGateCommand(checkType string, threshold float64, reportsDir string) erro
 			return fmt.Errorf("no eval results found in %s", evalLogPath)
 		}
 
-		evalScore := calculateEvalGateScore(evalResults)
-		evalPass := checkGate(evalScore, threshold)
-
-		result := formatGateResult("eval", evalScore, threshold, evalPass)
-		results = append(results, result)
-		fmt.Println(result)
+		if opts.RecomputePassed {
+			evalResults = applyPassThreshold(evalResults, opts.PassThreshold)
+		}
 
-		if !evalPass {
-			allPass = false
+		evalScore, evalPassRate, _ := summarizeEvalResults(evalResults)
+		jsonResult.ActualAvgScore = &evalScore
+		jsonResult.ActualPassRate = &evalPassRate
+
+		if len(opts.CategoryThresholds) > 0 {
+			// Each category is held to its own threshold instead of the aggregate score
+			jsonResult.Categories = evaluateCategoryGates(evalResults, opts.CategoryThresholds, threshold)
+			var failed []string
+			for _, gate := range jsonResult.Categories {
+				if textOutput {
+					if gate.Entries == 0 {
+						fmt.Printf("[✗] eval gate [%s]: no entries (threshold: %.1f%%) - FAIL\n", gate.Category, gate.Threshold)
+					} else {
+						fmt.Println(formatGateResult(fmt.Sprintf("eval [%s]", gate.Category), gate.AvgScore, gate.Threshold, gate.Passed))
+					}
+				}
+				if !gate.Passed {
+					failed = append(failed, fmt.Sprintf("%s (short by %.1f%%)", gate.Category, gate.Shortfall))
+					allPass = false
+				}
+			}
+			if textOutput && len(failed) > 0 {
+				fmt.Printf("Failed categories: %s\n", strings.Join(failed, ", "))
+			}
+		} else {
+			evalPass := checkGate(evalScore, threshold)
+			if textOutput {
+				fmt.Println(formatGateResult("eval", evalScore, threshold, evalPass))
+			}
+
+			if !evalPass {
+				allPass = false
+			}
 		}
 	}
 
@@ -159,23 +508,49 @@ func runGateCommand(checkType string, threshold float64, reportsDir string) erro
 
 		metaScore := calculateMetaGateScore(metaResults)
 		metaPass := checkGate(metaScore, threshold)
+		jsonResult.ActualConsistency = &metaScore
 
-		result := formatGateResult("meta", metaScore, threshold, metaPass)
-		results = append(results, result)
-		fmt.Println(result)
+		if textOutput {
+			fmt.Println(formatGateResult("meta", metaScore, threshold, metaPass))
+		}
 
 		if !metaPass {
 			allPass = false
 		}
+
+		// A high consistency from only a handful of runs is not meaningful
+		if opts.MinRuns > 0 {
+			agentKey := metaAgentKeyFunc(metaResults)
+			for _, agentResult := range findAgentsBelowMinRuns(metaResults, opts.MinRuns) {
+				jsonResult.BelowMinRuns = append(jsonResult.BelowMinRuns, agentKey(agentResult))
+				if textOutput {
+					fmt.Printf("[✗] meta gate: %s has %d runs (minimum: %d) - FAIL\n",
+						agentKey(agentResult), agentResult.TotalCount, opts.MinRuns)
+				}
+				allPass = false
+			}
+		}
 	}
 
-	// Print overall result
-	fmt.Printf("\n")
-	if allPass {
-		fmt.Printf("Overall: PASS - Release gate checks passed\n")
-		return nil
+	jsonResult.Passed = allPass
+	if textOutput {
+		// Print overall result
+		fmt.Printf("\n")
+		if allPass {
+			fmt.Printf("Overall: PASS - Release gate checks passed\n")
+		} else {
+			fmt.Printf("Overall: FAIL - Release gate checks failed\n")
+		}
 	} else {
-		fmt.Printf("Overall: FAIL - Release gate checks failed\n")
+		data, err := json.MarshalIndent(jsonResult, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding gate result: %w", err)
+		}
+		fmt.Println(string(data))
+	}
+
+	if !allPass {
 		return fmt.Errorf("release gate check failed: one or more checks did not meet threshold")
 	}
+	return nil
 }
@@ -1,11 +1,15 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 // TestLoadEvalResults tests loading eval results from JSON log
@@ -50,6 +54,149 @@ func TestLoadEvalResults(t *testing.T) {
 	}
 }
 
+// TestAppendEvalResult_IdempotentRunID tests that appending the same run ID twice keeps one entry
+func TestAppendEvalResult_IdempotentRunID(t *testing.T) {
+	tmpDir := t.TempDir()
+	evalLog := filepath.Join(tmpDir, "reports", "task-eval-log.json")
+
+	entry := GradeTaskOutput{
+		TaskID:        "test-task-001",
+		Timestamp:     "2026-01-27T18:29:07Z",
+		OverallPassed: true,
+		OverallScore:  100.0,
+		RunID:         "ci-job-42",
+	}
+
+	written, err := appendEvalResult(evalLog, entry)
+	if err != nil {
+		t.Fatalf("First append failed: %v", err)
+	}
+	if !written {
+		t.Error("Expected first append to write the entry")
+	}
+
+	// Simulate a CI retry appending the same run again
+	written, err = appendEvalResult(evalLog, entry)
+	if err != nil {
+		t.Fatalf("Second append failed: %v", err)
+	}
+	if written {
+		t.Error("Expected second append with the same run_id to be a no-op")
+	}
+
+	results, err := loadEvalResults(evalLog)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 entry after duplicate append, got %d", len(results))
+	}
+	if results[0].RunID != "ci-job-42" {
+		t.Errorf("Expected run_id 'ci-job-42', got %q", results[0].RunID)
+	}
+
+	// Entries without a run ID are always appended
+	entry.RunID = ""
+	for i := 0; i < 2; i++ {
+		if _, err := appendEvalResult(evalLog, entry); err != nil {
+			t.Fatalf("Append without run_id failed: %v", err)
+		}
+	}
+
+	results, err = loadEvalResults(evalLog)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+	if len(results) != 3 {
+		t.Errorf("Expected 3 entries, got %d", len(results))
+	}
+}
+
+// TestAppendEvalResult_Concurrent verifies parallel appends to one log keep every entry
+func TestAppendEvalResult_Concurrent(t *testing.T) {
+	evalLog := filepath.Join(t.TempDir(), "reports", "task-eval-log.json")
+
+	const runs = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, runs)
+	for i := 0; i < runs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			entry := GradeTaskOutput{TaskID: "task", RunID: fmt.Sprintf("job-%d", i)}
+			if _, err := appendEvalResult(evalLog, entry); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("Concurrent append failed: %v", err)
+	}
+
+	results, err := loadEvalResults(evalLog)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+	if len(results) != runs {
+		t.Errorf("Expected %d entries, got %d", runs, len(results))
+	}
+}
+
+// TestAppendEvalResult_HeldLock verifies an append waits for a held lock, times out, and succeeds once it is released
+func TestAppendEvalResult_HeldLock(t *testing.T) {
+	evalLog := filepath.Join(t.TempDir(), "task-eval-log.json")
+
+	origTimeout := logLockTimeout
+	logLockTimeout = 50 * time.Millisecond
+	defer func() { logLockTimeout = origTimeout }()
+
+	unlock, err := lockFile(evalLog, time.Second)
+	if err != nil {
+		t.Fatalf("lockFile failed: %v", err)
+	}
+	if _, err := appendEvalResult(evalLog, GradeTaskOutput{TaskID: "task"}); err == nil || !strings.Contains(err.Error(), "locking eval log") {
+		t.Errorf("Expected a lock timeout while the lock is held, got %v", err)
+	}
+
+	unlock()
+	if _, err := appendEvalResult(evalLog, GradeTaskOutput{TaskID: "task"}); err != nil {
+		t.Fatalf("Expected the append to succeed once the lock is released, got %v", err)
+	}
+}
+
+// TestAppendMetaResult_Concurrent verifies parallel appends to the consistency log keep every entry
+func TestAppendMetaResult_Concurrent(t *testing.T) {
+	metaLog := filepath.Join(t.TempDir(), "reports", "consistency-log.json")
+
+	const runs = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, runs)
+	for i := 0; i < runs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := appendMetaResult(metaLog, ConsistencyResult{Agent: fmt.Sprintf("agent-%d", i)}); err != nil {
+				errs <- err
+			}
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatalf("Concurrent append failed: %v", err)
+	}
+
+	results, err := loadMetaResults(metaLog)
+	if err != nil {
+		t.Fatalf("loadMetaResults failed: %v", err)
+	}
+	if len(results) != runs {
+		t.Errorf("Expected %d entries, got %d", runs, len(results))
+	}
+}
+
 // TestLoadMetaResults tests loading meta-eval results from JSON log
 func TestLoadMetaResults(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -305,6 +452,44 @@ func TestRunGateCommand_Meta(t *testing.T) {
 	}
 }
 
+// TestRunGateCommand_MetaMinRuns tests that agents with too few runs fail the meta gate
+func TestRunGateCommand_MetaMinRuns(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaLog := filepath.Join(tmpDir, "consistency-log.json")
+
+	// One agent is 100% consistent but from only 2 runs
+	metaData := []ConsistencyResult{
+		{Agent: "yokay-spec-reviewer", ConsistencyPercentage: 100.0, ConsistentCount: 2, TotalCount: 2},
+		{Agent: "yokay-quality-reviewer", ConsistencyPercentage: 100.0, ConsistentCount: 10, TotalCount: 10},
+	}
+	data, _ := json.Marshal(metaData)
+	os.WriteFile(metaLog, data, 0644)
+
+	// Without min-runs the percentage alone passes
+	if err := runGateCommandWithOptions("meta", 95.0, tmpDir, GateOptions{}); err != nil {
+		t.Errorf("Expected gate to pass without min-runs, got error: %v", err)
+	}
+
+	// With min-runs 5 the 2-run agent fails the gate
+	err := runGateCommandWithOptions("meta", 95.0, tmpDir, GateOptions{MinRuns: 5})
+	if err == nil {
+		t.Error("Expected gate to fail for agent below min-runs, got nil")
+	}
+
+	below := findAgentsBelowMinRuns(metaData, 5)
+	if len(below) != 1 || below[0].Agent != "yokay-spec-reviewer" {
+		t.Errorf("Expected only yokay-spec-reviewer below min-runs, got %+v", below)
+	}
+}
+
+// TestRunGateCommand_MetaMinRunsInvalid tests that a negative min-runs is rejected
+func TestRunGateCommand_MetaMinRunsInvalid(t *testing.T) {
+	err := runGateCommandWithOptions("meta", 95.0, t.TempDir(), GateOptions{MinRuns: -1})
+	if err == nil || !strings.Contains(err.Error(), "min-runs") {
+		t.Errorf("Expected min-runs validation error, got: %v", err)
+	}
+}
+
 // TestRunGateCommand_All tests gate command with both eval and meta
 func TestRunGateCommand_All(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -331,6 +516,152 @@ func TestRunGateCommand_All(t *testing.T) {
 	}
 }
 
+// TestRunGateCommand_EvalJSON tests that --format json reports the actual eval values against the threshold
+func TestRunGateCommand_EvalJSON(t *testing.T) {
+	tmpDir := t.TempDir()
+	evalLog := filepath.Join(tmpDir, "task-eval-log.json")
+
+	evalData := []GradeTaskOutput{
+		{TaskID: "task-1", OverallPassed: true, OverallScore: 100.0},
+		{TaskID: "task-2", OverallPassed: true, OverallScore: 95.0},
+		{TaskID: "task-3", OverallPassed: false, OverallScore: 60.0},
+		{TaskID: "task-4", OverallPassed: true, OverallScore: 85.0},
+	}
+	data, _ := json.Marshal(evalData)
+	os.WriteFile(evalLog, data, 0644)
+
+	// Capture stdout
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runGateCommandWithOptions("eval", 90.0, tmpDir, GateOptions{Format: "json"})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err == nil {
+		t.Error("Expected gate to fail below threshold, got nil")
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+	}
+
+	// Actual values must match the seeded log: avg (100+95+60+85)/4 = 85, pass rate 3/4 = 75%
+	expected := map[string]interface{}{
+		"type":             "eval",
+		"threshold":        90.0,
+		"actual_avg_score": 85.0,
+		"actual_pass_rate": 75.0,
+		"passed":           false,
+	}
+	for key, want := range expected {
+		if got, ok := result[key]; !ok || got != want {
+			t.Errorf("Expected %s=%v, got %v", key, want, got)
+		}
+	}
+	if _, ok := result["actual_consistency"]; ok {
+		t.Error("Expected no actual_consistency for an eval-only gate")
+	}
+}
+
+// TestApplyPassThreshold_RecomputesHistory tests that historical entries are re-judged against a new pass threshold
+func TestApplyPassThreshold_RecomputesHistory(t *testing.T) {
+	tmpDir := t.TempDir()
+	evalLog := filepath.Join(tmpDir, "task-eval-log.json")
+
+	// Stored booleans were baked in at grade time and disagree with the scores
+	evalData := []GradeTaskOutput{
+		{TaskID: "task-1", OverallPassed: false, OverallScore: 95.0},
+		{TaskID: "task-2", OverallPassed: true, OverallScore: 80.0},
+		{TaskID: "task-3", OverallPassed: true, OverallScore: 65.0},
+		{TaskID: "task-4", OverallPassed: true, OverallScore: 40.0},
+	}
+	data, _ := json.Marshal(evalData)
+	os.WriteFile(evalLog, data, 0644)
+
+	results, err := loadEvalResults(evalLog)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+
+	tests := []struct {
+		threshold    float64
+		wantPassRate float64
+	}{
+		{threshold: 70.0, wantPassRate: 50.0},
+		{threshold: 60.0, wantPassRate: 75.0},
+	}
+
+	for _, tt := range tests {
+		_, passRate, _ := summarizeEvalResults(applyPassThreshold(results, tt.threshold))
+		if passRate != tt.wantPassRate {
+			t.Errorf("threshold %.0f: expected pass rate %.1f, got %.1f", tt.threshold, tt.wantPassRate, passRate)
+		}
+	}
+
+	// The loaded results must not be modified
+	if results[0].OverallPassed {
+		t.Error("applyPassThreshold should not modify its input")
+	}
+}
+
+// TestRunGateCommand_PassThresholdJSON tests that --pass-threshold recomputes the pass rate reported by the gate
+func TestRunGateCommand_PassThresholdJSON(t *testing.T) {
+	tmpDir := t.TempDir()
+	evalLog := filepath.Join(tmpDir, "task-eval-log.json")
+
+	evalData := []GradeTaskOutput{
+		{TaskID: "task-1", OverallPassed: false, OverallScore: 90.0},
+		{TaskID: "task-2", OverallPassed: false, OverallScore: 70.0},
+	}
+	data, _ := json.Marshal(evalData)
+	os.WriteFile(evalLog, data, 0644)
+
+	// Capture stdout
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runGateCommandWithOptions("eval", 50.0, tmpDir, GateOptions{Format: "json", RecomputePassed: true, PassThreshold: 80.0})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Errorf("Expected gate to pass, got error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	var result GateJSONResult
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v", err)
+	}
+	if result.ActualPassRate == nil || *result.ActualPassRate != 50.0 {
+		t.Errorf("Expected recomputed pass rate 50.0, got %v", result.ActualPassRate)
+	}
+
+	err = runGateCommandWithOptions("eval", 50.0, tmpDir, GateOptions{RecomputePassed: true, PassThreshold: 150.0})
+	if err == nil || !strings.Contains(err.Error(), "pass-threshold") {
+		t.Errorf("Expected pass-threshold validation error, got %v", err)
+	}
+}
+
+// TestRunGateCommand_InvalidFormat tests that an unknown --format is rejected
+func TestRunGateCommand_InvalidFormat(t *testing.T) {
+	err := runGateCommandWithOptions("eval", 90.0, t.TempDir(), GateOptions{Format: "xml"})
+	if err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+}
+
 // TestRunGateCommand_AllPartialFail tests gate with one type failing
 func TestRunGateCommand_AllPartialFail(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -446,3 +777,114 @@ func TestFormatGateResult(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCategoryThresholds(t *testing.T) {
+	thresholds, err := parseCategoryThresholds(" security=99, docs = 70.5 ,")
+	if err != nil {
+		t.Fatalf("parseCategoryThresholds failed: %v", err)
+	}
+	if len(thresholds) != 2 || thresholds["security"] != 99 || thresholds["docs"] != 70.5 {
+		t.Errorf("Unexpected thresholds: %v", thresholds)
+	}
+
+	if thresholds, err := parseCategoryThresholds(""); err != nil || len(thresholds) != 0 {
+		t.Errorf("Expected empty thresholds for empty spec, got %v, %v", thresholds, err)
+	}
+
+	for _, spec := range []string{"security", "=90", "security=high", "security=101", "docs=70,docs=80"} {
+		if _, err := parseCategoryThresholds(spec); err == nil {
+			t.Errorf("Expected error for %q", spec)
+		}
+	}
+}
+
+func TestRunGateCommand_CategoryThresholds(t *testing.T) {
+	tmpDir := t.TempDir()
+	evalLog := filepath.Join(tmpDir, "task-eval-log.json")
+
+	evalData := []GradeTaskOutput{
+		{TaskID: "sec-1", OverallScore: 100.0, Tags: []string{"security"}},
+		{TaskID: "sec-2", OverallScore: 96.0, Tags: []string{"security"}},
+		{TaskID: "docs-1", OverallScore: 72.0, Tags: []string{"docs"}},
+		{TaskID: "api-1", OverallScore: 97.0, Tags: []string{"api"}},
+		{TaskID: "misc-1", OverallScore: 96.0},
+	}
+	data, _ := json.Marshal(evalData)
+	os.WriteFile(evalLog, data, 0644)
+
+	// docs passes its looser threshold even though the aggregate (92.2) is below 95
+	thresholds := map[string]float64{"security": 95, "docs": 70}
+	if err := runGateCommandWithOptions("eval", 95.0, tmpDir, GateOptions{CategoryThresholds: thresholds}); err != nil {
+		t.Errorf("Expected per-category gate to pass, got error: %v", err)
+	}
+
+	// security now falls short of its stricter threshold
+	thresholds["security"] = 99
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runGateCommandWithOptions("eval", 95.0, tmpDir, GateOptions{CategoryThresholds: thresholds})
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	if err == nil {
+		t.Fatal("Expected gate to fail when security is below its threshold")
+	}
+	output := buf.String()
+	for _, want := range []string{"eval [security] gate: 98.0% (threshold: 99.0%) - FAIL", "eval [docs] gate: 72.0% (threshold: 70.0%) - PASS", "eval [untagged] gate: 96.0% (threshold: 95.0%) - PASS", "Failed categories: security (short by 1.0%)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestRunGateCommand_CategoryThresholdsJSON(t *testing.T) {
+	tmpDir := t.TempDir()
+	evalLog := filepath.Join(tmpDir, "task-eval-log.json")
+
+	evalData := []GradeTaskOutput{
+		{TaskID: "docs-1", OverallScore: 60.0, Tags: []string{"docs"}},
+		{TaskID: "api-1", OverallScore: 97.0, Tags: []string{"api"}},
+	}
+	data, _ := json.Marshal(evalData)
+	os.WriteFile(evalLog, data, 0644)
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	opts := GateOptions{Format: "json", CategoryThresholds: map[string]float64{"docs": 70, "security": 99}}
+	err := runGateCommandWithOptions("eval", 95.0, tmpDir, opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	if err == nil {
+		t.Error("Expected gate to fail")
+	}
+
+	var result GateJSONResult
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+	}
+
+	want := []CategoryGateResult{
+		{Category: "api", Threshold: 95, AvgScore: 97, Entries: 1, Passed: true},
+		{Category: "docs", Threshold: 70, AvgScore: 60, Entries: 1, Passed: false, Shortfall: 10},
+		{Category: "security", Threshold: 99, Entries: 0, Passed: false, Shortfall: 99},
+	}
+	if len(result.Categories) != len(want) {
+		t.Fatalf("Expected %d categories, got %+v", len(want), result.Categories)
+	}
+	for i, gate := range result.Categories {
+		if gate != want[i] {
+			t.Errorf("Category %d: expected %+v, got %+v", i, want[i], gate)
+		}
+	}
+}
@@ -9,18 +9,39 @@ import (
 	"github.com/srstomp/kaizen/internal/graders/codebased"
 	"github.com/srstomp/kaizen/internal/graders/modelbased"
 	"github.com/srstomp/kaizen/internal/harness"
+	"github.com/srstomp/kaizen/internal/llm"
 )
 
 // GradeOutput represents the JSON output from the grade command
 type GradeOutput struct {
-	Grader  string  `json:"grader"`
-	Passed  bool    `json:"passed"`
-	Score   float64 `json:"score"`
-	Message string  `json:"message"`
+	Grader        string  `json:"grader"`
+	GraderVersion string  `json:"grader_version,omitempty"`
+	Model         string  `json:"model,omitempty"`
+	Passed        bool    `json:"passed"`
+	Score         float64 `json:"score"`
+	Message       string  `json:"message"`
+}
+
+// GradeOptions holds optional settings for the grade command
+type GradeOptions struct {
+	// Model is the LLM model used by model-based graders (default: modelbased.DefaultModel)
+	Model string
+	// VagueScopeKeywords overrides the task-quality grader's vague-scope words when non-nil
+	VagueScopeKeywords []string
+	// AcceptanceMarkers overrides the task-quality grader's acceptance-section headings when non-nil
+	AcceptanceMarkers []string
+	// LLMClient evaluates with model-based graders; nil keeps the heuristic evaluation,
+	// so no model is reported
+	LLMClient llm.Client
 }
 
 // runGradeCommand executes a single grader on a single input
 func runGradeCommand(grader, inputPath, spec, format string) error {
+	return runGradeCommandWithOptions(grader, inputPath, spec, format, GradeOptions{})
+}
+
+// runGradeCommandWithOptions executes a single grader on a single input using the given options
+func runGradeCommandWithOptions(grader, inputPath, spec, format string, opts GradeOptions) error {
 	// Support both hyphen and underscore variants
 	normalizedGraderUnderscore := strings.ReplaceAll(grader, "-", "_")
 	normalizedGraderHyphen := strings.ReplaceAll(grader, "_", "-")
@@ -56,7 +77,50 @@ func runGradeCommand(grader, inputPath, spec, format string) error {
 		return runCodeBasedGrader(codeGrader, inputData, format)
 	}
 
-	return runModelBasedGrader(modelGrader, inputData, spec, format, normalizedGraderUnderscore)
+	model := opts.Model
+	if model == "" {
+		model = modelbased.DefaultModel
+	}
+	setGraderModel(modelGrader, model)
+	if opts.LLMClient == nil {
+		model = ""
+	} else {
+		setGraderLLMClient(modelGrader, opts.LLMClient)
+	}
+	if g, ok := modelGrader.(*modelbased.TaskQualityGrader); ok {
+		if opts.VagueScopeKeywords != nil {
+			g.WithVagueScopeKeywords(opts.VagueScopeKeywords)
+		}
+		if opts.AcceptanceMarkers != nil {
+			g.WithAcceptanceMarkers(opts.AcceptanceMarkers)
+		}
+	}
+
+	return runModelBasedGrader(modelGrader, inputData, spec, format, normalizedGraderUnderscore, model)
+}
+
+// setGraderModel selects the LLM model used by a model-based grader
+func setGraderModel(grader modelbased.Grader, model string) {
+	switch g := grader.(type) {
+	case *modelbased.SpecComplianceGrader:
+		g.WithModel(model)
+	case *modelbased.TaskQualityGrader:
+		g.WithModel(model)
+	case *modelbased.SkillClarityGrader:
+		g.WithModel(model)
+	}
+}
+
+// setGraderLLMClient attaches the LLM client used by a model-based grader
+func setGraderLLMClient(grader modelbased.Grader, client llm.Client) {
+	switch g := grader.(type) {
+	case *modelbased.SpecComplianceGrader:
+		g.WithLLMClient(client)
+	case *modelbased.TaskQualityGrader:
+		g.WithLLMClient(client)
+	case *modelbased.SkillClarityGrader:
+		g.WithLLMClient(client)
+	}
 }
 
 // runCodeBasedGrader executes a code-based grader
@@ -73,10 +137,11 @@ func runCodeBasedGrader(grader codebased.CodeGrader, inputData []byte, format st
 	// Format output
 	if format == "json" {
 		output := GradeOutput{
-			Grader:  grader.Name(),
-			Passed:  result.Passed,
-			Score:   result.Score,
-			Message: result.Details,
+			Grader:        grader.Name(),
+			GraderVersion: result.GraderVersion,
+			Passed:        result.Passed,
+			Score:         result.Score,
+			Message:       result.Details,
 		}
 
 		encoder := json.NewEncoder(os.Stdout)
@@ -87,6 +152,7 @@ func runCodeBasedGrader(grader codebased.CodeGrader, inputData []byte, format st
 	} else {
 		// Text format
 		fmt.Printf("Grader: %s\n", grader.Name())
+		fmt.Printf("Version: %s\n", result.GraderVersion)
 
 		status := "PASS"
 		if result.Skipped {
@@ -109,7 +175,7 @@ func runCodeBasedGrader(grader codebased.CodeGrader, inputData []byte, format st
 }
 
 // runModelBasedGrader executes a model-based grader
-func runModelBasedGrader(grader modelbased.Grader, inputData []byte, spec, format, graderName string) error {
+func runModelBasedGrader(grader modelbased.Grader, inputData []byte, spec, format, graderName, model string) error {
 	// Parse model-based input
 	var input modelbased.GradeInput
 	if err := json.Unmarshal(inputData, &input); err != nil {
@@ -133,10 +199,12 @@ func runModelBasedGrader(grader modelbased.Grader, inputData []byte, spec, forma
 	// Format output
 	if format == "json" {
 		output := GradeOutput{
-			Grader:  graderName,
-			Passed:  result.Passed,
-			Score:   result.Score,
-			Message: result.Message,
+			Grader:        graderName,
+			GraderVersion: result.GraderVersion,
+			Model:         model,
+			Passed:        result.Passed,
+			Score:         result.Score,
+			Message:       result.Message,
 		}
 
 		encoder := json.NewEncoder(os.Stdout)
@@ -147,6 +215,10 @@ func runModelBasedGrader(grader modelbased.Grader, inputData []byte, spec, forma
 	} else {
 		// Text format
 		fmt.Printf("Grader: %s\n", graderName)
+		fmt.Printf("Version: %s\n", result.GraderVersion)
+		if model != "" {
+			fmt.Printf("Model: %s\n", model)
+		}
 
 		status := "PASS"
 		if !result.Passed {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -740,3 +741,148 @@ func TestRunGradeTaskQualityCommand_Suggestion(t *testing.T) {
 		t.Errorf("Suggestion should mention brainstorm: %s", result.Suggestion)
 	}
 }
+
+// captureGradeTaskQuality runs grade-task-quality for a task titled "Investigate login" and returns stdout
+func captureGradeTaskQuality(t *testing.T, taskType, description, acceptanceCriteria, format string, verbose bool) string {
+	t.Helper()
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runGradeTaskQualityWithOptions("test-task", "Investigate login", taskType, description, acceptanceCriteria, 100, format, TaskQualityOptions{Verbose: verbose})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("runGradeTaskQualityWithOptions failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	return buf.String()
+}
+
+// TestRunGradeTaskQualityCommand_Verbose tests that --verbose explains the score per check
+func TestRunGradeTaskQualityCommand_Verbose(t *testing.T) {
+	longDescription := strings.Repeat("a", 100)
+
+	output := captureGradeTaskQuality(t, "feature", longDescription, "", "json", true)
+
+	var result TaskQualityOutput
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, output)
+	}
+
+	expected := []TaskQualityCheck{
+		{Name: "description_length", Passed: true, Points: 25},
+		{Name: "acceptance_criteria", Passed: false, Points: 0},
+		{Name: "ambiguous_keywords", Passed: false, Points: 0},
+		{Name: "spike_type", Passed: true, Points: 25},
+	}
+	if len(result.Checks) != len(expected) {
+		t.Fatalf("Expected %d checks, got %+v", len(expected), result.Checks)
+	}
+	total := 0.0
+	for i, check := range result.Checks {
+		if check != expected[i] {
+			t.Errorf("Check %d: expected %+v, got %+v", i, expected[i], check)
+		}
+		total += check.Points
+	}
+	if total != result.Score {
+		t.Errorf("Expected check points to add up to score %.1f, got %.1f", result.Score, total)
+	}
+
+	text := captureGradeTaskQuality(t, "feature", longDescription, "", "text", true)
+	for _, want := range []string{
+		"Checks:\n",
+		"  PASS description_length   25.0 points\n",
+		"  FAIL acceptance_criteria   0.0 points\n",
+		"  FAIL ambiguous_keywords    0.0 points\n",
+		"  PASS spike_type           25.0 points\n",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected text output to contain %q, got:\n%s", want, text)
+		}
+	}
+}
+
+// TestRunGradeTaskQualityCommand_NotVerbose tests that output without --verbose is unchanged
+func TestRunGradeTaskQualityCommand_NotVerbose(t *testing.T) {
+	longDescription := strings.Repeat("a", 100)
+
+	output := captureGradeTaskQuality(t, "bug", longDescription, "", "json", false)
+	expectedJSON := `{
+  "task_id": "test-task",
+  "passed": false,
+  "score": 75,
+  "issues": [
+    {
+      "check": "ambiguous_keywords",
+      "message": "Contains ambiguous keyword 'investigate' - task may be too vague"
+    }
+  ],
+  "suggestion": "Run /pokayokay:brainstorm to refine task requirements"
+}
+`
+	if output != expectedJSON {
+		t.Errorf("Expected JSON output:\n%s\ngot:\n%s", expectedJSON, output)
+	}
+
+	text := captureGradeTaskQuality(t, "bug", longDescription, "", "text", false)
+	if strings.Contains(text, "Checks:") || strings.Contains(text, "points") {
+		t.Errorf("Expected no per-check output without --verbose, got:\n%s", text)
+	}
+	if !strings.Contains(text, "Score: 75.0/100\n\nStatus: FAILED\n") {
+		t.Errorf("Expected score followed by status, got:\n%s", text)
+	}
+}
+
+// TestRunGradeTaskQualityCommand_Output tests that --output writes the result to a file in a new
+// directory and prints a one-line summary instead
+func TestRunGradeTaskQualityCommand_Output(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), "artifacts", "quality.json")
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runGradeTaskQualityWithOptions("test-task", "Add login", "bug", strings.Repeat("a", 100), "", 100, "json", TaskQualityOptions{Output: outputPath})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("runGradeTaskQualityWithOptions failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	if want := "Task test-task quality: PASSED (score 100.0/100, 0 issues); result written to " + outputPath + "\n"; buf.String() != want {
+		t.Errorf("Expected summary %q on stdout, got %q", want, buf.String())
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output file: %v", err)
+	}
+	var result TaskQualityOutput
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("Output file is not valid JSON: %v\n%s", err, data)
+	}
+	if !result.Passed || result.TaskID != "test-task" {
+		t.Errorf("Unexpected result in output file: %+v", result)
+	}
+
+	// A parent path that is a file can't be created
+	blocker := filepath.Join(t.TempDir(), "blocker")
+	if err := os.WriteFile(blocker, nil, 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	err = runGradeTaskQualityWithOptions("test-task", "Add login", "bug", strings.Repeat("a", 100), "", 100, "json", TaskQualityOptions{Output: filepath.Join(blocker, "quality.json")})
+	if err == nil || !strings.Contains(err.Error(), "creating output directory") {
+		t.Errorf("Expected an output directory error, got %v", err)
+	}
+}
@@ -3,10 +3,14 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"flag"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/srstomp/kaizen/internal/graders/codebased"
 )
 
 // TestRunGradeTaskCommand_InvalidTaskType tests that invalid task types are rejected
@@ -132,27 +136,86 @@ func TestRunGradeTaskCommand_OverallScoreCalculation(t *testing.T) {
 		t.Fatalf("Failed to parse JSON output: %v", err)
 	}
 
-	// Calculate expected overall score (average of applicable graders)
-	var totalScore float64
-	applicableCount := 0
+	// Calculate expected overall score (weighted average of applicable graders, from the reported weights)
+	var totalScore, totalWeight float64
 	for _, r := range result.Results {
+		if r.Weight <= 0 {
+			t.Errorf("Expected a weight for %s in JSON output, got %v", r.GraderName, r.Weight)
+		}
 		if !r.Skipped {
-			totalScore += r.Score
-			applicableCount++
+			totalScore += r.Score * r.Weight
+			totalWeight += r.Weight
 		}
 	}
 
 	expectedScore := float64(0)
-	if applicableCount > 0 {
-		expectedScore = totalScore / float64(applicableCount)
+	if totalWeight > 0 {
+		expectedScore = totalScore / totalWeight
 	}
 
-	// Verify overall score is the average of applicable graders
+	// Verify overall score is the weighted average of applicable graders
 	if result.OverallScore != expectedScore {
 		t.Errorf("Expected overall score %.2f, got %.2f", expectedScore, result.OverallScore)
 	}
 }
 
+// TestWeightedOverallScore tests that graders count by weight and skipped graders not at all
+func TestWeightedOverallScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []codebased.GradeResult
+		want    float64
+	}{
+		{
+			name: "heavier grader counts more",
+			results: []codebased.GradeResult{
+				{GraderName: "file-exists", Score: 100, Weight: 1},
+				{GraderName: "test-exists", Score: 40, Weight: 2},
+			},
+			want: 60,
+		},
+		{
+			name: "equal weights are a plain average",
+			results: []codebased.GradeResult{
+				{GraderName: "a", Score: 100, Weight: 3},
+				{GraderName: "b", Score: 50, Weight: 3},
+			},
+			want: 75,
+		},
+		{
+			name: "skipped graders are excluded from numerator and denominator",
+			results: []codebased.GradeResult{
+				{GraderName: "file-exists", Score: 80, Weight: 1},
+				{GraderName: "test-exists", Score: 0, Weight: 2, Skipped: true},
+			},
+			want: 80,
+		},
+		{
+			name: "missing weight counts as the default",
+			results: []codebased.GradeResult{
+				{GraderName: "a", Score: 100},
+				{GraderName: "b", Score: 50, Weight: 1},
+			},
+			want: 75,
+		},
+		{
+			name: "all skipped scores zero",
+			results: []codebased.GradeResult{
+				{GraderName: "a", Score: 100, Weight: 1, Skipped: true},
+			},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := weightedOverallScore(tt.results); got != tt.want {
+				t.Errorf("Expected overall score %.2f, got %.2f", tt.want, got)
+			}
+		})
+	}
+}
+
 // TestRunGradeTaskCommand_SkippedGradersIgnored tests that skipped graders don't affect score
 func TestRunGradeTaskCommand_SkippedGradersIgnored(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -218,6 +281,176 @@ func TestRunGradeTaskCommand_SkippedGradersIgnored(t *testing.T) {
 	}
 }
 
+// TestRunGradeTaskCommand_AllGradersSkipped tests that a task every grader skips reports
+// no applicable graders instead of passing with a score of 0
+func TestRunGradeTaskCommand_AllGradersSkipped(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	for _, format := range []string{"json", "text"} {
+		t.Run(format, func(t *testing.T) {
+			oldStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			// A spike with no changed files: file-exists has nothing to check and test-exists doesn't apply
+			err := runGradeTaskCommand("docs-spike", "spike", nil, tmpDir, format)
+
+			w.Close()
+			os.Stdout = oldStdout
+
+			if err != nil {
+				t.Fatalf("runGradeTaskCommand failed: %v", err)
+			}
+
+			var buf bytes.Buffer
+			buf.ReadFrom(r)
+			output := buf.String()
+
+			if format == "text" {
+				if !strings.Contains(output, "Overall Result: NO APPLICABLE GRADERS") {
+					t.Errorf("Expected a no applicable graders result, got:\n%s", output)
+				}
+				if strings.Contains(output, "PASS") {
+					t.Errorf("Expected no PASS when every grader skipped, got:\n%s", output)
+				}
+				return
+			}
+
+			var result GradeTaskOutput
+			if err := json.Unmarshal([]byte(output), &result); err != nil {
+				t.Fatalf("Failed to parse JSON output: %v", err)
+			}
+			for _, r := range result.Results {
+				if !r.Skipped {
+					t.Fatalf("Expected every grader to skip, %s ran", r.GraderName)
+				}
+			}
+			if result.Status != gradeStatusNoApplicableGraders {
+				t.Errorf("Expected status %q, got %q", gradeStatusNoApplicableGraders, result.Status)
+			}
+			if result.OverallPassed {
+				t.Error("Expected overall_passed=false when every grader skipped")
+			}
+		})
+	}
+}
+
+// TestRunGradeTaskCommand_Output tests that --output writes the chosen format to a file in a
+// new directory while stdout gets a one-line summary
+func TestRunGradeTaskCommand_Output(t *testing.T) {
+	tmpDir := t.TempDir()
+	codeFile := filepath.Join(tmpDir, "main.go")
+	if err := os.WriteFile(codeFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	for _, format := range []string{"json", "text"} {
+		t.Run(format, func(t *testing.T) {
+			outputPath := filepath.Join(t.TempDir(), "artifacts", "grade."+format)
+
+			oldStdout := os.Stdout
+			r, w, _ := os.Pipe()
+			os.Stdout = w
+
+			opts := GradeTaskOptions{Output: outputPath, Graders: []string{"file-exists", "test-exists"}}
+			err := runGradeTaskCommandWithOptions("task-1", "feature", []string{codeFile}, tmpDir, format, opts)
+
+			w.Close()
+			os.Stdout = oldStdout
+
+			if err != nil {
+				t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+			}
+
+			var buf bytes.Buffer
+			buf.ReadFrom(r)
+			summary := buf.String()
+			// main.go has no test file, so test-exists fails
+			want := "Task task-1: FAIL (score 33.3, 2 graders run, 0 skipped); results written to " + outputPath + "\n"
+			if summary != want {
+				t.Errorf("Expected summary %q on stdout, got %q", want, summary)
+			}
+
+			data, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatalf("Failed to read output file: %v", err)
+			}
+			if format == "text" {
+				if !strings.Contains(string(data), "Overall Result: FAIL") {
+					t.Errorf("Expected the text report in the output file, got:\n%s", data)
+				}
+				return
+			}
+			var result GradeTaskOutput
+			if err := json.Unmarshal(data, &result); err != nil {
+				t.Fatalf("Output file is not valid JSON: %v\n%s", err, data)
+			}
+			if result.TaskID != "task-1" || result.Status != gradeStatusFail {
+				t.Errorf("Unexpected result in output file: %+v", result)
+			}
+		})
+	}
+
+	// Write failures are reported
+	blocker := filepath.Join(tmpDir, "blocker")
+	if err := os.WriteFile(blocker, nil, 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	err := runGradeTaskCommandWithOptions("task-1", "feature", []string{codeFile}, tmpDir, "json", GradeTaskOptions{Output: filepath.Join(blocker, "grade.json")})
+	if err == nil || !strings.Contains(err.Error(), "creating output directory") {
+		t.Errorf("Expected an output directory error, got %v", err)
+	}
+}
+
+// TestRunGradeTaskCommand_AllGradersSkippedNotLogged tests that a run every grader skips is left
+// out of the eval log, so the gate average is unchanged
+func TestRunGradeTaskCommand_AllGradersSkippedNotLogged(t *testing.T) {
+	tmpDir := t.TempDir()
+	evalLog := filepath.Join(tmpDir, "task-eval-log.json")
+	readme := filepath.Join(tmpDir, "README.md")
+	if err := os.WriteFile(readme, []byte("# Notes\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	runGradeTask := func(taskID, taskType string, changedFiles []string) {
+		t.Helper()
+
+		oldStdout := os.Stdout
+		_, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err := runGradeTaskCommandWithOptions(taskID, taskType, changedFiles, tmpDir, "json", GradeTaskOptions{EvalLogPath: evalLog})
+
+		w.Close()
+		os.Stdout = oldStdout
+
+		if err != nil {
+			t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+		}
+	}
+
+	runGradeTask("docs-task", "chore", []string{readme})
+	before, err := loadEvalResults(evalLog)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+	if len(before) != 1 {
+		t.Fatalf("Expected 1 logged run, got %d", len(before))
+	}
+
+	runGradeTask("docs-spike", "spike", nil)
+	after, err := loadEvalResults(evalLog)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+	if len(after) != 1 {
+		t.Errorf("Expected the all-skipped run to be left out of the eval log, got %d runs", len(after))
+	}
+	if got, want := calculateEvalGateScore(after), calculateEvalGateScore(before); got != want {
+		t.Errorf("Expected gate average %.1f to be unchanged, got %.1f", want, got)
+	}
+}
+
 // TestRunGradeTaskCommand_OverallPassedLogic tests overall passed calculation
 func TestRunGradeTaskCommand_OverallPassedLogic(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -267,7 +500,8 @@ func TestRunGradeTaskCommand_OverallPassedLogic(t *testing.T) {
 			r, w, _ := os.Pipe()
 			os.Stdout = w
 
-			err := runGradeTaskCommand("test-task", tc.taskType, filePaths, tmpDir, "json")
+			opts := GradeTaskOptions{Graders: []string{"file-exists", "test-exists"}}
+			err := runGradeTaskCommandWithOptions("test-task", tc.taskType, filePaths, tmpDir, "json", opts)
 
 			w.Close()
 			os.Stdout = oldStdout
@@ -289,6 +523,13 @@ func TestRunGradeTaskCommand_OverallPassedLogic(t *testing.T) {
 				t.Errorf("Expected overall_passed=%v, got %v", tc.expectPassed, result.OverallPassed)
 				t.Logf("Results: %+v", result.Results)
 			}
+			expectStatus := gradeStatusFail
+			if tc.expectPassed {
+				expectStatus = gradeStatusPass
+			}
+			if result.Status != expectStatus {
+				t.Errorf("Expected status %q, got %q", expectStatus, result.Status)
+			}
 		})
 	}
 }
@@ -349,6 +590,486 @@ func TestRunGradeTaskCommand_JSONOutput(t *testing.T) {
 	}
 }
 
+// TestRunGradeTaskCommand_TagsLogged tests that run tags are stored in the eval log
+func TestRunGradeTaskCommand_TagsLogged(t *testing.T) {
+	tmpDir := t.TempDir()
+	evalLog := filepath.Join(tmpDir, "reports", "task-eval-log.json")
+
+	testFile := filepath.Join(tmpDir, "test.go")
+	if err := os.WriteFile(testFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	// Discard stdout
+	oldStdout := os.Stdout
+	_, w, _ := os.Pipe()
+	os.Stdout = w
+
+	opts := GradeTaskOptions{EvalLogPath: evalLog, Tags: []string{"nightly", "pre-release"}}
+	err := runGradeTaskCommandWithOptions("test-123", "feature", []string{testFile}, tmpDir, "json", opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+	}
+
+	results, err := loadEvalResults(evalLog)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 logged result, got %d", len(results))
+	}
+	if strings.Join(results[0].Tags, ",") != "nightly,pre-release" {
+		t.Errorf("Expected tags [nightly pre-release], got %v", results[0].Tags)
+	}
+}
+
+// TestRunGradeTaskCommand_ConfiguredPipeline tests that a configured pipeline selects the graders to run
+func TestRunGradeTaskCommand_ConfiguredPipeline(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	configYAML := `grader_pipelines:
+  feature: [file-exists, debug-statements]
+`
+	if err := os.WriteFile(configPath, []byte(configYAML), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	config, err := loadConfig(configPath)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+
+	testFile := filepath.Join(tmpDir, "handler.go")
+	if err := os.WriteFile(testFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	runGradeTask := func(taskType string) GradeTaskOutput {
+		t.Helper()
+
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		opts := GradeTaskOptions{GraderPipelines: config.GraderPipelines}
+		err := runGradeTaskCommandWithOptions("test-123", taskType, []string{testFile}, tmpDir, "json", opts)
+
+		w.Close()
+		os.Stdout = oldStdout
+
+		if err != nil {
+			t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+		}
+
+		var buf bytes.Buffer
+		buf.ReadFrom(r)
+
+		var result GradeTaskOutput
+		if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+			t.Fatalf("Failed to parse JSON output: %v", err)
+		}
+		return result
+	}
+
+	graderNames := func(result GradeTaskOutput) string {
+		var names []string
+		for _, r := range result.Results {
+			names = append(names, r.GraderName)
+		}
+		return strings.Join(names, ",")
+	}
+
+	// Configured type runs exactly the configured graders
+	if got := graderNames(runGradeTask("feature")); got != "file-exists,debug-statements" {
+		t.Errorf("Expected feature pipeline file-exists,debug-statements, got %s", got)
+	}
+
+	// Unconfigured type falls back to the default pipeline
+	if got := graderNames(runGradeTask("bug")); got != "file-exists,test-exists,endpoint-exists" {
+		t.Errorf("Expected default pipeline file-exists,test-exists,endpoint-exists, got %s", got)
+	}
+}
+
+// TestRunGradeTaskCommand_ConfiguredPipelineOverridesTaskType tests that a grader named in a
+// configured pipeline runs for a task type it would otherwise skip
+func TestRunGradeTaskCommand_ConfiguredPipelineOverridesTaskType(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	testFile := filepath.Join(tmpDir, "handler.go")
+	if err := os.WriteFile(testFile, []byte("package handler\n\nimport \"fmt\"\n\nfunc Handle() {\n\tfmt.Println(\"debug\")\n}\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	runGradeTask := func(opts GradeTaskOptions) codebased.GradeResult {
+		t.Helper()
+
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err := runGradeTaskCommandWithOptions("test-123", "spike", []string{testFile}, tmpDir, "json", opts)
+
+		w.Close()
+		os.Stdout = oldStdout
+
+		if err != nil {
+			t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+		}
+
+		var buf bytes.Buffer
+		buf.ReadFrom(r)
+
+		var result GradeTaskOutput
+		if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+			t.Fatalf("Failed to parse JSON output: %v", err)
+		}
+		for _, r := range result.Results {
+			if r.GraderName == "debug-statements" {
+				return r
+			}
+		}
+		t.Fatalf("Expected a debug-statements result, got %+v", result.Results)
+		return codebased.GradeResult{}
+	}
+
+	// debug-statements does not apply to spikes by default
+	input := codebased.GradeInput{TaskType: "spike", ChangedFiles: []string{testFile}, WorkDir: tmpDir}
+	if result := codebased.NewDebugStatementGrader().Grade(input); !result.Skipped {
+		t.Fatalf("Expected debug-statements to skip a spike by default, got %+v", result)
+	}
+
+	result := runGradeTask(GradeTaskOptions{GraderPipelines: map[string][]string{"spike": {"file-exists", "debug-statements"}}})
+	if result.Skipped {
+		t.Fatalf("Expected the configured debug-statements grader to run for a spike, got skip: %s", result.SkipReason)
+	}
+	if result.Passed {
+		t.Errorf("Expected debug-statements to fail on the fmt.Println, got %+v", result)
+	}
+}
+
+// TestRunGradeTaskCommand_GradersAllowlist tests that --graders overrides the configured pipeline
+func TestRunGradeTaskCommand_GradersAllowlist(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "routes.js")
+	if err := os.WriteFile(testFile, []byte("app.get('/health', handler);\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	opts := GradeTaskOptions{
+		GraderPipelines: map[string][]string{"feature": {"test-exists"}},
+		Graders:         []string{"endpoint-exists", "file-exists"},
+	}
+	err := runGradeTaskCommandWithOptions("test-123", "feature", []string{testFile}, tmpDir, "json", opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	var result GradeTaskOutput
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v", err)
+	}
+
+	var names []string
+	for _, r := range result.Results {
+		names = append(names, r.GraderName)
+	}
+	if got := strings.Join(names, ","); got != "endpoint-exists,file-exists" {
+		t.Errorf("Expected graders endpoint-exists,file-exists, got %s", got)
+	}
+	if !result.OverallPassed {
+		t.Errorf("Expected overall pass, got %+v", result.Results)
+	}
+}
+
+// TestSelectGraders_Invalid tests that unknown or empty --graders lists are rejected
+func TestSelectGraders_Invalid(t *testing.T) {
+	_, err := selectGraders([]string{"file-exists", "no-such-grader"})
+	if err == nil || !strings.Contains(err.Error(), "no-such-grader") || !strings.Contains(err.Error(), "endpoint-exists") {
+		t.Errorf("Expected unknown grader error listing available graders, got %v", err)
+	}
+
+	if _, err := selectGraders([]string{}); err == nil {
+		t.Error("Expected error for empty --graders list")
+	}
+}
+
+// TestSelectGraders_All tests that --graders all selects every registered grader
+func TestSelectGraders_All(t *testing.T) {
+	graders, err := selectGraders([]string{"all"})
+	if err != nil {
+		t.Fatalf("selectGraders failed: %v", err)
+	}
+
+	var names []string
+	for _, grader := range graders {
+		names = append(names, grader.Name())
+	}
+	if got, want := strings.Join(names, ","), strings.Join(codebased.Names(), ","); got != want {
+		t.Errorf("Expected every registered grader %s, got %s", want, got)
+	}
+}
+
+// TestRunGradeTaskCommand_DisableGrader tests that a disabled grader is absent from the results and the score
+func TestRunGradeTaskCommand_DisableGrader(t *testing.T) {
+	tmpDir := t.TempDir()
+	codeFile := filepath.Join(tmpDir, "service.go")
+	if err := os.WriteFile(codeFile, []byte("package service\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	// service.go has no test, so test-exists would fail the task if it ran
+	opts := GradeTaskOptions{Graders: []string{"file-exists", "test-exists"}, DisabledGraders: []string{"test-exists"}}
+	err := runGradeTaskCommandWithOptions("test-123", "feature", []string{codeFile}, tmpDir, "json", opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	var result GradeTaskOutput
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v", err)
+	}
+
+	if len(result.Results) != 1 || result.Results[0].GraderName != "file-exists" {
+		t.Fatalf("Expected only file-exists in results, got %+v", result.Results)
+	}
+	if !result.OverallPassed || result.OverallScore != 100 {
+		t.Errorf("Expected disabled grader not to affect the score, got passed=%v score=%.1f", result.OverallPassed, result.OverallScore)
+	}
+}
+
+// TestFilterGraders tests --only-grader and --disable-grader filtering of the pipeline
+func TestFilterGraders(t *testing.T) {
+	pipeline, err := selectGraders([]string{"file-exists", "test-exists", "endpoint-exists"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	names := func(graders []codebased.CodeGrader) string {
+		var names []string
+		for _, grader := range graders {
+			names = append(names, grader.Name())
+		}
+		return strings.Join(names, ",")
+	}
+
+	tests := []struct {
+		name     string
+		only     []string
+		disabled []string
+		want     string
+		wantErr  string
+	}{
+		{name: "no filters", want: "file-exists,test-exists,endpoint-exists"},
+		{name: "only keeps pipeline order", only: []string{"endpoint-exists", "file-exists"}, want: "file-exists,endpoint-exists"},
+		{name: "disable", disabled: []string{"test-exists"}, want: "file-exists,endpoint-exists"},
+		{name: "disable wins over only", only: []string{"file-exists", "test-exists"}, disabled: []string{"test-exists"}, want: "file-exists"},
+		{name: "unknown grader", disabled: []string{"no-such-grader"}, wantErr: `unknown grader "no-such-grader" in --disable-grader`},
+		{name: "nothing left", only: []string{"sql-injection"}, wantErr: "no graders left"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graders, err := filterGraders(pipeline, tt.only, tt.disabled)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("filterGraders failed: %v", err)
+			}
+			if got := names(graders); got != tt.want {
+				t.Errorf("Expected graders %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestRepeatedFlag tests that a repeated flag collects every value
+func TestRepeatedFlag(t *testing.T) {
+	var disabled repeatedFlag
+	fs := flag.NewFlagSet("grade-task", flag.ContinueOnError)
+	fs.Var(&disabled, "disable-grader", "")
+	if err := fs.Parse([]string{"--disable-grader", "test-exists", "--disable-grader= endpoint-exists "}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got := disabled.String(); got != "test-exists,endpoint-exists" {
+		t.Errorf("Expected test-exists,endpoint-exists, got %s", got)
+	}
+}
+
+// TestRunGradeTaskCommand_GraderSettings tests that configured grader settings switch
+// debug-statements to warnings and flaky-tests to failures
+func TestRunGradeTaskCommand_GraderSettings(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"handler.go":      "package handler\n\nimport \"fmt\"\n\nfunc Handle() {\n\tfmt.Println(\"debug\")\n}\n",
+		"handler_test.go": "package handler\n\nimport (\n\t\"testing\"\n\t\"time\"\n)\n\nfunc TestHandle(t *testing.T) {\n\ttime.Sleep(time.Second)\n}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	runGradeTask := func(settings GradersConfig) map[string]codebased.GradeResult {
+		t.Helper()
+
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		opts := GradeTaskOptions{Graders: []string{"debug-statements", "flaky-tests"}, GraderSettings: settings}
+		err := runGradeTaskCommandWithOptions("test-123", "feature", []string{"handler.go", "handler_test.go"}, tmpDir, "json", opts)
+
+		w.Close()
+		os.Stdout = oldStdout
+
+		if err != nil {
+			t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+		}
+
+		var buf bytes.Buffer
+		buf.ReadFrom(r)
+		var result GradeTaskOutput
+		if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+			t.Fatalf("Failed to parse JSON output: %v", err)
+		}
+		byName := make(map[string]codebased.GradeResult)
+		for _, r := range result.Results {
+			byName[r.GraderName] = r
+		}
+		return byName
+	}
+
+	// Defaults: debug statements fail, flaky patterns only warn
+	results := runGradeTask(GradersConfig{})
+	if results["debug-statements"].Passed {
+		t.Errorf("Expected debug-statements to fail by default, got %+v", results["debug-statements"])
+	}
+	if !results["flaky-tests"].Passed {
+		t.Errorf("Expected flaky-tests to warn by default, got %+v", results["flaky-tests"])
+	}
+
+	settings := GradersConfig{
+		DebugStatements: DebugStatementsConfig{WarnOnly: true},
+		FlakyTests:      FlakyTestsConfig{FailOnFindings: true},
+	}
+	results = runGradeTask(settings)
+	if debug := results["debug-statements"]; !debug.Passed || !strings.HasPrefix(debug.Details, "Warning:") {
+		t.Errorf("Expected debug-statements to warn with warn_only, got %+v", debug)
+	}
+	if results["flaky-tests"].Passed {
+		t.Errorf("Expected flaky-tests to fail with fail_on_findings, got %+v", results["flaky-tests"])
+	}
+}
+
+// TestRunGradeTaskCommand_CoverageThreshold tests that graders.test_coverage.threshold from the
+// config replaces the default threshold of test-coverage
+func TestRunGradeTaskCommand_CoverageThreshold(t *testing.T) {
+	tmpDir := t.TempDir()
+	// auth is 3/4 covered
+	files := map[string]string{
+		"go.mod":        "module example.com/app\n\ngo 1.21\n",
+		"auth/login.go": "package auth\n",
+		"coverage.out":  "mode: set\nexample.com/app/auth/login.go:3.30,5.2 2 1\nexample.com/app/auth/login.go:7.30,9.2 1 1\nexample.com/app/auth/login.go:11.30,13.2 1 0\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory for %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	configPath := filepath.Join(tmpDir, "config.yaml")
+	if err := os.WriteFile(configPath, []byte("graders:\n  test_coverage:\n    threshold: 70\n"), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	config, err := loadConfig(configPath)
+	if err != nil {
+		t.Fatalf("loadConfig failed: %v", err)
+	}
+
+	runGradeTask := func(settings GradersConfig) codebased.GradeResult {
+		t.Helper()
+
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		opts := GradeTaskOptions{Graders: []string{"test-coverage"}, GraderSettings: settings, CoverageFile: "coverage.out"}
+		err := runGradeTaskCommandWithOptions("test-123", "feature", []string{"auth/login.go"}, tmpDir, "json", opts)
+
+		w.Close()
+		os.Stdout = oldStdout
+
+		if err != nil {
+			t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+		}
+
+		var buf bytes.Buffer
+		buf.ReadFrom(r)
+		var result GradeTaskOutput
+		if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+			t.Fatalf("Failed to parse JSON output: %v", err)
+		}
+		if len(result.Results) != 1 {
+			t.Fatalf("Expected one result, got %+v", result.Results)
+		}
+		return result.Results[0]
+	}
+
+	// 75% misses the default threshold of 80
+	if result := runGradeTask(GradersConfig{}); result.Passed || !strings.Contains(result.Details, "threshold: 80.0%") {
+		t.Errorf("Expected test-coverage to fail at the default threshold, got %+v", result)
+	}
+	if result := runGradeTask(config.Graders); !result.Passed || !strings.Contains(result.Details, "threshold: 70.0%") {
+		t.Errorf("Expected test-coverage to pass with threshold 70, got %+v", result)
+	}
+
+	invalid := GradersConfig{TestCoverage: TestCoverageConfig{Threshold: 120}}
+	err = runGradeTaskCommandWithOptions("test-123", "feature", []string{"auth/login.go"}, tmpDir, "json", GradeTaskOptions{GraderSettings: invalid})
+	if err == nil || !strings.Contains(err.Error(), "graders.test_coverage.threshold") {
+		t.Errorf("Expected invalid threshold error, got %v", err)
+	}
+}
+
+// TestResolveGraderPipeline_UnknownGrader tests that unknown grader names are rejected
+func TestResolveGraderPipeline_UnknownGrader(t *testing.T) {
+	_, err := resolveGraderPipeline("feature", map[string][]string{"feature": {"file-exists", "no-such-grader"}})
+	if err == nil || !strings.Contains(err.Error(), "no-such-grader") {
+		t.Errorf("Expected unknown grader error, got %v", err)
+	}
+}
+
 // TestRunGradeTaskCommand_TextOutput tests text output format
 func TestRunGradeTaskCommand_TextOutput(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -502,3 +1223,252 @@ func TestRunGradeTaskCommand_InvalidInput(t *testing.T) {
 		})
 	}
 }
+
+// runGit runs a git command in dir, failing the test on error
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %v\n%s", args, err, out)
+	}
+}
+
+// TestRunGradeTaskCommand_GitDiff tests that --git-diff grades the files changed since a ref
+func TestRunGradeTaskCommand_GitDiff(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tmpDir := t.TempDir()
+	runGit(t, tmpDir, "init", "-q")
+
+	// Base commit
+	files := map[string]string{
+		"handler.go":   "package app\n",
+		"unchanged.go": "package app\n",
+		"legacy.go":    "package app\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runGit(t, tmpDir, "add", ".")
+	runGit(t, tmpDir, "commit", "-q", "-m", "base")
+	runGit(t, tmpDir, "tag", "base")
+
+	// Feature commit: modify, add and delete files
+	if err := os.WriteFile(filepath.Join(tmpDir, "handler.go"), []byte("package app\n\nfunc Handle() {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "handler_test.go"), []byte("package app\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, tmpDir, "rm", "-q", "legacy.go")
+	runGit(t, tmpDir, "add", ".")
+	runGit(t, tmpDir, "commit", "-q", "-m", "feature")
+
+	changed, err := gitChangedFiles(tmpDir, "base")
+	if err != nil {
+		t.Fatalf("gitChangedFiles failed: %v", err)
+	}
+	want := []string{"handler.go", "handler_test.go"}
+	if strings.Join(changed, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected changed files %v, got %v", want, changed)
+	}
+
+	// Capture stdout
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err = runGradeTaskCommandWithOptions("task-1", "feature", nil, tmpDir, "json", GradeTaskOptions{GitDiffRef: "base", Graders: []string{"file-exists", "test-exists"}})
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	var result GradeTaskOutput
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+	}
+
+	// The deleted file must not reach the graders, so file-exists passes for both changed files
+	for _, r := range result.Results {
+		if r.GraderName == "file-exists" && r.Details != "All 2 files exist" {
+			t.Errorf("Expected file-exists to see 2 existing files, got: %s", r.Details)
+		}
+	}
+	if !result.OverallPassed {
+		t.Errorf("Expected overall pass, got %+v", result.Results)
+	}
+}
+
+// TestGitChangedFiles_Subdirectory tests that changed files are listed relative to a workDir below the repository root
+func TestGitChangedFiles_Subdirectory(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	tmpDir := t.TempDir()
+	serviceDir := filepath.Join(tmpDir, "service")
+	if err := os.MkdirAll(serviceDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, tmpDir, "init", "-q")
+	if err := os.WriteFile(filepath.Join(tmpDir, "README.md"), []byte("# repo\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, tmpDir, "add", ".")
+	runGit(t, tmpDir, "commit", "-q", "-m", "base")
+	runGit(t, tmpDir, "tag", "base")
+
+	if err := os.WriteFile(filepath.Join(serviceDir, "handler.go"), []byte("package service\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "README.md"), []byte("# repo\n\nupdated\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	runGit(t, tmpDir, "add", ".")
+	runGit(t, tmpDir, "commit", "-q", "-m", "feature")
+
+	changed, err := gitChangedFiles(serviceDir, "base")
+	if err != nil {
+		t.Fatalf("gitChangedFiles failed: %v", err)
+	}
+	if len(changed) != 1 || changed[0] != "handler.go" {
+		t.Errorf("Expected [handler.go] relative to the service directory, got %v", changed)
+	}
+}
+
+// TestGitChangedFiles_RejectsOptionRef tests that a ref that git would parse as an option is rejected
+func TestGitChangedFiles_RejectsOptionRef(t *testing.T) {
+	_, err := gitChangedFiles(t.TempDir(), "--output=diff.txt")
+	if err == nil || !strings.Contains(err.Error(), "must not start with") {
+		t.Errorf("Expected invalid ref error, got %v", err)
+	}
+}
+
+// TestRunGradeTaskCommand_GitDiffWithChangedFiles tests that --git-diff and --changed-files conflict
+func TestRunGradeTaskCommand_GitDiffWithChangedFiles(t *testing.T) {
+	err := runGradeTaskCommandWithOptions("task-1", "feature", []string{"a.go"}, t.TempDir(), "json", GradeTaskOptions{GitDiffRef: "main"})
+	if err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Errorf("Expected mutually exclusive error, got %v", err)
+	}
+}
+
+// TestRunGradeTaskCommand_FailuresOnly tests that --failures-only hides passing and skipped graders
+func TestRunGradeTaskCommand_FailuresOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+	testFile := filepath.Join(tmpDir, "routes.js")
+	if err := os.WriteFile(testFile, []byte("app.get('/health', handler);\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	evalLog := filepath.Join(tmpDir, "task-eval-log.json")
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	opts := GradeTaskOptions{
+		Graders:      []string{"file-exists", "test-exists", "endpoint-exists"},
+		FailuresOnly: true,
+		EvalLogPath:  evalLog,
+	}
+	err := runGradeTaskCommandWithOptions("test-123", "feature", []string{testFile}, tmpDir, "json", opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	if err != nil {
+		t.Fatalf("runGradeTaskCommandWithOptions failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	var result GradeTaskOutput
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v", err)
+	}
+
+	if len(result.Results) != 1 || result.Results[0].GraderName != "test-exists" || result.Results[0].Passed {
+		t.Fatalf("Expected only the failing test-exists grader, got %+v", result.Results)
+	}
+
+	// The overall score still averages every applicable grader
+	logged, err := loadEvalResults(evalLog)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+	if len(logged) != 1 || len(logged[0].Results) != 3 {
+		t.Fatalf("Expected the eval log to keep all 3 grader results, got %+v", logged)
+	}
+	if result.OverallScore != logged[0].OverallScore || result.OverallScore == result.Results[0].Score {
+		t.Errorf("Expected overall score from all graders, got %.1f (failing grader %.1f)", result.OverallScore, result.Results[0].Score)
+	}
+	if result.OverallPassed {
+		t.Error("Expected overall failure")
+	}
+}
+
+// TestRunGradeTaskCommand_ChangedFileLanguages tests the changed-file breakdown by extension in text and JSON output
+func TestRunGradeTaskCommand_ChangedFileLanguages(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := []string{"cmd/main.go", "cmd/main_test.go", "lib/a.go", "lib/b.GO", "web/app.js", "web/app.test.js", "README.md", "Makefile"}
+	for _, file := range files {
+		path := filepath.Join(tmpDir, file)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("content\n"), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+
+	run := func(format string) string {
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err := runGradeTaskCommand("test-langs", "feature", files, tmpDir, format)
+
+		w.Close()
+		os.Stdout = oldStdout
+
+		if err != nil {
+			t.Fatalf("runGradeTaskCommand failed: %v", err)
+		}
+		var buf bytes.Buffer
+		buf.ReadFrom(r)
+		return buf.String()
+	}
+
+	var result GradeTaskOutput
+	if err := json.Unmarshal([]byte(run("json")), &result); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v", err)
+	}
+	want := map[string]int{"go": 4, "js": 2, "md": 1, "other": 1}
+	if len(result.ChangedFileLanguages) != len(want) {
+		t.Errorf("Expected languages %v, got %v", want, result.ChangedFileLanguages)
+	}
+	for language, count := range want {
+		if result.ChangedFileLanguages[language] != count {
+			t.Errorf("Expected %s: %d, got %v", language, count, result.ChangedFileLanguages)
+		}
+	}
+
+	if output := run("text"); !strings.Contains(output, "Changed Files: 8 (go: 4, js: 2, md: 1, other: 1)") {
+		t.Errorf("Expected language breakdown in text output, got:\n%s", output)
+	}
+
+	if counts := countChangedFileLanguages(nil); counts != nil {
+		t.Errorf("Expected nil counts without files, got %v", counts)
+	}
+}
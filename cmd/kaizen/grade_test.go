@@ -387,3 +387,56 @@ func TestRunGradeCommand_FailingGrader(t *testing.T) {
 		t.Error("Expected grader to fail (no test file), but it passed")
 	}
 }
+
+// TestRunGradeCommand_Model tests that the selected model is reported only when an LLM client grades the input
+func TestRunGradeCommand_Model(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	inputFile := filepath.Join(tmpDir, "input.json")
+	inputJSON, _ := json.Marshal(map[string]interface{}{
+		"content": "# Test Skill\n\nThis is a test skill with clear instructions.",
+	})
+	if err := os.WriteFile(inputFile, inputJSON, 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	grade := func(opts GradeOptions) GradeOutput {
+		t.Helper()
+
+		// Capture stdout
+		oldStdout := os.Stdout
+		r, w, _ := os.Pipe()
+		os.Stdout = w
+
+		err := runGradeCommandWithOptions("skill_clarity", inputFile, "", "json", opts)
+
+		w.Close()
+		os.Stdout = oldStdout
+
+		if err != nil {
+			t.Fatalf("runGradeCommandWithOptions failed: %v", err)
+		}
+
+		var buf bytes.Buffer
+		buf.ReadFrom(r)
+
+		var result GradeOutput
+		if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+			t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, buf.String())
+		}
+		return result
+	}
+
+	result := grade(GradeOptions{Model: "claude-sonnet-4", LLMClient: stubSkillLLMClient{}})
+	if result.Model != "claude-sonnet-4" {
+		t.Errorf("Expected model claude-sonnet-4, got %q", result.Model)
+	}
+	if result.Score != 80 {
+		t.Errorf("Expected the LLM client's score 80, got %.1f", result.Score)
+	}
+
+	result = grade(GradeOptions{Model: "claude-sonnet-4"})
+	if result.Model != "" {
+		t.Errorf("Expected no model without an LLM client, got %q", result.Model)
+	}
+}
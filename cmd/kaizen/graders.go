@@ -0,0 +1,58 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/srstomp/kaizen/internal/graders/codebased"
+)
+
+// GradersListJSON is the JSON output of `graders list`
+type GradersListJSON struct {
+	Graders []codebased.GraderInfo `json:"graders"`
+}
+
+// runGradersListCommand lists the registered code graders with what they check and when they apply
+func runGradersListCommand(format string) (string, error) {
+	if format == "" {
+		format = "text"
+	}
+	if format != "text" && format != "json" {
+		return "", fmt.Errorf("unsupported format: %s (use 'text' or 'json')", format)
+	}
+
+	graders := codebased.All()
+	infos := make([]codebased.GraderInfo, 0, len(graders))
+	for _, grader := range graders {
+		info := codebased.Describe(grader)
+		if info.TaskTypes == nil {
+			info.TaskTypes = []string{}
+		}
+		infos = append(infos, info)
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(GradersListJSON{Graders: infos}, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("marshaling graders: %w", err)
+		}
+		return string(data) + "\n", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Available graders (%d):\n", len(infos)))
+	for _, info := range infos {
+		sb.WriteString(fmt.Sprintf("\n  %s\n", info.Name))
+		if info.Description != "" {
+			sb.WriteString(fmt.Sprintf("    %s\n", info.Description))
+		}
+		if len(info.TaskTypes) > 0 {
+			sb.WriteString(fmt.Sprintf("    Task types: %s\n", strings.Join(info.TaskTypes, ", ")))
+		}
+		if info.Files != "" {
+			sb.WriteString(fmt.Sprintf("    Files: %s\n", info.Files))
+		}
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestRunGradersListCommand verifies the listing includes the built-in graders with their applicability
+func TestRunGradersListCommand(t *testing.T) {
+	output, err := runGradersListCommand("text")
+	if err != nil {
+		t.Fatalf("runGradersListCommand failed: %v", err)
+	}
+	for _, want := range []string{
+		"file-exists",
+		"test-exists",
+		"debug-statements",
+		"Task types: feature, bug\n",
+		"Checks that changed code files have corresponding test files",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected listing to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	output, err = runGradersListCommand("json")
+	if err != nil {
+		t.Fatalf("runGradersListCommand json failed: %v", err)
+	}
+	var listing GradersListJSON
+	if err := json.Unmarshal([]byte(output), &listing); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, output)
+	}
+
+	byName := make(map[string][]string)
+	for _, info := range listing.Graders {
+		byName[info.Name] = info.TaskTypes
+	}
+	expected := map[string]string{
+		"file-exists":   "feature,bug,test,spike,chore",
+		"test-coverage": "feature,bug,test",
+		"flaky-tests":   "feature,test",
+		"test-ratio":    "feature,bug",
+	}
+	for name, taskTypes := range expected {
+		if got := strings.Join(byName[name], ","); got != taskTypes {
+			t.Errorf("%s: expected task types %s, got %q", name, taskTypes, got)
+		}
+	}
+
+	if _, err := runGradersListCommand("xml"); err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+}
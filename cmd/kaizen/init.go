@@ -19,6 +19,15 @@ confidence_thresholds:
   # 1 occurrence = log-only (implicit)
 
 templates_dir: ""  # Empty means use built-in templates
+
+llm:
+  api_key_env: ANTHROPIC_API_KEY  # Env var holding the API key (the key itself is never stored here)
+  # provider: fake  # Offline canned responses instead of the API (or KAIZEN_LLM_PROVIDER=fake)
+
+# Per task type code graders for grade-task (unlisted types run file-exists, test-exists, endpoint-exists)
+# grader_pipelines:
+#   feature: [file-exists, test-exists, endpoint-exists]
+#   spike: [file-exists]
 `
 
 // runInitCommand initializes the kaizen configuration directory and database.
@@ -1,18 +1,28 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/srstomp/kaizen/internal/graders/codebased"
 	"github.com/srstomp/kaizen/internal/graders/modelbased"
+	"github.com/srstomp/kaizen/internal/llm"
+	"github.com/srstomp/kaizen/internal/metrics"
+	"github.com/srstomp/kaizen/internal/textutil"
+	"github.com/srstomp/kaizen/internal/version"
 )
 
 type skillResult struct {
@@ -22,13 +32,44 @@ type skillResult struct {
 	Passed  bool
 	Message string
 	Details map[string]any
+	// GradedByLLM is true when the skill was graded by an LLM rather than heuristics
+	GradedByLLM bool
+	// Model is the LLM model that graded the skill
+	Model string
+	// Rubric is the path of the custom rubric the LLM graded against; empty for the built-in criteria
+	Rubric string
+	// BaselineScore is the skill's score in the --baseline report; nil without a baseline or when the skill is new
+	BaselineScore *float64
 }
 
 func main() {
+	// Global options come before the subcommand, e.g. kaizen --timeout 10m meta --suite agents
+	globalFlags := flag.NewFlagSet("kaizen", flag.ExitOnError)
+	overallTimeout := globalFlags.Duration("timeout", 0, "Abort the whole command after this duration, e.g. 30s or 10m (default: no limit)")
+	showVersion := globalFlags.Bool("version", false, "Print the version, git commit and build date")
+	globalFlags.Parse(os.Args[1:])
+	args := globalFlags.Args()
+
+	if *showVersion {
+		fmt.Println(version.String())
+		return
+	}
+
 	// Define subcommands
 	gradeCmd := flag.NewFlagSet("grade-skills", flag.ExitOnError)
-	skillsDir := gradeCmd.String("skills-dir", "/Users/sis4m4/Projects/stevestomp/pokayokay/plugins/pokayokay/skills", "Path to skills directory")
+	skillsDir := gradeCmd.String("skills-dir", "", "Path to skills directory (default: plugins/pokayokay/skills in the pokayokay project, or skills/)")
 	reportPath := gradeCmd.String("output", "", "Output report path (default: yokay-evals/reports/skill-clarity-YYYY-MM-DD.md)")
+	gradeSkillsFormat := gradeCmd.String("format", "markdown", "Report format: 'markdown', 'json' or 'jsonl' (one JSON object per skill, streamed to --output or stdout)")
+	gradeFilenamePattern := gradeCmd.String("filename-pattern", defaultGradeReportPattern, "Report filename pattern; {date} is replaced with YYYY-MM-DD")
+	gradeSkillsModel := gradeCmd.String("model", modelbased.DefaultModel, "LLM model used for skill grading")
+	gradeThreshold := gradeCmd.Float64("threshold", defaultSkillPassingThreshold, "Minimum score (0-100) for a skill to pass")
+	gradeWarnThreshold := gradeCmd.Float64("warn-threshold", defaultSkillWarnThreshold, "Score below which a passing skill is listed as needing improvement; raised to --threshold when unset")
+	gradeSummaryOnly := gradeCmd.Bool("summary-only", false, "Print only aggregate skill metrics to stdout instead of writing a report")
+	gradeSelfConsistency := gradeCmd.Int("self-consistency", 1, "LLM responses per skill; the median score of each criterion is used")
+	gradeBaseline := gradeCmd.String("baseline", "", "Prior grade-skills report (markdown or JSON); skills scoring lower are flagged as regressions")
+	gradeRubric := gradeCmd.String("rubric", "", "Markdown rubric the LLM grades skills against instead of the built-in criteria")
+	gradeConcurrency := gradeCmd.Int("concurrency", 1, "Number of skills to grade at once")
+	gradeReportNote := gradeCmd.String("report-note", "", "Custom note for the report header (default: grade_skills.report_note in config, else the grading mode)")
 
 	metaCmd := flag.NewFlagSet("meta", flag.ExitOnError)
 	suite := metaCmd.String("suite", "", "Suite to run: 'agents' or 'skills'")
@@ -36,20 +77,42 @@ func main() {
 	k := metaCmd.Int("k", 5, "Number of runs for pass^k (default: 5)")
 	metaDirFlag := metaCmd.String("meta-dir", "", "Path to meta directory (default: yokay-evals/meta)")
 	confirm := metaCmd.Bool("confirm", false, "Confirm before running suite (skips prompt)")
+	metaParallel := metaCmd.Int("parallel", 1, "Maximum number of agent runs to execute concurrently")
+	metaReportsDir := metaCmd.String("reports-dir", "", "Path to reports directory for consistency-log.json (default: reports/ next to the meta directory)")
+	metaNoLog := metaCmd.Bool("no-log", false, "Don't append results to consistency-log.json (dry run)")
+	metaMaxRetries := metaCmd.Int("max-retries", defaultMetaMaxRetries, "Retries with exponential backoff for agent runs that error or time out")
+	metaFormat := metaCmd.String("format", "text", "Output format: 'text' or 'json'")
+	metaRunner := metaCmd.String("runner", "", "Command that runs an agent, with {agent} substituted and the prompt on stdin (default: config agent_runner, else claude --agent {agent} --print)")
+	metaCostPerCall := metaCmd.Float64("cost-per-call", 0, "Assumed dollar cost of one agent run for the estimate (default: config meta.cost_per_call, else 0.015)")
+	metaMaxCost := metaCmd.Float64("max-cost", 0, "Abort before running when the worst-case estimated cost exceeds this many dollars, even with --confirm (default: config meta.max_cost, else no limit)")
+	metaTiePolicy := metaCmd.String("tie-policy", tiePolicyAlphabetical, "Majority verdict for tied runs: 'alphabetical', 'prefer-expected' or 'mark-inconclusive'")
+	metaEvalGlob := metaCmd.String("eval-glob", "", "Run the eval files matching this glob (\"**\" matches any directories) instead of --suite or --agent")
+	metaOutputDir := metaCmd.String("output-dir", "", "Write <agent>.md (and <agent>.json with --format json) per eval file plus a summary to this directory instead of stdout")
 
 	evalCmd := flag.NewFlagSet("eval", flag.ExitOnError)
 	failuresDirFlag := evalCmd.String("failures-dir", "", "Path to failures directory (default: yokay-evals/failures)")
-	categoryFlag := evalCmd.String("category", "", "Filter to specific category (e.g., 'missing-tests')")
+	categoryFlag := evalCmd.String("category", "", "Filter to categories, comma-separated (e.g., 'missing-tests,scope-creep')")
 	kFlag := evalCmd.Int("k", 1, "Number of evaluation runs (default: 1)")
 	formatFlag := evalCmd.String("format", "table", "Output format: 'table' or 'json'")
+	rerunFailedFlag := evalCmd.String("rerun-failed", "", "Re-run only the failed cases from a previous 'eval --format json' output")
+	onlyChangedCategoriesFlag := evalCmd.String("only-changed-categories", "", "Evaluate only categories with failures captured since a previous 'eval --format json' output")
 
 	reportCmd := flag.NewFlagSet("report", flag.ExitOnError)
-	reportType := reportCmd.String("type", "grade", "Report type: 'grade', 'eval', or 'all'")
-	reportFormat := reportCmd.String("format", "markdown", "Output format: 'markdown' or 'json'")
+	reportType := reportCmd.String("type", "grade", "Report type: 'grade', 'meta', 'eval', or 'all' (json only)")
+	reportFormat := reportCmd.String("format", "markdown", "Output format: 'markdown', 'json' or 'html'")
 	listReports := reportCmd.Bool("list", false, "List available reports without aggregating")
 	outputFile := reportCmd.String("output", "", "Write output to file instead of stdout")
 	reportsDirFlag := reportCmd.String("reports-dir", "", "Path to reports directory (default: reports/)")
 	noTrends := reportCmd.Bool("no-trends", false, "Disable trend analysis")
+	reportPassThreshold := reportCmd.Float64("pass-threshold", -1, "Recompute eval pass/fail as overall_score >= threshold (default: use stored result)")
+	reportTag := reportCmd.String("tag", "", "Only include eval entries carrying this tag")
+	reportSince := reportCmd.String("since", "", "Only include eval/meta entries at or after this time (RFC3339 or YYYY-MM-DD)")
+	reportUntil := reportCmd.String("until", "", "Only include eval/meta entries at or before this time (RFC3339 or YYYY-MM-DD, whole day)")
+	reportWorst := reportCmd.Int("worst", 0, "Show the N lowest-scoring tasks in the eval markdown or html report")
+	reportHistory := reportCmd.Int("history", 2, "Number of grade reports to chart in the grade markdown report (2 keeps the two-report trend only)")
+	reportFilenamePattern := reportCmd.String("filename-pattern", defaultGradeReportPattern, "Grade report filename pattern; {date} matches YYYY-MM-DD")
+	reportFailOnRegression := reportCmd.Bool("fail-on-regression", false, "Exit non-zero after writing the report when a trend regresses beyond --regression-threshold")
+	reportRegressionThreshold := reportCmd.Float64("regression-threshold", defaultRegressionThreshold, "Percentage drop that counts as a regression for --fail-on-regression and the trend markers")
 
 	gradeTaskCmd := flag.NewFlagSet("grade-task", flag.ExitOnError)
 	taskID := gradeTaskCmd.String("task-id", "", "Task ID")
@@ -57,6 +120,19 @@ func main() {
 	changedFiles := gradeTaskCmd.String("changed-files", "", "Comma-separated list of changed files")
 	workDir := gradeTaskCmd.String("work-dir", ".", "Working directory")
 	gradeFormat := gradeTaskCmd.String("format", "json", "Output format (json, text)")
+	gradeRunID := gradeTaskCmd.String("run-id", "", "Run ID used to deduplicate eval log entries (e.g. CI job ID)")
+	gradeEvalLog := gradeTaskCmd.String("eval-log", "", "Append the result to this eval log (default: <reports-dir>/task-eval-log.json)")
+	gradeReportsDir := gradeTaskCmd.String("reports-dir", "", "Path to reports directory holding the eval log (default: reports/)")
+	gradeNoLog := gradeTaskCmd.Bool("no-log", false, "Don't append the result to the eval log")
+	gradeTags := gradeTaskCmd.String("tag", "", "Comma-separated tags for this run (e.g. nightly,pre-release)")
+	gradeGitDiff := gradeTaskCmd.String("git-diff", "", "Grade the files changed in <ref>...HEAD instead of --changed-files")
+	gradeGraders := gradeTaskCmd.String("graders", "", "Comma-separated graders to run, overriding the pipeline (e.g. file-exists,endpoint-exists), or 'all' for every registered grader")
+	gradeFailuresOnly := gradeTaskCmd.Bool("failures-only", false, "Only show failing graders (overall score still uses all applicable graders)")
+	gradeCoverageFile := gradeTaskCmd.String("coverage-file", "", "Existing go test coverage profile for test-coverage (default: run go test)")
+	gradeTaskOutput := gradeTaskCmd.String("output", "", "Write the results to this file and print a one-line summary")
+	var gradeDisabledGraders, gradeOnlyGraders repeatedFlag
+	gradeTaskCmd.Var(&gradeDisabledGraders, "disable-grader", "Leave this grader out of the pipeline (repeatable)")
+	gradeTaskCmd.Var(&gradeOnlyGraders, "only-grader", "Run only this grader from the pipeline (repeatable)")
 
 	gradeTaskQualityCmd := flag.NewFlagSet("grade-task-quality", flag.ExitOnError)
 	qualityTaskID := gradeTaskQualityCmd.String("task-id", "", "Task ID")
@@ -66,21 +142,35 @@ func main() {
 	qualityAcceptanceCriteria := gradeTaskQualityCmd.String("acceptance-criteria", "", "Acceptance criteria (comma-separated or JSON)")
 	qualityMinDescLength := gradeTaskQualityCmd.Int("min-description-length", 100, "Minimum description length")
 	qualityFormat := gradeTaskQualityCmd.String("format", "json", "Output format (json, text)")
+	qualityVerbose := gradeTaskQualityCmd.Bool("verbose", false, "Show each check with PASS/FAIL and the points it contributed")
+	qualityTaskFile := gradeTaskQualityCmd.String("task-file", "", "Markdown or YAML task spec to read the task from (flags override its values)")
+	qualityOutput := gradeTaskQualityCmd.String("output", "", "Write the result to this file and print a one-line summary")
 
 	gradeSingleCmd := flag.NewFlagSet("grade", flag.ExitOnError)
 	graderFlag := gradeSingleCmd.String("grader", "", "Grader name (required)")
 	inputFlag := gradeSingleCmd.String("input", "", "Path to input JSON file (required)")
 	specFlag := gradeSingleCmd.String("spec", "", "Specification text (optional, for model-based graders)")
 	singleFormatFlag := gradeSingleCmd.String("format", "text", "Output format (text, json)")
+	singleModelFlag := gradeSingleCmd.String("model", modelbased.DefaultModel, "LLM model used by model-based graders")
 
 	gateCmd := flag.NewFlagSet("gate", flag.ExitOnError)
 	gateType := gateCmd.String("type", "all", "Check type: 'eval', 'meta', or 'all'")
 	gateThreshold := gateCmd.Float64("threshold", 95.0, "Threshold percentage (0-100)")
 	gateReportsDir := gateCmd.String("reports-dir", "", "Path to reports directory (default: reports/)")
+	gateMinRuns := gateCmd.Int("min-runs", 0, "Minimum runs per agent for the meta gate (0 disables)")
+	gatePassThreshold := gateCmd.Float64("pass-threshold", -1, "Recompute eval pass/fail as overall_score >= threshold (default: use stored result)")
+	gateFormat := gateCmd.String("format", "text", "Output format: 'text' or 'json'")
+	gateThresholds := gateCmd.String("thresholds", "", "Per-category eval thresholds as category=value pairs, e.g. security=99,docs=70 (categories are eval tags)")
+
+	validateCmd := flag.NewFlagSet("validate", flag.ExitOnError)
+	validateMetaDir := validateCmd.String("meta-dir", "meta", "Path to meta directory containing agents/ and skills/ eval files")
+	validateFormat := validateCmd.String("format", "text", "Output format: 'text' or 'json'")
 
 	dashboardCmd := flag.NewFlagSet("dashboard", flag.ExitOnError)
 	dashboardReportsDir := dashboardCmd.String("reports-dir", "", "Path to reports directory (default: reports/)")
-	dashboardOutput := dashboardCmd.String("output", "dashboard.html", "Output file path for HTML dashboard")
+	dashboardOutput := dashboardCmd.String("output", "", "Output file path (default: dashboard.html, or stdout with --data-only)")
+	dashboardDataOnly := dashboardCmd.Bool("data-only", false, "Emit aggregated dashboard data instead of HTML")
+	dashboardFormat := dashboardCmd.String("format", "json", "Data format for --data-only (json)")
 
 	initCmd := flag.NewFlagSet("init", flag.ExitOnError)
 	initBootstrap := initCmd.Bool("bootstrap", false, "Bootstrap category stats from existing failures directory")
@@ -88,39 +178,103 @@ func main() {
 
 	suggestCmd := flag.NewFlagSet("suggest", flag.ExitOnError)
 	suggestTaskID := suggestCmd.String("task-id", "", "Task ID to associate with (required)")
-	suggestCategory := suggestCmd.String("category", "", "Failure category to get suggestions for (required)")
+	suggestCategory := suggestCmd.String("category", "", "Failure category to get suggestions for (required unless --top is set)")
+	suggestTop := suggestCmd.Int("top", 0, "Suggest fixes for the N most frequent categories instead of one category")
+	suggestFormat := suggestCmd.String("format", "json", "Output format for --top: 'json' or 'text'")
+
+	analyzeCmd := flag.NewFlagSet("analyze", flag.ExitOnError)
+	analyzeCoOccurrence := analyzeCmd.Bool("co-occurrence", false, "Count tasks that failed in each pair of categories")
+	analyzeFormat := analyzeCmd.String("format", "text", "Output format: 'text' or 'json'")
+
+	statsCmd := flag.NewFlagSet("stats", flag.ExitOnError)
+	statsBucket := statsCmd.String("bucket", "day", "Time bucket for failure counts: 'day', 'week' or 'month'")
+	statsFormat := statsCmd.String("format", "text", "Output format: 'text', 'json', or 'csv' for per-category stats")
+	statsTop := statsCmd.Int("top", 0, "List the N most frequent categories with their share of all occurrences instead of time buckets")
+
+	pruneCmd := flag.NewFlagSet("prune", flag.ExitOnError)
+	pruneOlderThan := pruneCmd.String("older-than", "", "Delete failures older than this age, e.g. 90d, 2w or 36h (required)")
+	pruneDryRun := pruneCmd.Bool("dry-run", false, "Report what would be deleted without changing the database")
+
+	gradersListCmd := flag.NewFlagSet("graders list", flag.ExitOnError)
+	gradersListFormat := gradersListCmd.String("format", "text", "Output format: 'text' or 'json'")
+
+	compareCmd := flag.NewFlagSet("compare", flag.ExitOnError)
+	compareFrom := compareCmd.String("from", "", "Earlier skill-clarity report: a path or a YYYY-MM-DD report date (required)")
+	compareTo := compareCmd.String("to", "", "Later skill-clarity report: a path or a YYYY-MM-DD report date (required)")
+	compareFormat := compareCmd.String("format", "markdown", "Output format: 'markdown' or 'json'")
+	compareReportsDir := compareCmd.String("reports-dir", "reports", "Directory where report dates are looked up")
+	compareFilenamePattern := compareCmd.String("filename-pattern", defaultGradeReportPattern, "Grade report filename pattern; {date} matches YYYY-MM-DD")
+
+	exportCmd := flag.NewFlagSet("export", flag.ExitOnError)
+	exportFormat := exportCmd.String("format", "json", "Output format: 'json' or 'csv'")
+	exportOutput := exportCmd.String("output", "", "Write to this file instead of stdout")
+	exportCategory := exportCmd.String("category", "", "Only export failures in this category")
+
+	snapshotCmd := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	snapshotOutput := snapshotCmd.String("output", "", "File to write the snapshot to (required)")
+
+	restoreCmd := flag.NewFlagSet("restore", flag.ExitOnError)
+	restoreInput := restoreCmd.String("input", "", "Snapshot file written by 'kaizen snapshot' (required)")
+
+	searchCmd := flag.NewFlagSet("search", flag.ExitOnError)
+	searchQuery := searchCmd.String("query", "", "Text to find in failure details, case-insensitive (required)")
+	searchCategory := searchCmd.String("category", "", "Only search failures in this category")
+	searchLimit := searchCmd.Int("limit", 0, "Show at most this many failures, newest first (0 shows all)")
+	searchFormat := searchCmd.String("format", "text", "Output format: 'text' or 'json'")
 
 	detectCmd := flag.NewFlagSet("detect-category", flag.ExitOnError)
 	detectDetails := detectCmd.String("details", "", "Text to analyze for category detection (required)")
+	detectNormalize := detectCmd.Bool("normalize", true, "Strip timestamps, paths, hex/uuids and extra whitespace before matching")
+	detectMinConfidence := detectCmd.Float64("min-confidence", 0, "Report 'unknown' and exit non-zero when the top match's confidence (0-1) is below this")
 
 	captureCmd := flag.NewFlagSet("capture", flag.ExitOnError)
 	captureTaskID := captureCmd.String("task-id", "", "Task ID where the failure occurred (required)")
 	captureCategory := captureCmd.String("category", "", "Failure category (required)")
 	captureDetails := captureCmd.String("details", "", "Details about the failure (required)")
 	captureSource := captureCmd.String("source", "", "Source of the failure, e.g. spec-review, quality-review (required)")
+	captureInteractive := captureCmd.Bool("interactive", false, "Prompt for the failure, choosing category and source from menus")
+	captureDryRun := captureCmd.Bool("dry-run", false, "Validate and print the record that would be captured without writing to the database")
 
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: kaizen <command> [options]")
+	if len(args) < 1 {
+		fmt.Println("Usage: kaizen [--timeout duration] <command> [options]")
 		fmt.Println("\nCommands:")
 		fmt.Println("  init                Initialize kaizen configuration directory")
 		fmt.Println("  capture             Capture a failure record in the database")
 		fmt.Println("  suggest             Generate fix task suggestions based on failure patterns")
+		fmt.Println("  analyze             Analyze captured failures (e.g. category co-occurrence)")
+		fmt.Println("  stats               Show failure totals bucketed by day, week or month")
+		fmt.Println("  prune               Delete failure records older than a given age")
+		fmt.Println("  export              Export failure records to JSON or CSV")
+		fmt.Println("  snapshot            Save the whole failures database to a portable file")
+		fmt.Println("  restore             Load a snapshot into an empty failures database")
+		fmt.Println("  search              Search failure details for text")
 		fmt.Println("  detect-category     Detect failure category from text details")
 		fmt.Println("  grade               Run a single grader on a single input")
 		fmt.Println("  grade-skills        Grade all pokayokay skills and generate report")
 		fmt.Println("  grade-task          Run code-based graders on task changes")
 		fmt.Println("  grade-task-quality  Evaluate task quality based on metadata")
+		fmt.Println("  graders list        List available code graders and when they apply")
 		fmt.Println("  meta                Run meta-evaluations on agents or skills")
 		fmt.Println("  eval                Run eval suite against failure cases")
 		fmt.Println("  report              View and analyze evaluation reports")
+		fmt.Println("  compare             Diff two skill-clarity reports per skill and criterion")
 		fmt.Println("  gate                Check if eval/meta results pass threshold (for CI)")
+		fmt.Println("  validate            Validate meta eval.yaml files against the schema")
 		fmt.Println("  dashboard           Generate HTML dashboard from eval/meta results")
+		fmt.Println("  version             Print the version, git commit and build date")
+		fmt.Println("\nGlobal options:")
+		fmt.Println("  --timeout duration  Abort the whole command after this duration (e.g. 30s, 10m)")
+		fmt.Println("  --version           Print the version, git commit and build date")
 		os.Exit(1)
 	}
 
-	switch os.Args[1] {
+	ctx, cancel := newRootContext(*overallTimeout)
+	defer cancel()
+	watchOverallTimeout(ctx, *overallTimeout)
+
+	switch args[0] {
 	case "init":
-		initCmd.Parse(os.Args[2:])
+		initCmd.Parse(args[1:])
 
 		// Get home directory and create config path
 		homeDir, err := os.UserHomeDir()
@@ -172,18 +326,30 @@ func main() {
 		}
 
 	case "suggest":
-		suggestCmd.Parse(os.Args[2:])
+		suggestCmd.Parse(args[1:])
 
-		// Validate required flags
-		if *suggestTaskID == "" {
-			fmt.Println("Error: --task-id flag is required")
+		// Validate required flags; --top ranks categories itself and doesn't need a task
+		if *suggestTop < 0 {
+			fmt.Println("Error: --top must be a positive number")
 			suggestCmd.Usage()
 			os.Exit(1)
 		}
-		if *suggestCategory == "" {
-			fmt.Println("Error: --category flag is required")
-			suggestCmd.Usage()
-			os.Exit(1)
+		if *suggestTop == 0 {
+			if *suggestTaskID == "" {
+				fmt.Println("Error: --task-id flag is required")
+				suggestCmd.Usage()
+				os.Exit(1)
+			}
+			if *suggestCategory == "" {
+				fmt.Println("Error: --category flag is required")
+				suggestCmd.Usage()
+				os.Exit(1)
+			}
+			if *suggestFormat != "json" {
+				fmt.Println("Error: --format only applies with --top")
+				suggestCmd.Usage()
+				os.Exit(1)
+			}
 		}
 
 		// Check if kaizen is initialized
@@ -199,54 +365,250 @@ func main() {
 			os.Exit(1)
 		}
 
-		if err := runSuggestCommand(*suggestTaskID, *suggestCategory); err != nil {
+		if *suggestTop > 0 {
+			err = runSuggestTopCommand(*suggestTop, *suggestTaskID, *suggestFormat)
+		} else {
+			err = runSuggestCommand(*suggestTaskID, *suggestCategory)
+		}
+		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
-	case "detect-category":
-		detectCmd.Parse(os.Args[2:])
+	case "analyze":
+		analyzeCmd.Parse(args[1:])
 
-		// Validate required flags
-		if *detectDetails == "" {
-			fmt.Println("Error: --details flag is required")
-			detectCmd.Usage()
+		// Check if kaizen is initialized
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			log.Fatalf("Failed to get home directory: %v", err)
+		}
+		dbPath := filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+
+		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, "Error: kaizen not initialized. Run 'kaizen init' first.")
 			os.Exit(1)
 		}
 
-		output, err := runDetectCategoryCommand(*detectDetails)
+		opts := AnalyzeOptions{CoOccurrence: *analyzeCoOccurrence, Format: *analyzeFormat}
+		output, err := runAnalyzeCommandWithConfig(dbPath, opts)
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
-		fmt.Println(output)
+		fmt.Print(output)
 
-	case "capture":
-		captureCmd.Parse(os.Args[2:])
+	case "stats":
+		statsCmd.Parse(args[1:])
+
+		// Check if kaizen is initialized
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			log.Fatalf("Failed to get home directory: %v", err)
+		}
+		dbPath := filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+
+		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, "Error: kaizen not initialized. Run 'kaizen init' first.")
+			os.Exit(1)
+		}
+
+		opts := StatsOptions{Bucket: *statsBucket, Format: *statsFormat, Top: *statsTop}
+		output, err := runStatsCommandWithConfig(dbPath, opts)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Print(output)
+
+	case "prune":
+		pruneCmd.Parse(args[1:])
 
 		// Validate required flags
-		if *captureTaskID == "" {
-			fmt.Println("Error: --task-id flag is required")
-			captureCmd.Usage()
+		if *pruneOlderThan == "" {
+			fmt.Println("Error: --older-than flag is required")
+			pruneCmd.Usage()
+			os.Exit(1)
+		}
+		age, err := parseAge(*pruneOlderThan)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		// Check if kaizen is initialized
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			log.Fatalf("Failed to get home directory: %v", err)
+		}
+		dbPath := filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+
+		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, "Error: kaizen not initialized. Run 'kaizen init' first.")
+			os.Exit(1)
+		}
+
+		output, err := runPruneCommandWithConfig(dbPath, PruneOptions{OlderThan: age, DryRun: *pruneDryRun})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Print(output)
+
+	case "export":
+		exportCmd.Parse(args[1:])
+
+		// Check if kaizen is initialized
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			log.Fatalf("Failed to get home directory: %v", err)
+		}
+		dbPath := filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+
+		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, "Error: kaizen not initialized. Run 'kaizen init' first.")
+			os.Exit(1)
+		}
+
+		opts := ExportOptions{Format: *exportFormat, Output: *exportOutput, Category: *exportCategory}
+		if err := runExportCommandWithConfig(dbPath, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+	case "snapshot":
+		snapshotCmd.Parse(args[1:])
+
+		if *snapshotOutput == "" {
+			fmt.Fprintln(os.Stderr, "Error: --output is required")
+			snapshotCmd.Usage()
+			os.Exit(1)
+		}
+
+		// Check if kaizen is initialized
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			log.Fatalf("Failed to get home directory: %v", err)
+		}
+		dbPath := filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+
+		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, "Error: kaizen not initialized. Run 'kaizen init' first.")
+			os.Exit(1)
+		}
+
+		if err := runSnapshotCommandWithConfig(dbPath, *snapshotOutput); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+	case "restore":
+		restoreCmd.Parse(args[1:])
+
+		if *restoreInput == "" {
+			fmt.Fprintln(os.Stderr, "Error: --input is required")
+			restoreCmd.Usage()
+			os.Exit(1)
+		}
+
+		// Check if kaizen is initialized; init creates the empty database restore fills
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			log.Fatalf("Failed to get home directory: %v", err)
+		}
+		dbPath := filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+
+		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, "Error: kaizen not initialized. Run 'kaizen init' first.")
+			os.Exit(1)
+		}
+
+		if err := runRestoreCommandWithConfig(dbPath, *restoreInput); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+	case "search":
+		searchCmd.Parse(args[1:])
+
+		if *searchQuery == "" {
+			fmt.Fprintln(os.Stderr, "Error: --query is required")
+			searchCmd.Usage()
+			os.Exit(1)
+		}
+
+		// Check if kaizen is initialized
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			log.Fatalf("Failed to get home directory: %v", err)
+		}
+		dbPath := filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+
+		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+			fmt.Fprintln(os.Stderr, "Error: kaizen not initialized. Run 'kaizen init' first.")
 			os.Exit(1)
 		}
-		if *captureCategory == "" {
-			fmt.Println("Error: --category flag is required")
-			captureCmd.Usage()
+
+		opts := SearchOptions{Query: *searchQuery, Category: *searchCategory, Limit: *searchLimit, Format: *searchFormat}
+		if err := runSearchCommandWithConfig(dbPath, opts); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
-		if *captureDetails == "" {
+
+	case "detect-category":
+		detectCmd.Parse(args[1:])
+
+		// Validate required flags
+		if *detectDetails == "" {
 			fmt.Println("Error: --details flag is required")
-			captureCmd.Usage()
+			detectCmd.Usage()
+			os.Exit(1)
+		}
+
+		opts := DetectOptions{Normalize: *detectNormalize, MinConfidence: *detectMinConfidence}
+		output, err := runDetectCategoryCommandWithOptions(*detectDetails, opts)
+		if errors.Is(err, errLowConfidence) {
+			// Still print the result so callers can route it to manual triage
+			fmt.Println(output)
 			os.Exit(1)
 		}
-		if *captureSource == "" {
-			fmt.Println("Error: --source flag is required")
-			captureCmd.Usage()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
+		fmt.Println(output)
+
+	case "capture":
+		captureCmd.Parse(args[1:])
+
+		// Validate required flags; the interactive wizard prompts for them instead
+		if !*captureInteractive {
+			if *captureTaskID == "" {
+				fmt.Println("Error: --task-id flag is required")
+				captureCmd.Usage()
+				os.Exit(1)
+			}
+			if *captureCategory == "" {
+				fmt.Println("Error: --category flag is required")
+				captureCmd.Usage()
+				os.Exit(1)
+			}
+			if *captureDetails == "" {
+				fmt.Println("Error: --details flag is required")
+				captureCmd.Usage()
+				os.Exit(1)
+			}
+			if *captureSource == "" {
+				fmt.Println("Error: --source flag is required")
+				captureCmd.Usage()
+				os.Exit(1)
+			}
+		}
+
 		// Check if kaizen is initialized
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
@@ -260,7 +622,16 @@ func main() {
 			os.Exit(1)
 		}
 
-		output, err := runCaptureCommand(*captureTaskID, *captureCategory, *captureDetails, *captureSource)
+		var output string
+		if *captureInteractive {
+			config, configErr := loadConfig(filepath.Join(configDir, "config.yaml"))
+			if configErr != nil {
+				log.Fatalf("Failed to load config: %v", configErr)
+			}
+			output, err = runInteractiveCaptureWithOptions(os.Stdin, os.Stdout, dbPath, config.Capture, CaptureOptions{DryRun: *captureDryRun})
+		} else {
+			output, err = runCaptureCommandWithOptions(*captureTaskID, *captureCategory, *captureDetails, *captureSource, CaptureOptions{DryRun: *captureDryRun})
+		}
 		if err != nil {
 			fmt.Fprintln(os.Stderr, output)
 			os.Exit(1)
@@ -269,7 +640,7 @@ func main() {
 		fmt.Println(output)
 
 	case "grade":
-		gradeSingleCmd.Parse(os.Args[2:])
+		gradeSingleCmd.Parse(args[1:])
 
 		// Validate required flags
 		if *graderFlag == "" {
@@ -283,17 +654,74 @@ func main() {
 			os.Exit(1)
 		}
 
-		if err := runGradeCommand(*graderFlag, *inputFlag, *specFlag, *singleFormatFlag); err != nil {
+		if strings.TrimSpace(*singleModelFlag) == "" {
+			fmt.Println("Error: --model must not be empty")
+			gradeSingleCmd.Usage()
+			os.Exit(1)
+		}
+
+		configPath, err := defaultConfigPath()
+		if err != nil {
+			log.Fatalf("Failed to resolve config path: %v", err)
+		}
+		config, err := loadConfig(configPath)
+		if err != nil {
+			log.Fatalf("Failed to load config: %v", err)
+		}
+
+		opts := GradeOptions{
+			Model:              *singleModelFlag,
+			VagueScopeKeywords: config.TaskQuality.VagueScopeKeywords,
+			AcceptanceMarkers:  config.TaskQuality.AcceptanceMarkers,
+		}
+		client, err := newLLMClient(config.LLM)
+		switch {
+		case errors.Is(err, errNoAPIKey):
+			log.Printf("Warning: %v; using heuristic evaluation", err)
+		case err != nil:
+			log.Fatalf("Failed to configure LLM client: %v", err)
+		default:
+			opts.LLMClient = client
+		}
+		if err := runGradeCommandWithOptions(*graderFlag, *inputFlag, *specFlag, *singleFormatFlag, opts); err != nil {
 			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 			os.Exit(1)
 		}
 
 	case "grade-skills":
-		gradeCmd.Parse(os.Args[2:])
+		gradeCmd.Parse(args[1:])
+
+		if strings.TrimSpace(*gradeSkillsModel) == "" {
+			fmt.Println("Error: --model must not be empty")
+			gradeCmd.Usage()
+			os.Exit(1)
+		}
+		warnThresholdSet := false
+		gradeCmd.Visit(func(f *flag.Flag) {
+			if f.Name == "warn-threshold" {
+				warnThresholdSet = true
+			}
+		})
+		thresholds, err := resolveSkillThresholds(*gradeThreshold, *gradeWarnThreshold, warnThresholdSet)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			gradeCmd.Usage()
+			os.Exit(1)
+		}
+		if *gradeSelfConsistency < 1 {
+			fmt.Println("Error: --self-consistency must be at least 1")
+			gradeCmd.Usage()
+			os.Exit(1)
+		}
+		if *gradeConcurrency < 1 {
+			fmt.Println("Error: --concurrency must be at least 1")
+			gradeCmd.Usage()
+			os.Exit(1)
+		}
 
-		// Set default output path if not specified
+		// Set default output path if not specified; --summary-only writes no report and jsonl streams to stdout
 		output := *reportPath
-		if output == "" {
+		if output == "" && !*gradeSummaryOnly && *gradeSkillsFormat != "jsonl" {
 			// Get the yokay-evals directory (parent of cmd)
 			execPath, err := os.Executable()
 			if err != nil {
@@ -307,18 +735,56 @@ func main() {
 				log.Fatalf("Failed to create reports directory: %v", err)
 			}
 
-			today := time.Now().Format("2006-01-02")
-			output = filepath.Join(reportsDir, fmt.Sprintf("skill-clarity-%s.md", today))
+			filename, err := gradeReportFilename(*gradeFilenamePattern, time.Now())
+			if err != nil {
+				log.Fatalf("Invalid filename pattern: %v", err)
+			}
+			if *gradeSkillsFormat == "json" {
+				filename = strings.TrimSuffix(filename, filepath.Ext(filename)) + ".json"
+			}
+			output = filepath.Join(reportsDir, filename)
+		}
+
+		// Grade with an LLM when one can be configured, otherwise fall back to heuristics
+		opts := GradeSkillsOptions{Format: *gradeSkillsFormat, Model: *gradeSkillsModel, SelfConsistency: *gradeSelfConsistency, SummaryOnly: *gradeSummaryOnly, Rubric: *gradeRubric, Baseline: *gradeBaseline, Concurrency: *gradeConcurrency}
+		opts.Thresholds = thresholds
+		configPath, err := defaultConfigPath()
+		if err != nil {
+			log.Fatalf("Failed to resolve config path: %v", err)
+		}
+		config, err := loadConfig(configPath)
+		if err != nil {
+			log.Fatalf("Failed to load config: %v", err)
+		}
+		client, err := newLLMClient(config.LLM)
+		switch {
+		case errors.Is(err, errNoAPIKey):
+			log.Printf("Warning: %v; using heuristic evaluation", err)
+		case err != nil:
+			log.Fatalf("Failed to configure LLM client: %v", err)
+		default:
+			opts.LLMClient = client
+		}
+		opts.ReportNote = config.GradeSkills.ReportNote
+		opts.Labels = config.GradeSkills.Labels
+		if *gradeReportNote != "" {
+			opts.ReportNote = *gradeReportNote
 		}
 
-		if err := gradeSkills(*skillsDir, output); err != nil {
+		skillsPath := *skillsDir
+		if skillsPath == "" {
+			skillsPath = defaultSkillsDir(mustGetwd())
+		}
+		if err := gradeSkillsWithContext(ctx, skillsPath, output, opts); err != nil {
 			log.Fatalf("Failed to grade skills: %v", err)
 		}
 
-		fmt.Printf("Report generated: %s\n", output)
+		if !*gradeSummaryOnly && output != "" {
+			fmt.Printf("Report generated: %s\n", output)
+		}
 
 	case "meta":
-		metaCmd.Parse(os.Args[2:])
+		metaCmd.Parse(args[1:])
 
 		// Set default meta directory if not specified
 		metaDir := *metaDirFlag
@@ -343,12 +809,31 @@ func main() {
 			}
 		}
 
-		if err := runMetaCommand(*suite, *agent, *k, metaDir, *confirm); err != nil {
+		opts := MetaOptions{Parallel: *metaParallel, ReportsDir: *metaReportsDir, NoLog: *metaNoLog, MaxRetries: *metaMaxRetries, Format: *metaFormat, OutputDir: *metaOutputDir, Runner: *metaRunner, CostPerCall: *metaCostPerCall, MaxCost: *metaMaxCost, TiePolicy: *metaTiePolicy, EvalGlob: *metaEvalGlob}
+		configPath, err := defaultConfigPath()
+		if err != nil {
+			log.Fatalf("Failed to resolve config path: %v", err)
+		}
+		config, err := loadConfig(configPath)
+		if err != nil {
+			log.Fatalf("Failed to load config: %v", err)
+		}
+		// Flags win over config
+		if opts.Runner == "" {
+			opts.Runner = config.AgentRunner
+		}
+		if opts.CostPerCall == 0 {
+			opts.CostPerCall = config.Meta.CostPerCall
+		}
+		if opts.MaxCost == 0 {
+			opts.MaxCost = config.Meta.MaxCost
+		}
+		if err := runMetaCommandWithContext(ctx, *suite, *agent, *k, metaDir, *confirm, opts); err != nil {
 			log.Fatalf("Failed to run meta-evaluation: %v", err)
 		}
 
 	case "eval":
-		evalCmd.Parse(os.Args[2:])
+		evalCmd.Parse(args[1:])
 
 		// Set default failures directory if not specified
 		failuresDir := *failuresDirFlag
@@ -380,42 +865,61 @@ func main() {
 			}
 		}
 
-		if err := runEvalCommand(failuresDir, *categoryFlag, *kFlag, *formatFlag); err != nil {
+		opts := EvalOptions{RerunFailed: *rerunFailedFlag, OnlyChangedCategories: *onlyChangedCategoriesFlag}
+		if opts.OnlyChangedCategories != "" {
+			homeDir, err := os.UserHomeDir()
+			if err != nil {
+				log.Fatalf("Failed to get home directory: %v", err)
+			}
+			opts.FailuresDB = filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+			if _, err := os.Stat(opts.FailuresDB); os.IsNotExist(err) {
+				fmt.Fprintln(os.Stderr, "Error: kaizen not initialized. Run 'kaizen init' first.")
+				os.Exit(1)
+			}
+		}
+		if err := runEvalCommandWithOptions(failuresDir, *categoryFlag, *kFlag, *formatFlag, opts); err != nil {
 			log.Fatalf("Failed to run eval command: %v", err)
 		}
 
 	case "report":
-		reportCmd.Parse(os.Args[2:])
+		reportCmd.Parse(args[1:])
 
 		// Set default reports directory if not specified
 		reportsDir := *reportsDirFlag
 		if reportsDir == "" {
-			// Try to find reports directory relative to current working directory
-			cwd, err := os.Getwd()
-			if err != nil {
-				log.Fatalf("Failed to get current directory: %v", err)
-			}
+			reportsDir = defaultReportsDir(mustGetwd())
+		}
 
-			// Check if we're in the project root or a subdirectory
-			if strings.Contains(cwd, "pokayokay") {
-				// Find the project root
-				parts := strings.Split(cwd, "pokayokay")
-				if len(parts) > 0 {
-					projectRoot := parts[0] + "pokayokay"
-					reportsDir = filepath.Join(projectRoot, "reports")
-				}
-			} else {
-				// Assume reports is relative to current directory
-				reportsDir = "reports"
-			}
+		// Validate the date range before reading any reports
+		since, until, err := parseReportDateRange(*reportSince, *reportUntil)
+		if err != nil {
+			log.Fatalf("Failed to run report command: %v", err)
 		}
 
-		if err := runReportCommand(*reportType, *reportFormat, *listReports, *outputFile, reportsDir, !*noTrends); err != nil {
+		reportOpts := ReportOptions{
+			GradeReportPattern:  *reportFilenamePattern,
+			WorstTasks:          *reportWorst,
+			History:             *reportHistory,
+			Tag:                 *reportTag,
+			RecomputePassed:     *reportPassThreshold >= 0,
+			PassThreshold:       *reportPassThreshold,
+			Since:               since,
+			Until:               until,
+			FailOnRegression:    *reportFailOnRegression,
+			RegressionThreshold: *reportRegressionThreshold,
+		}
+		err = runReportCommandWithOptions(*reportType, *reportFormat, *listReports, *outputFile, reportsDir, !*noTrends, reportOpts)
+		if errors.Is(err, errRegressionDetected) {
+			// The report is already written; only the exit status changes
+			fmt.Fprintf(os.Stderr, "Report: %v\n", err)
+			os.Exit(1)
+		}
+		if err != nil {
 			log.Fatalf("Failed to run report command: %v", err)
 		}
 
 	case "grade-task":
-		gradeTaskCmd.Parse(os.Args[2:])
+		gradeTaskCmd.Parse(args[1:])
 
 		// Parse changed files
 		var files []string
@@ -427,87 +931,407 @@ func main() {
 			}
 		}
 
-		if err := runGradeTaskCommand(*taskID, *taskType, files, *workDir, *gradeFormat); err != nil {
-			log.Fatalf("Failed to run grade-task command: %v", err)
+		// Parse tags
+		var tags []string
+		for _, tag := range strings.Split(*gradeTags, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
+			}
 		}
 
-	case "grade-task-quality":
-		gradeTaskQualityCmd.Parse(os.Args[2:])
-
-		if err := runGradeTaskQuality(*qualityTaskID, *qualityTaskTitle, *qualityTaskType, *qualityDescription, *qualityAcceptanceCriteria, *qualityMinDescLength, *qualityFormat); err != nil {
-			log.Fatalf("Failed to run grade-task-quality command: %v", err)
+		// Load per-task-type grader pipelines from config
+		configPath, err := defaultConfigPath()
+		if err != nil {
+			log.Fatalf("Failed to resolve config path: %v", err)
+		}
+		config, err := loadConfig(configPath)
+		if err != nil {
+			log.Fatalf("Failed to load config: %v", err)
 		}
 
-	case "gate":
-		gateCmd.Parse(os.Args[2:])
-
-		// Set default reports directory if not specified
-		reportsDir := *gateReportsDir
-		if reportsDir == "" {
-			// Try to find reports directory relative to current working directory
-			cwd, err := os.Getwd()
-			if err != nil {
-				log.Fatalf("Failed to get current directory: %v", err)
+		// Results are appended to <reports-dir>/task-eval-log.json unless --eval-log or --no-log says otherwise
+		evalLogPath := *gradeEvalLog
+		if *gradeNoLog {
+			evalLogPath = ""
+		} else if evalLogPath == "" {
+			reportsDir := *gradeReportsDir
+			if reportsDir == "" {
+				reportsDir = defaultReportsDir(mustGetwd())
 			}
+			evalLogPath = filepath.Join(reportsDir, evalLogFilename)
+		}
 
-			// Check if we're in the project root or a subdirectory
-			if strings.Contains(cwd, "pokayokay") {
-				// Find the project root
-				parts := strings.Split(cwd, "pokayokay")
-				if len(parts) > 0 {
-					projectRoot := parts[0] + "pokayokay"
-					reportsDir = filepath.Join(projectRoot, "reports")
+		opts := GradeTaskOptions{
+			RunID:           *gradeRunID,
+			EvalLogPath:     evalLogPath,
+			Tags:            tags,
+			GraderPipelines: config.GraderPipelines,
+			GraderSettings:  config.Graders,
+			GitDiffRef:      *gradeGitDiff,
+			CoverageFile:    *gradeCoverageFile,
+			FailuresOnly:    *gradeFailuresOnly,
+			DisabledGraders: gradeDisabledGraders,
+			OnlyGraders:     gradeOnlyGraders,
+			Output:          *gradeTaskOutput,
+		}
+		if *gradeGraders != "" {
+			opts.Graders = []string{}
+			for _, name := range strings.Split(*gradeGraders, ",") {
+				if name = strings.TrimSpace(name); name != "" {
+					opts.Graders = append(opts.Graders, name)
 				}
-			} else {
-				// Assume reports is relative to current directory
-				reportsDir = "reports"
 			}
 		}
 
-		if err := runGateCommand(*gateType, *gateThreshold, reportsDir); err != nil {
-			log.Fatalf("Gate check failed: %v", err)
+		if err := runGradeTaskCommandWithOptions(*taskID, *taskType, files, *workDir, *gradeFormat, opts); err != nil {
+			log.Fatalf("Failed to run grade-task command: %v", err)
 		}
 
-	case "dashboard":
-		dashboardCmd.Parse(os.Args[2:])
+	case "graders":
+		if len(args) < 2 || args[1] != "list" {
+			fmt.Fprintln(os.Stderr, "Usage: kaizen graders list [--format text|json]")
+			os.Exit(1)
+		}
+		gradersListCmd.Parse(args[2:])
 
-		// Set default reports directory if not specified
-		reportsDir := *dashboardReportsDir
-		if reportsDir == "" {
-			// Try to find reports directory relative to current working directory
-			cwd, err := os.Getwd()
-			if err != nil {
-				log.Fatalf("Failed to get current directory: %v", err)
-			}
+		output, err := runGradersListCommand(*gradersListFormat)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
 
-			// Check if we're in the project root or a subdirectory
-			if strings.Contains(cwd, "pokayokay") {
-				// Find the project root
-				parts := strings.Split(cwd, "pokayokay")
-				if len(parts) > 0 {
-					projectRoot := parts[0] + "pokayokay"
-					reportsDir = filepath.Join(projectRoot, "reports")
-				}
-			} else {
-				// Assume reports is relative to current directory
-				reportsDir = "reports"
+		fmt.Print(output)
+
+	case "compare":
+		compareCmd.Parse(args[1:])
+
+		if *compareFrom == "" || *compareTo == "" {
+			fmt.Println("Error: --from and --to are required")
+			compareCmd.Usage()
+			os.Exit(1)
+		}
+
+		output, err := runCompareCommand(CompareOptions{
+			From:            *compareFrom,
+			To:              *compareTo,
+			Format:          *compareFormat,
+			ReportsDir:      *compareReportsDir,
+			FilenamePattern: *compareFilenamePattern,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Print(output)
+
+	case "grade-task-quality":
+		gradeTaskQualityCmd.Parse(args[1:])
+
+		task := taskQualityInput{
+			ID:                 *qualityTaskID,
+			Title:              *qualityTaskTitle,
+			Type:               *qualityTaskType,
+			Description:        *qualityDescription,
+			AcceptanceCriteria: *qualityAcceptanceCriteria,
+		}
+		if *qualityTaskFile != "" {
+			var err error
+			if task, err = task.withTaskFile(*qualityTaskFile); err != nil {
+				log.Fatalf("Failed to read task file: %v", err)
 			}
 		}
 
-		if err := runDashboardCommand(reportsDir, *dashboardOutput); err != nil {
+		qualityOpts := TaskQualityOptions{Verbose: *qualityVerbose, Output: *qualityOutput}
+		if err := runGradeTaskQualityWithOptions(task.ID, task.Title, task.Type, task.Description, task.AcceptanceCriteria, *qualityMinDescLength, *qualityFormat, qualityOpts); err != nil {
+			log.Fatalf("Failed to run grade-task-quality command: %v", err)
+		}
+
+	case "gate":
+		gateCmd.Parse(args[1:])
+
+		// Set default reports directory if not specified
+		reportsDir := *gateReportsDir
+		if reportsDir == "" {
+			reportsDir = defaultReportsDir(mustGetwd())
+		}
+
+		categoryThresholds, err := parseCategoryThresholds(*gateThresholds)
+		if err != nil {
+			log.Fatalf("Invalid --thresholds: %v", err)
+		}
+
+		opts := GateOptions{
+			MinRuns:            *gateMinRuns,
+			Format:             *gateFormat,
+			RecomputePassed:    *gatePassThreshold >= 0,
+			PassThreshold:      *gatePassThreshold,
+			CategoryThresholds: categoryThresholds,
+		}
+
+		if err := runGateCommandWithOptions(*gateType, *gateThreshold, reportsDir, opts); err != nil {
+			log.Fatalf("Gate check failed: %v", err)
+		}
+
+	case "validate":
+		validateCmd.Parse(args[1:])
+
+		opts := ValidateOptions{Format: *validateFormat}
+		if err := runValidateCommand(*validateMetaDir, opts); err != nil {
+			log.Fatalf("Validation failed: %v", err)
+		}
+
+	case "dashboard":
+		dashboardCmd.Parse(args[1:])
+
+		// Set default reports directory if not specified
+		reportsDir := *dashboardReportsDir
+		if reportsDir == "" {
+			reportsDir = defaultReportsDir(mustGetwd())
+		}
+
+		outputPath := *dashboardOutput
+		if outputPath == "" && !*dashboardDataOnly {
+			outputPath = "dashboard.html"
+		}
+
+		opts := DashboardOptions{DataOnly: *dashboardDataOnly, Format: *dashboardFormat}
+		if homeDir, err := os.UserHomeDir(); err == nil {
+			opts.FailuresDB = filepath.Join(homeDir, ".config", "kaizen", "failures.db")
+		}
+		if err := runDashboardCommandWithOptions(reportsDir, outputPath, opts); err != nil {
 			log.Fatalf("Dashboard generation failed: %v", err)
 		}
 
-		fmt.Printf("Dashboard generated: %s\n", *dashboardOutput)
+		if outputPath != "" {
+			fmt.Printf("Dashboard generated: %s\n", outputPath)
+		}
+
+	case "version":
+		fmt.Println(version.String())
 
 	default:
-		fmt.Printf("Unknown command: %s\n", os.Args[1])
+		fmt.Printf("Unknown command: %s\n", args[0])
 		os.Exit(1)
 	}
 }
 
+// pokayokayRoot returns the pokayokay project directory containing cwd, if there is one
+func pokayokayRoot(cwd string) (string, bool) {
+	before, _, found := strings.Cut(cwd, "pokayokay")
+	if !found {
+		return "", false
+	}
+	return before + "pokayokay", true
+}
+
+// defaultReportsDir returns the reports directory used when --reports-dir is not given:
+// reports/ at the root of the pokayokay project containing cwd, or reports/ relative to cwd
+func defaultReportsDir(cwd string) string {
+	if root, ok := pokayokayRoot(cwd); ok {
+		return filepath.Join(root, "reports")
+	}
+	return "reports"
+}
+
+// defaultSkillsDir returns the skills directory used when --skills-dir is not given:
+// the pokayokay plugin's skills inside the project containing cwd, or skills/ relative to cwd
+func defaultSkillsDir(cwd string) string {
+	if root, ok := pokayokayRoot(cwd); ok {
+		return filepath.Join(root, "plugins", "pokayokay", "skills")
+	}
+	return "skills"
+}
+
+// mustGetwd returns the current working directory, exiting if it cannot be determined
+func mustGetwd() string {
+	cwd, err := os.Getwd()
+	if err != nil {
+		log.Fatalf("Failed to get current directory: %v", err)
+	}
+	return cwd
+}
+
 // gradeSkills finds all skill files, grades them, and generates a report
 func gradeSkills(skillsDir, reportPath string) error {
+	return gradeSkillsWithFormat(skillsDir, reportPath, "markdown")
+}
+
+// Default grade-skills thresholds
+const (
+	defaultSkillPassingThreshold = 70.0
+	defaultSkillWarnThreshold    = 80.0
+)
+
+// SkillThresholds are the scores skills are graded and reported against
+type SkillThresholds struct {
+	// Passing is the minimum score for a skill to pass
+	Passing float64
+	// Warn is the score below which a passing skill is listed as needing improvement
+	Warn float64
+}
+
+// Default statuses of skills below the warning threshold in the markdown report
+const (
+	defaultNeedsImprovementLabel = "Needs Improvement"
+	defaultFailedLabel           = "**FAILED**"
+)
+
+// SkillReportLabels are the statuses the markdown report gives skills below the warning threshold
+type SkillReportLabels struct {
+	// NeedsImprovement labels passing skills scoring below the warning threshold
+	NeedsImprovement string `yaml:"needs_improvement"`
+	// Failed labels skills scoring below the passing threshold
+	Failed string `yaml:"failed"`
+}
+
+// withDefaults fills unset labels with the defaults
+func (l SkillReportLabels) withDefaults() SkillReportLabels {
+	if l.NeedsImprovement == "" {
+		l.NeedsImprovement = defaultNeedsImprovementLabel
+	}
+	if l.Failed == "" {
+		l.Failed = defaultFailedLabel
+	}
+	return l
+}
+
+// label returns the status of a skill below the warning threshold: Failed under the passing
+// threshold, NeedsImprovement otherwise
+func (l SkillReportLabels) label(score float64, thresholds SkillThresholds) string {
+	if score < thresholds.Passing {
+		return l.Failed
+	}
+	return l.NeedsImprovement
+}
+
+// builtinRubric names the built-in skill clarity criteria in reports
+const builtinRubric = "built-in"
+
+// defaultSkillThresholds returns the thresholds used when none are configured
+func defaultSkillThresholds() SkillThresholds {
+	return SkillThresholds{Passing: defaultSkillPassingThreshold, Warn: defaultSkillWarnThreshold}
+}
+
+// resolveSkillThresholds validates the grade-skills --threshold and --warn-threshold values.
+// When --warn-threshold was not set it defaults to the larger of its default and the passing
+// threshold, so raising --threshold alone never makes the warn threshold invalid.
+func resolveSkillThresholds(passing, warn float64, warnSet bool) (SkillThresholds, error) {
+	if passing < 0 || passing > 100 {
+		return SkillThresholds{}, fmt.Errorf("--threshold must be between 0 and 100")
+	}
+	if !warnSet {
+		warn = defaultSkillWarnThreshold
+		if passing > warn {
+			warn = passing
+		}
+	}
+	if warn < passing || warn > 100 {
+		return SkillThresholds{}, fmt.Errorf("--warn-threshold must be between --threshold and 100")
+	}
+	return SkillThresholds{Passing: passing, Warn: warn}, nil
+}
+
+// GradeSkillsOptions holds optional settings for the grade-skills command
+type GradeSkillsOptions struct {
+	// Format is the report format: markdown, json, or jsonl to stream one JSON object per skill
+	// as it is graded (to stdout when the report path is empty)
+	Format string
+	// LLMClient grades skills with an LLM; nil uses heuristic evaluation
+	LLMClient llm.Client
+	// Model is the LLM model used for grading (default: modelbased.DefaultModel)
+	Model string
+	// SelfConsistency is the number of LLM responses aggregated per skill (0 or 1: a single response)
+	SelfConsistency int
+	// SummaryOnly prints aggregate metrics to stdout instead of writing the report;
+	// progress goes to stderr so the summary stays machine-readable
+	SummaryOnly bool
+	// Thresholds are the passing and warning scores; the zero value uses the defaults (70 and 80)
+	Thresholds SkillThresholds
+	// ReportNote replaces the report header note; empty states the grading mode
+	ReportNote string
+	// Labels replace the markdown report's statuses for skills below the thresholds; empty labels use the defaults
+	Labels SkillReportLabels
+	// Rubric is the path of a markdown rubric the LLM grades against instead of the built-in criteria
+	Rubric string
+	// Baseline is a prior markdown or JSON report; skills scoring lower than in it are flagged as regressions
+	Baseline string
+	// Concurrency is the number of skills graded at once (0 or 1: one at a time)
+	Concurrency int
+}
+
+// gradeSkillsWithFormat grades all skills and writes the report in the given format (markdown, json or jsonl)
+func gradeSkillsWithFormat(skillsDir, reportPath, format string) error {
+	return gradeSkillsWithOptions(skillsDir, reportPath, GradeSkillsOptions{Format: format})
+}
+
+// gradeSkillsWithOptions grades all skills and writes the report using the given options
+func gradeSkillsWithOptions(skillsDir, reportPath string, opts GradeSkillsOptions) error {
+	return gradeSkillsWithContext(context.Background(), skillsDir, reportPath, opts)
+}
+
+// gradeSkillsWithContext grades all skills and writes the report, stopping when ctx is done.
+// Skills graded before ctx ended are still written to the report.
+func gradeSkillsWithContext(ctx context.Context, skillsDir, reportPath string, opts GradeSkillsOptions) error {
+	format := opts.Format
+	if format != "markdown" && format != "json" && format != "jsonl" {
+		return fmt.Errorf("unsupported format: %s (use 'markdown', 'json' or 'jsonl')", format)
+	}
+	if format == "jsonl" && opts.SummaryOnly {
+		return fmt.Errorf("--summary-only cannot be combined with the jsonl format")
+	}
+
+	thresholds := opts.Thresholds
+	if thresholds == (SkillThresholds{}) {
+		thresholds = defaultSkillThresholds()
+	}
+
+	progress := io.Writer(os.Stdout)
+	if opts.SummaryOnly {
+		progress = os.Stderr
+	}
+	warnings := newWarnings(format)
+
+	// jsonl streams each skill as it is graded, so the destination is opened up front
+	var stream io.Writer
+	if format == "jsonl" {
+		if reportPath == "" {
+			stream = os.Stdout
+			progress = os.Stderr
+		} else {
+			file, err := os.Create(reportPath)
+			if err != nil {
+				return fmt.Errorf("creating report file: %w", err)
+			}
+			defer file.Close()
+			stream = file
+		}
+	}
+
+	var rubric string
+	if opts.Rubric != "" {
+		content, err := os.ReadFile(opts.Rubric)
+		if err != nil {
+			return fmt.Errorf("reading rubric: %w", err)
+		}
+		rubric = textutil.NormalizeLineEndings(string(content))
+		if strings.TrimSpace(rubric) == "" {
+			return fmt.Errorf("rubric %s is empty", opts.Rubric)
+		}
+		if opts.LLMClient == nil {
+			warnings.Addf("rubric %s is ignored by heuristic evaluation", opts.Rubric)
+		}
+	}
+
+	var baseline map[string]float64
+	if opts.Baseline != "" {
+		scores, err := loadSkillBaseline(opts.Baseline)
+		if err != nil {
+			return fmt.Errorf("loading baseline: %w", err)
+		}
+		baseline = scores
+	}
+
 	// Find all SKILL.md files
 	skillFiles, err := findSkillFiles(skillsDir)
 	if err != nil {
@@ -518,56 +1342,178 @@ func gradeSkills(skillsDir, reportPath string) error {
 		return fmt.Errorf("no skill files found in %s", skillsDir)
 	}
 
-	fmt.Printf("Found %d skills to grade...\n", len(skillFiles))
+	fmt.Fprintf(progress, "Found %d skills to grade...\n", len(skillFiles))
 
 	// Grade each skill
-	grader := modelbased.NewSkillClarityGrader()
-	results := make([]skillResult, 0, len(skillFiles))
+	grader := modelbased.NewSkillClarityGrader().
+		WithLLMClient(opts.LLMClient).
+		WithSelfConsistency(opts.SelfConsistency).
+		WithPassingScore(thresholds.Passing).
+		WithRubric(rubric)
+	if opts.Model != "" {
+		grader.WithModel(opts.Model)
+	}
+	rubricPath := ""
+	if grader.UsesLLM() && grader.UsesRubric() {
+		rubricPath = opts.Rubric
+	}
 
-	for i, skillPath := range skillFiles {
-		fmt.Printf("[%d/%d] Grading %s...\n", i+1, len(skillFiles), filepath.Base(filepath.Dir(skillPath)))
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
 
-		// Read skill content
-		content, err := os.ReadFile(skillPath)
-		if err != nil {
-			log.Printf("Warning: Failed to read %s: %v", skillPath, err)
-			continue
-		}
+	// Worker pool grading skills; mu guards progress output, warnings, graded and the jsonl stream.
+	// graded is indexed like skillFiles so the results keep file order whatever order they finish in.
+	graded := make([]*skillResult, len(skillFiles))
+	var streamErr error
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan int)
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range queue {
+				mu.Lock()
+				stopped := streamErr != nil
+				mu.Unlock()
+				// Drain skills that were queued before cancellation without grading them
+				if stopped || ctx.Err() != nil {
+					continue
+				}
 
-		// Grade the skill
-		result, err := grader.Grade(modelbased.GradeInput{
-			Content: string(content),
-			Context: map[string]any{
-				"path": skillPath,
-			},
-		})
-		if err != nil {
-			log.Printf("Warning: Failed to grade %s: %v", skillPath, err)
-			continue
-		}
+				skillPath := skillFiles[i]
+				mu.Lock()
+				fmt.Fprintf(progress, "[%d/%d] Grading %s...\n", i+1, len(skillFiles), filepath.Base(filepath.Dir(skillPath)))
+				mu.Unlock()
+
+				// Read skill content
+				content, err := os.ReadFile(skillPath)
+				if err != nil {
+					mu.Lock()
+					warnings.Addf("Failed to read %s: %v", skillPath, err)
+					mu.Unlock()
+					continue
+				}
 
-		// Extract skill name from path (directory name containing SKILL.md)
-		skillName := filepath.Base(filepath.Dir(skillPath))
+				// Grade the skill
+				result, err := grader.GradeContext(ctx, modelbased.GradeInput{
+					Content: textutil.NormalizeLineEndings(string(content)),
+					Context: map[string]any{
+						"path": skillPath,
+					},
+				})
+				if err != nil {
+					if ctx.Err() != nil {
+						continue
+					}
+					mu.Lock()
+					warnings.Addf("Failed to grade %s: %v", skillPath, err)
+					mu.Unlock()
+					continue
+				}
 
-		results = append(results, skillResult{
-			Name:    skillName,
-			Path:    skillPath,
-			Score:   result.Score,
-			Passed:  result.Passed,
-			Message: result.Message,
-			Details: result.Details,
-		})
+				// Extract skill name from path (directory name containing SKILL.md)
+				skillName := filepath.Base(filepath.Dir(skillPath))
+
+				var baselineScore *float64
+				if score, ok := baseline[skillName]; ok {
+					baselineScore = &score
+				}
+
+				graded[i] = &skillResult{
+					Name:        skillName,
+					Path:        skillPath,
+					Score:       result.Score,
+					Passed:      result.Passed,
+					Message:     result.Message,
+					Details:     result.Details,
+					GradedByLLM: grader.UsesLLM(),
+					Model:       grader.Model(),
+					Rubric:      rubricPath,
+
+					BaselineScore: baselineScore,
+				}
+
+				// jsonl lines are written in the order skills finish
+				if stream != nil {
+					mu.Lock()
+					if streamErr == nil {
+						if err := json.NewEncoder(stream).Encode(newSkillReportEntry(*graded[i])); err != nil {
+							streamErr = fmt.Errorf("writing skill result: %w", err)
+						}
+					}
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for i := range skillFiles {
+		select {
+		case queue <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
 	}
+	close(queue)
+	wg.Wait()
 
+	if streamErr != nil {
+		return streamErr
+	}
+	results := make([]skillResult, 0, len(skillFiles))
+	for _, result := range graded {
+		if result != nil {
+			results = append(results, *result)
+		}
+	}
+
+	if ctx.Err() != nil && len(results) == 0 {
+		return fmt.Errorf("grading stopped before any skill was graded: %w", context.Cause(ctx))
+	}
 	if len(results) == 0 {
 		return fmt.Errorf("no skills were successfully graded")
 	}
 
+	if opts.Baseline != "" {
+		writeSkillRegressions(progress, opts.Baseline, findSkillRegressions(results))
+	}
+
+	if opts.SummaryOnly {
+		if err := writeSkillSummary(os.Stdout, results, thresholds, format); err != nil {
+			return fmt.Errorf("writing summary: %w", err)
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("grading stopped after %d/%d skills (summary covers graded skills only): %w", len(results), len(skillFiles), context.Cause(ctx))
+		}
+		return nil
+	}
+
+	// jsonl results were written as each skill was graded
+	if format == "jsonl" {
+		if ctx.Err() != nil {
+			return fmt.Errorf("grading stopped after %d/%d skills: %w", len(results), len(skillFiles), context.Cause(ctx))
+		}
+		return nil
+	}
+
 	// Generate report
-	if err := generateReport(results, reportPath); err != nil {
+	if format == "json" {
+		err = generateReportJSON(results, reportPath, SkillReportOptions{Thresholds: thresholds, Note: opts.ReportNote, Warnings: warnings})
+	} else {
+		err = generateReport(results, reportPath, SkillReportOptions{Thresholds: thresholds, Note: opts.ReportNote, Labels: opts.Labels})
+	}
+	if err != nil {
 		return fmt.Errorf("generating report: %w", err)
 	}
 
+	if ctx.Err() != nil {
+		return fmt.Errorf("grading stopped after %d/%d skills (partial report written to %s): %w", len(results), len(skillFiles), reportPath, context.Cause(ctx))
+	}
+
 	return nil
 }
 
@@ -594,8 +1540,47 @@ func findSkillFiles(rootDir string) ([]string, error) {
 	return skillFiles, nil
 }
 
-// generateReport creates a markdown report from grading results
-func generateReport(results []skillResult, reportPath string) error {
+// SkillReportOptions configure the markdown and JSON skill reports
+type SkillReportOptions struct {
+	// Thresholds are the passing and warning scores; the zero value uses the defaults
+	Thresholds SkillThresholds
+	// Note is the report header note; empty states the grading mode
+	Note string
+	// Labels are the markdown statuses of skills below the warning threshold (defaults for empty labels)
+	Labels SkillReportLabels
+	// Warnings raised while grading are listed in the JSON report when non-nil
+	Warnings *Warnings
+}
+
+// skillReportNote returns the report header note: customNote when set, otherwise how the skills
+// were graded (LLM and model, or heuristic stub)
+func skillReportNote(results []skillResult, customNote string) string {
+	if customNote != "" {
+		return customNote
+	}
+	if len(results) > 0 && results[0].GradedByLLM {
+		return fmt.Sprintf("Skills were graded by LLM (model %s).", results[0].Model)
+	}
+	return "Skills were graded by heuristic evaluation (stub implementation, no LLM client configured)."
+}
+
+// skillReportRubric returns the rubric LLM grading used: the custom rubric path or "built-in"
+func skillReportRubric(results []skillResult) string {
+	if len(results) > 0 && results[0].Rubric != "" {
+		return results[0].Rubric
+	}
+	return builtinRubric
+}
+
+// generateReport creates a markdown report from grading results, listing skills below the
+// warning threshold as needing improvement
+func generateReport(results []skillResult, reportPath string, opts SkillReportOptions) error {
+	thresholds := opts.Thresholds
+	if thresholds == (SkillThresholds{}) {
+		thresholds = defaultSkillThresholds()
+	}
+	labels := opts.Labels.withDefaults()
+
 	// Sort results by score (highest to lowest)
 	sort.Slice(results, func(i, j int) bool {
 		return results[i].Score > results[j].Score
@@ -618,34 +1603,68 @@ func generateReport(results []skillResult, reportPath string) error {
 
 	// Header
 	sb.WriteString("# Skill Clarity Report\n\n")
-	sb.WriteString(fmt.Sprintf("Generated: %s\n\n", time.Now().Format("2006-01-02 15:04:05")))
+	sb.WriteString(fmt.Sprintf("Generated: %s\n", time.Now().Format("2006-01-02 15:04:05")))
+	if results[0].GradedByLLM {
+		sb.WriteString(fmt.Sprintf("Model: %s\n", results[0].Model))
+		sb.WriteString(fmt.Sprintf("Rubric: %s\n", skillReportRubric(results)))
+	}
+	sb.WriteString("\n")
 	sb.WriteString("This report evaluates pokayokay skills using the Skill Clarity Grader.\n")
-	sb.WriteString("**Note**: Current grading uses heuristic-based evaluation (stub implementation). LLM-based grading not yet implemented.\n\n")
+	sb.WriteString(fmt.Sprintf("**Note**: %s\n\n", skillReportNote(results, opts.Note)))
 
 	// Summary
 	sb.WriteString("## Summary\n\n")
 	sb.WriteString(fmt.Sprintf("- **Total Skills**: %d\n", len(results)))
 	sb.WriteString(fmt.Sprintf("- **Average Score**: %.1f/100\n", avgScore))
 	sb.WriteString(fmt.Sprintf("- **Pass Rate**: %.1f%% (%d/%d)\n", passRate, passCount, len(results)))
-	sb.WriteString(fmt.Sprintf("- **Passing Threshold**: 70.0\n\n"))
+	sb.WriteString(fmt.Sprintf("- **Passing Threshold**: %.1f\n", thresholds.Passing))
+	stats := calculateSkillScoreStats(results)
+	sb.WriteString(fmt.Sprintf("- **Score Distribution**: min %.1f, p25 %.1f, median %.1f, p75 %.1f, max %.1f\n\n",
+		stats.Min, stats.P25, stats.Median, stats.P75, stats.Max))
+
+	// Regressions against --baseline
+	if regressions := findSkillRegressions(results); len(regressions) > 0 {
+		sb.WriteString(fmt.Sprintf("## Regressions vs Baseline (%d)\n\n", len(regressions)))
+		sb.WriteString("These skills scored lower than in the baseline report:\n\n")
+		sb.WriteString("| Skill | Baseline | Current | Change |\n")
+		sb.WriteString("|-------|----------|---------|--------|\n")
+		for _, regression := range regressions {
+			sb.WriteString(fmt.Sprintf("| %s | %.1f | %.1f | %+.1f ↓ |\n",
+				regression.Name, regression.BaselineScore, regression.Score, regression.Delta))
+		}
+		sb.WriteString("\n")
+	}
 
 	// Skills below threshold
 	belowThreshold := []skillResult{}
 	for _, r := range results {
-		if r.Score < 80.0 {
+		if r.Score < thresholds.Warn {
 			belowThreshold = append(belowThreshold, r)
 		}
 	}
 
 	if len(belowThreshold) > 0 {
-		sb.WriteString("## Skills Below Threshold (< 80%)\n\n")
+		sb.WriteString(fmt.Sprintf("## Skills Below Threshold (< %g%%)\n\n", thresholds.Warn))
 		sb.WriteString("These skills need improvement:\n\n")
 		for _, r := range belowThreshold {
-			status := "Needs Improvement"
-			if r.Score < 70.0 {
-				status = "**FAILED**"
-			}
-			sb.WriteString(fmt.Sprintf("- **%s** - %.1f/100 - %s\n", r.Name, r.Score, status))
+			sb.WriteString(fmt.Sprintf("- **%s** - %.1f/100 - %s\n", r.Name, r.Score, labels.label(r.Score, thresholds)))
+		}
+		sb.WriteString("\n")
+	}
+
+	// Which criterion most often holds failing skills back
+	if tallies := tallyFailingCriteria(results); len(tallies) > 0 {
+		failedCount := 0
+		for _, tally := range tallies {
+			failedCount += tally.Count
+		}
+		sb.WriteString("## Most Common Failing Criteria\n\n")
+		sb.WriteString(fmt.Sprintf("Lowest-scoring criterion of the %d skills below the passing threshold:\n\n", failedCount))
+		sb.WriteString("| Criterion | Skills | Share |\n")
+		sb.WriteString("|-----------|--------|-------|\n")
+		for _, tally := range tallies {
+			sb.WriteString(fmt.Sprintf("| %s | %d | %.1f%% |\n",
+				formatCriterionName(tally.Name), tally.Count, float64(tally.Count)/float64(failedCount)*100))
 		}
 		sb.WriteString("\n")
 	}
@@ -660,7 +1679,7 @@ func generateReport(results []skillResult, reportPath string) error {
 		status := "✅ Pass"
 		if !r.Passed {
 			status = "❌ Fail"
-		} else if r.Score < 80.0 {
+		} else if r.Score < thresholds.Warn {
 			status = "⚠️  Pass (Low)"
 		}
 		sb.WriteString(fmt.Sprintf("| %d | %s | %.1f | %s |\n", i+1, r.Name, r.Score, status))
@@ -675,23 +1694,10 @@ func generateReport(results []skillResult, reportPath string) error {
 		sb.WriteString("**Criteria Scores**:\n\n")
 
 		// Extract and display criteria details
-		criteria := []string{"clear_instructions", "actionable_steps", "good_examples", "appropriate_scope"}
-		for _, criterion := range criteria {
-			if details, ok := r.Details[criterion].(map[string]any); ok {
-				// Safely extract fields with type checking
-				score, scoreOk := details["score"].(float64)
-				feedback, feedbackOk := details["feedback"].(string)
-				weight, weightOk := details["weight"].(float64)
-
-				// Skip this criterion if any field is missing or has wrong type
-				if !scoreOk || !feedbackOk || !weightOk {
-					continue
-				}
-
-				sb.WriteString(fmt.Sprintf("- **%s** (weight: %.0f%%): %.1f/100\n",
-					formatCriterionName(criterion), weight*100, score))
-				sb.WriteString(fmt.Sprintf("  - %s\n", feedback))
-			}
+		for _, criterion := range extractSkillCriteria(r.Details) {
+			sb.WriteString(fmt.Sprintf("- **%s** (weight: %.0f%%): %.1f/100\n",
+				formatCriterionName(criterion.Name), criterion.Weight*100, criterion.Score))
+			sb.WriteString(fmt.Sprintf("  - %s\n", criterion.Feedback))
 		}
 		sb.WriteString("\n")
 	}
@@ -704,6 +1710,336 @@ func generateReport(results []skillResult, reportPath string) error {
 	return nil
 }
 
+// CriterionTally counts how many failing skills had a criterion as their lowest score
+type CriterionTally struct {
+	Name  string
+	Count int
+}
+
+// tallyFailingCriteria counts, for skills that failed, which criterion scored lowest.
+// Ties go to the criterion listed first in skillCriteria. Tallies are sorted by count,
+// most common first; skills without criteria details are not counted.
+func tallyFailingCriteria(results []skillResult) []CriterionTally {
+	counts := make(map[string]int)
+	for _, r := range results {
+		if r.Passed {
+			continue
+		}
+		criteria := extractSkillCriteria(r.Details)
+		if len(criteria) == 0 {
+			continue
+		}
+		lowest := criteria[0]
+		for _, criterion := range criteria[1:] {
+			if criterion.Score < lowest.Score {
+				lowest = criterion
+			}
+		}
+		counts[lowest.Name]++
+	}
+
+	var tallies []CriterionTally
+	for _, name := range skillCriteria {
+		if counts[name] > 0 {
+			tallies = append(tallies, CriterionTally{Name: name, Count: counts[name]})
+		}
+	}
+	sort.SliceStable(tallies, func(i, j int) bool {
+		return tallies[i].Count > tallies[j].Count
+	})
+	return tallies
+}
+
+// skillCriteria lists the skill clarity criteria in report order
+var skillCriteria = []string{"clear_instructions", "actionable_steps", "good_examples", "appropriate_scope"}
+
+// SkillCriterionResult is a single criterion's score, weight and feedback for a skill
+type SkillCriterionResult struct {
+	Name     string  `json:"name"`
+	Score    float64 `json:"score"`
+	Weight   float64 `json:"weight"`
+	Feedback string  `json:"feedback"`
+}
+
+// SkillReportEntry is a single skill's result in the JSON skill report
+type SkillReportEntry struct {
+	Name     string                 `json:"name"`
+	Path     string                 `json:"path"`
+	Score    float64                `json:"score"`
+	Passed   bool                   `json:"passed"`
+	Message  string                 `json:"message"`
+	Criteria []SkillCriterionResult `json:"criteria"`
+	// BaselineScore is the skill's score in the --baseline report, when one was given and had the skill
+	BaselineScore *float64 `json:"baseline_score,omitempty"`
+}
+
+// SkillScoreStats summarizes the distribution of skill scores.
+// Percentiles use linear interpolation between closest ranks (see metrics.Percentile).
+type SkillScoreStats struct {
+	Min    float64 `json:"min"`
+	P25    float64 `json:"p25"`
+	Median float64 `json:"median"`
+	P75    float64 `json:"p75"`
+	Max    float64 `json:"max"`
+}
+
+// SkillReportJSON is the JSON form of the skill clarity report
+type SkillReportJSON struct {
+	SchemaVersion    string             `json:"schema_version"`
+	GeneratedAt      string             `json:"generated_at"`
+	Model            string             `json:"model,omitempty"`
+	Rubric           string             `json:"rubric,omitempty"`
+	TotalSkills      int                `json:"total_skills"`
+	AverageScore     float64            `json:"average_score"`
+	PassRate         float64            `json:"pass_rate"`
+	PassingThreshold float64            `json:"passing_threshold"`
+	WarnThreshold    float64            `json:"warn_threshold"`
+	Note             string             `json:"note"`
+	ScoreStats       SkillScoreStats    `json:"score_stats"`
+	Regressions      []SkillRegression  `json:"regressions,omitempty"`
+	Skills           []SkillReportEntry `json:"skills"`
+	Warnings         []string           `json:"warnings"`
+}
+
+// calculateSkillScoreStats computes min, p25, median, p75 and max of skill scores
+func calculateSkillScoreStats(results []skillResult) SkillScoreStats {
+	scores := make([]float64, len(results))
+	for i, r := range results {
+		scores[i] = r.Score
+	}
+
+	return SkillScoreStats{
+		Min:    metrics.Percentile(scores, 0),
+		P25:    metrics.Percentile(scores, 25),
+		Median: metrics.Percentile(scores, 50),
+		P75:    metrics.Percentile(scores, 75),
+		Max:    metrics.Percentile(scores, 100),
+	}
+}
+
+// extractSkillCriteria extracts per-criterion results from a grader's details.
+// Criteria with missing or mistyped fields are skipped.
+func extractSkillCriteria(details map[string]any) []SkillCriterionResult {
+	criteria := []SkillCriterionResult{}
+	for _, criterion := range skillCriteria {
+		criterionDetails, ok := details[criterion].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		// Safely extract fields with type checking
+		score, scoreOk := criterionDetails["score"].(float64)
+		feedback, feedbackOk := criterionDetails["feedback"].(string)
+		weight, weightOk := criterionDetails["weight"].(float64)
+		if !scoreOk || !feedbackOk || !weightOk {
+			continue
+		}
+
+		criteria = append(criteria, SkillCriterionResult{
+			Name:     criterion,
+			Score:    score,
+			Weight:   weight,
+			Feedback: feedback,
+		})
+	}
+	return criteria
+}
+
+// newSkillReportEntry converts a skill's grading result to its JSON report entry
+func newSkillReportEntry(r skillResult) SkillReportEntry {
+	return SkillReportEntry{
+		Name:     r.Name,
+		Path:     r.Path,
+		Score:    r.Score,
+		Passed:   r.Passed,
+		Message:  r.Message,
+		Criteria: extractSkillCriteria(r.Details),
+
+		BaselineScore: r.BaselineScore,
+	}
+}
+
+// generateReportJSON creates a JSON report from grading results with per-criterion detail,
+// recording the thresholds used
+func generateReportJSON(results []skillResult, reportPath string, opts SkillReportOptions) error {
+	thresholds := opts.Thresholds
+	if thresholds == (SkillThresholds{}) {
+		thresholds = defaultSkillThresholds()
+	}
+
+	// Sort results by score (highest to lowest)
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	report := SkillReportJSON{
+		SchemaVersion:    reportSchemaVersion,
+		GeneratedAt:      time.Now().UTC().Format(time.RFC3339),
+		TotalSkills:      len(results),
+		PassingThreshold: thresholds.Passing,
+		WarnThreshold:    thresholds.Warn,
+		Note:             skillReportNote(results, opts.Note),
+		ScoreStats:       calculateSkillScoreStats(results),
+		Regressions:      findSkillRegressions(results),
+		Skills:           make([]SkillReportEntry, 0, len(results)),
+		Warnings:         opts.Warnings.List(),
+	}
+	if len(results) > 0 && results[0].GradedByLLM {
+		report.Model = results[0].Model
+		report.Rubric = skillReportRubric(results)
+	}
+
+	totalScore := 0.0
+	passCount := 0
+	for _, r := range results {
+		totalScore += r.Score
+		if r.Passed {
+			passCount++
+		}
+		report.Skills = append(report.Skills, newSkillReportEntry(r))
+	}
+	if len(results) > 0 {
+		report.AverageScore = totalScore / float64(len(results))
+		report.PassRate = float64(passCount) / float64(len(results)) * 100
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding report: %w", err)
+	}
+
+	if err := os.WriteFile(reportPath, data, 0644); err != nil {
+		return fmt.Errorf("writing report file: %w", err)
+	}
+
+	return nil
+}
+
+// SkillSummaryJSON is the JSON form of grade-skills --summary-only
+type SkillSummaryJSON struct {
+	SchemaVersion       string  `json:"schema_version"`
+	TotalSkills         int     `json:"total_skills"`
+	AverageScore        float64 `json:"average_score"`
+	PassRate            float64 `json:"pass_rate"`
+	PassingThreshold    float64 `json:"passing_threshold"`
+	BelowThresholdCount int     `json:"below_threshold_count"`
+}
+
+// calculateSkillSummary computes the aggregate metrics of graded skills against passingThreshold
+func calculateSkillSummary(results []skillResult, passingThreshold float64) SkillSummaryJSON {
+	summary := SkillSummaryJSON{
+		TotalSkills:      len(results),
+		PassingThreshold: passingThreshold,
+	}
+
+	totalScore := 0.0
+	passCount := 0
+	for _, r := range results {
+		totalScore += r.Score
+		if r.Passed {
+			passCount++
+		}
+		if r.Score < summary.PassingThreshold {
+			summary.BelowThresholdCount++
+		}
+	}
+	if len(results) > 0 {
+		summary.AverageScore = totalScore / float64(len(results))
+		summary.PassRate = float64(passCount) / float64(len(results)) * 100
+	}
+
+	return summary
+}
+
+// writeSkillSummary writes the aggregate skill metrics as JSON or as a markdown summary list
+func writeSkillSummary(w io.Writer, results []skillResult, thresholds SkillThresholds, format string) error {
+	summary := calculateSkillSummary(results, thresholds.Passing)
+
+	if format == "json" {
+		summary.SchemaVersion = reportSchemaVersion
+		data, err := json.MarshalIndent(summary, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding summary: %w", err)
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "- **Total Skills**: %d\n- **Average Score**: %.1f/100\n- **Pass Rate**: %.1f%%\n- **Passing Threshold**: %.1f\n- **Below Threshold**: %d\n",
+		summary.TotalSkills, summary.AverageScore, summary.PassRate, summary.PassingThreshold, summary.BelowThresholdCount)
+	return err
+}
+
+// baselineRegressionTolerance absorbs the rounding of report scores to one decimal
+const baselineRegressionTolerance = 0.05
+
+// SkillRegression is a skill that scored lower than in the baseline report
+type SkillRegression struct {
+	Name          string  `json:"name"`
+	BaselineScore float64 `json:"baseline_score"`
+	Score         float64 `json:"score"`
+	Delta         float64 `json:"delta"`
+}
+
+// loadSkillBaseline reads per-skill scores from a prior grade-skills report: the JSON skills list,
+// or the Overall Score of each skill in the markdown Detailed Breakdown
+func loadSkillBaseline(path string) (map[string]float64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	scores := make(map[string]float64)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var report SkillReportJSON
+		if err := json.Unmarshal(data, &report); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		for _, skill := range report.Skills {
+			scores[skill.Name] = skill.Score
+		}
+	} else {
+		scores = parseSkillBreakdownScores(string(data))
+	}
+
+	if len(scores) == 0 {
+		return nil, fmt.Errorf("%s has no per-skill scores", path)
+	}
+	return scores, nil
+}
+
+// findSkillRegressions returns the skills scoring lower than their baseline score, largest drop first
+func findSkillRegressions(results []skillResult) []SkillRegression {
+	var regressions []SkillRegression
+	for _, r := range results {
+		if r.BaselineScore == nil || r.Score >= *r.BaselineScore-baselineRegressionTolerance {
+			continue
+		}
+		regressions = append(regressions, SkillRegression{
+			Name:          r.Name,
+			BaselineScore: *r.BaselineScore,
+			Score:         r.Score,
+			Delta:         r.Score - *r.BaselineScore,
+		})
+	}
+	sort.SliceStable(regressions, func(i, j int) bool {
+		return regressions[i].Delta < regressions[j].Delta
+	})
+	return regressions
+}
+
+// writeSkillRegressions prints the regressions against the baseline report at baselinePath
+func writeSkillRegressions(w io.Writer, baselinePath string, regressions []SkillRegression) {
+	if len(regressions) == 0 {
+		fmt.Fprintf(w, "No regressions vs baseline %s\n", baselinePath)
+		return
+	}
+	fmt.Fprintf(w, "⚠️  %d skills regressed vs baseline %s:\n", len(regressions), baselinePath)
+	for _, regression := range regressions {
+		fmt.Fprintf(w, "  %s: %.1f → %.1f (%+.1f)\n", regression.Name, regression.BaselineScore, regression.Score, regression.Delta)
+	}
+}
+
 // formatCriterionName converts snake_case to Title Case
 func formatCriterionName(name string) string {
 	parts := strings.Split(name, "_")
@@ -723,10 +2059,203 @@ type GradeTaskOutput struct {
 	Results       []codebased.GradeResult `json:"results"`
 	OverallPassed bool                    `json:"overall_passed"`
 	OverallScore  float64                 `json:"overall_score"`
+	// Status is "pass", "fail" or "no_applicable_graders" when every grader skipped the task
+	Status string   `json:"status,omitempty"`
+	RunID  string   `json:"run_id,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	// ChangedFileLanguages counts the changed files by extension, e.g. {"go": 4, "md": 1}
+	ChangedFileLanguages map[string]int `json:"changed_file_languages,omitempty"`
+}
+
+// Overall statuses of a grade-task run
+const (
+	gradeStatusPass                = "pass"
+	gradeStatusFail                = "fail"
+	gradeStatusNoApplicableGraders = "no_applicable_graders"
+)
+
+// GradeTaskOptions holds optional settings for the grade-task command
+type GradeTaskOptions struct {
+	// RunID identifies the run so retried CI jobs don't log duplicate entries
+	RunID string
+	// EvalLogPath is the eval log to append the result to (empty disables logging)
+	EvalLogPath string
+	// Tags annotate the run (e.g. "nightly", "pre-release") for filtering in reports
+	Tags []string
+	// GraderPipelines maps task types to the graders to run (from config.yaml)
+	GraderPipelines map[string][]string
+	// GraderSettings configure how individual graders report findings (from config.yaml)
+	GraderSettings GradersConfig
+	// GitDiffRef derives the changed files from `git diff <ref>...HEAD` in the work dir
+	GitDiffRef string
+	// CoverageFile is an existing coverage profile read by test-coverage instead of running go test
+	CoverageFile string
+	// Graders is an allowlist of grader names that overrides the configured pipeline
+	Graders []string
+	// FailuresOnly limits the printed results to failing graders; the score and eval log still use all graders
+	FailuresOnly bool
+	// DisabledGraders are removed from the pipeline; they don't appear in the output or affect the score
+	DisabledGraders []string
+	// OnlyGraders restricts the pipeline to these graders (empty keeps all)
+	OnlyGraders []string
+	// Output writes the results to this file instead of stdout, which gets a one-line summary
+	Output string
+}
+
+// repeatedFlag collects the values of a flag that may be given more than once
+type repeatedFlag []string
+
+// String returns the values joined by commas
+func (f *repeatedFlag) String() string {
+	return strings.Join(*f, ",")
+}
+
+// Set appends one occurrence of the flag
+func (f *repeatedFlag) Set(value string) error {
+	if value = strings.TrimSpace(value); value == "" {
+		return fmt.Errorf("grader name must not be empty")
+	}
+	*f = append(*f, value)
+	return nil
+}
+
+// defaultGraderPipeline lists the graders grade-task runs when no pipeline is configured
+var defaultGraderPipeline = []string{"file-exists", "test-exists", "endpoint-exists"}
+
+// allGraders is the --graders value that runs every registered grader, including those that
+// execute commands such as test-coverage
+const allGraders = "all"
+
+// resolveGraderPipeline returns the graders to run for a task type.
+// A configured pipeline overrides the default; unknown grader names are an error.
+func resolveGraderPipeline(taskType string, pipelines map[string][]string) ([]codebased.CodeGrader, error) {
+	names, ok := pipelines[taskType]
+	if !ok {
+		names = defaultGraderPipeline
+	}
+
+	graders := make([]codebased.CodeGrader, 0, len(names))
+	for _, name := range names {
+		grader := codebased.New(name)
+		if grader == nil {
+			return nil, fmt.Errorf("unknown grader %q in pipeline for task type %q", name, taskType)
+		}
+		graders = append(graders, grader)
+	}
+
+	return graders, nil
+}
+
+// configureCodeGrader applies the configured settings to a code grader
+func configureCodeGrader(grader codebased.CodeGrader, settings GradersConfig) {
+	switch g := grader.(type) {
+	case *codebased.DebugStatementGrader:
+		g.WithWarnOnly(settings.DebugStatements.WarnOnly)
+	case *codebased.FlakyTestGrader:
+		g.WithFailOnFindings(settings.FlakyTests.FailOnFindings)
+	case *codebased.TestCoverageGrader:
+		if settings.TestCoverage.Threshold > 0 {
+			g.WithThreshold(settings.TestCoverage.Threshold)
+		}
+	}
+}
+
+// selectGraders returns the graders named in a --graders allowlist, in the order given.
+// A lone "all" selects every registered grader.
+func selectGraders(names []string) ([]codebased.CodeGrader, error) {
+	if len(names) == 1 && names[0] == allGraders {
+		names = codebased.Names()
+	}
+
+	graders := make([]codebased.CodeGrader, 0, len(names))
+	for _, name := range names {
+		grader := codebased.New(name)
+		if grader == nil {
+			return nil, fmt.Errorf("unknown grader %q in --graders (available: %s)", name, strings.Join(codebased.Names(), ", "))
+		}
+		graders = append(graders, grader)
+	}
+	if len(graders) == 0 {
+		return nil, fmt.Errorf("--graders must name at least one grader")
+	}
+
+	return graders, nil
+}
+
+// filterGraders keeps the graders named in only (all when only is empty) minus those in disabled,
+// preserving pipeline order. Names must be registered graders.
+func filterGraders(graders []codebased.CodeGrader, only, disabled []string) ([]codebased.CodeGrader, error) {
+	if len(only) == 0 && len(disabled) == 0 {
+		return graders, nil
+	}
+
+	toSet := func(flagName string, names []string) (map[string]bool, error) {
+		set := make(map[string]bool, len(names))
+		for _, name := range names {
+			if codebased.New(name) == nil {
+				return nil, fmt.Errorf("unknown grader %q in %s (available: %s)", name, flagName, strings.Join(codebased.Names(), ", "))
+			}
+			set[name] = true
+		}
+		return set, nil
+	}
+	onlySet, err := toSet("--only-grader", only)
+	if err != nil {
+		return nil, err
+	}
+	disabledSet, err := toSet("--disable-grader", disabled)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := make([]codebased.CodeGrader, 0, len(graders))
+	for _, grader := range graders {
+		if (len(onlySet) > 0 && !onlySet[grader.Name()]) || disabledSet[grader.Name()] {
+			continue
+		}
+		kept = append(kept, grader)
+	}
+	if len(kept) == 0 {
+		return nil, fmt.Errorf("no graders left to run after --only-grader and --disable-grader")
+	}
+
+	return kept, nil
+}
+
+// gitChangedFiles lists files changed between ref and HEAD in workDir, relative to workDir so they
+// resolve the same way as --changed-files when workDir is a subdirectory of the repository.
+// Deleted files are excluded since there is nothing on disk for graders to read.
+func gitChangedFiles(workDir, ref string) ([]string, error) {
+	// A ref starting with "-" would be parsed by git as an option
+	if strings.HasPrefix(ref, "-") {
+		return nil, fmt.Errorf("invalid git ref %q: must not start with \"-\"", ref)
+	}
+
+	cmd := exec.Command("git", "diff", "--name-only", "--relative", "--diff-filter=d", ref+"...HEAD")
+	cmd.Dir = workDir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("running git diff against %s: %w: %s", ref, err, strings.TrimSpace(stderr.String()))
+	}
+
+	var files []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
 }
 
 // runGradeTaskCommand executes the grade-task CLI command
 func runGradeTaskCommand(taskID, taskType string, changedFiles []string, workDir, format string) error {
+	return runGradeTaskCommandWithOptions(taskID, taskType, changedFiles, workDir, format, GradeTaskOptions{})
+}
+
+// runGradeTaskCommandWithOptions executes the grade-task CLI command with optional settings
+func runGradeTaskCommandWithOptions(taskID, taskType string, changedFiles []string, workDir, format string, opts GradeTaskOptions) error {
 	// Validate taskType
 	validTaskTypes := []string{"feature", "bug", "test", "spike", "chore"}
 	isValid := false
@@ -739,6 +2268,21 @@ func runGradeTaskCommand(taskID, taskType string, changedFiles []string, workDir
 	if !isValid {
 		return fmt.Errorf("invalid task type %q: must be one of: %s", taskType, strings.Join(validTaskTypes, ", "))
 	}
+	if threshold := opts.GraderSettings.TestCoverage.Threshold; threshold < 0 || threshold > 100 {
+		return fmt.Errorf("invalid graders.test_coverage.threshold %g: must be between 0 and 100", threshold)
+	}
+
+	// Derive changed files from git instead of --changed-files
+	if opts.GitDiffRef != "" {
+		if len(changedFiles) > 0 {
+			return fmt.Errorf("--changed-files and --git-diff are mutually exclusive")
+		}
+		files, err := gitChangedFiles(workDir, opts.GitDiffRef)
+		if err != nil {
+			return err
+		}
+		changedFiles = files
+	}
 
 	// Create input for graders
 	input := codebased.GradeInput{
@@ -746,93 +2290,263 @@ func runGradeTaskCommand(taskID, taskType string, changedFiles []string, workDir
 		TaskType:     taskType,
 		ChangedFiles: changedFiles,
 		WorkDir:      workDir,
+		CoverageFile: opts.CoverageFile,
 	}
 
-	// Initialize graders
-	graders := []codebased.CodeGrader{
-		codebased.NewFileExistsGrader(),
-		codebased.NewTestExistsGrader(),
+	// Initialize graders: an explicit --graders allowlist wins over the configured pipeline
+	var graders []codebased.CodeGrader
+	var err error
+	if opts.Graders != nil {
+		graders, err = selectGraders(opts.Graders)
+	} else {
+		graders, err = resolveGraderPipeline(taskType, opts.GraderPipelines)
+		// Graders a configured pipeline names for this task type run whatever their defaults say
+		_, input.AnyTaskType = opts.GraderPipelines[taskType]
+	}
+	if err != nil {
+		return err
+	}
+	graders, err = filterGraders(graders, opts.OnlyGraders, opts.DisabledGraders)
+	if err != nil {
+		return err
 	}
 
 	// Run graders
 	var results []codebased.GradeResult
-	totalScore := float64(0)
-	applicableCount := 0
-
 	for _, grader := range graders {
+		configureCodeGrader(grader, opts.GraderSettings)
 		result := grader.Grade(input)
+		result.Weight = codebased.Weight(grader)
 		results = append(results, result)
-
-		// Only count applicable graders in overall score
-		if !result.Skipped {
-			totalScore += result.Score
-			applicableCount++
-		}
 	}
 
 	// Calculate overall metrics
-	overallScore := float64(0)
-	if applicableCount > 0 {
-		overallScore = totalScore / float64(applicableCount)
-	}
+	overallScore := weightedOverallScore(results)
 
-	// Overall passes if all applicable graders pass
+	// Overall passes if all applicable graders pass. When every grader skipped the task
+	// nothing was checked, so it neither passes with a score of 0 nor fails.
 	overallPassed := true
+	applicable := 0
 	for _, r := range results {
-		if !r.Skipped && !r.Passed {
+		if r.Skipped {
+			continue
+		}
+		applicable++
+		if !r.Passed {
 			overallPassed = false
-			break
 		}
 	}
+	status := gradeStatusPass
+	switch {
+	case applicable == 0:
+		overallPassed = false
+		status = gradeStatusNoApplicableGraders
+	case !overallPassed:
+		status = gradeStatusFail
+	}
 
-	// Format output
-	if format == "json" {
-		output := GradeTaskOutput{
-			TaskID:        taskID,
-			Timestamp:     time.Now().UTC().Format(time.RFC3339),
-			Results:       results,
-			OverallPassed: overallPassed,
-			OverallScore:  overallScore,
+	output := GradeTaskOutput{
+		TaskID:        taskID,
+		Timestamp:     time.Now().UTC().Format(time.RFC3339),
+		Results:       results,
+		OverallPassed: overallPassed,
+		OverallScore:  overallScore,
+		Status:        status,
+		RunID:         opts.RunID,
+		Tags:          opts.Tags,
+
+		ChangedFileLanguages: countChangedFileLanguages(changedFiles),
+	}
+
+	// Append to the eval log if requested. A run where every grader skipped checked nothing,
+	// so it is left out rather than counted as a score of 0 in gate, report and dashboard averages.
+	if opts.EvalLogPath != "" && status != gradeStatusNoApplicableGraders {
+		if _, err := appendEvalResult(opts.EvalLogPath, output); err != nil {
+			return fmt.Errorf("appending to eval log: %w", err)
 		}
+	}
 
-		encoder := json.NewEncoder(os.Stdout)
+	if opts.FailuresOnly {
+		output.Results = failingResults(results)
+	}
+
+	// Format output, to the --output file when set
+	var out io.Writer = os.Stdout
+	var buf bytes.Buffer
+	if opts.Output != "" {
+		out = &buf
+	}
+	if format == "json" {
+		encoder := json.NewEncoder(out)
 		encoder.SetIndent("", "  ")
 		if err := encoder.Encode(output); err != nil {
 			return fmt.Errorf("encoding JSON output: %w", err)
 		}
 	} else {
 		// Text format
-		fmt.Printf("Task Grading Results\n")
-		fmt.Printf("====================\n\n")
-		fmt.Printf("Task ID: %s\n", taskID)
-		fmt.Printf("Task Type: %s\n", taskType)
-		fmt.Printf("Changed Files: %d\n\n", len(changedFiles))
-
-		fmt.Printf("Grader Results:\n")
-		for _, r := range results {
+		fmt.Fprintf(out, "Task Grading Results\n")
+		fmt.Fprintf(out, "====================\n\n")
+		fmt.Fprintf(out, "Task ID: %s\n", taskID)
+		fmt.Fprintf(out, "Task Type: %s\n", taskType)
+		if len(changedFiles) > 0 {
+			fmt.Fprintf(out, "Changed Files: %d (%s)\n\n", len(changedFiles), formatChangedFileLanguages(output.ChangedFileLanguages))
+		} else {
+			fmt.Fprintf(out, "Changed Files: 0\n\n")
+		}
+
+		fmt.Fprintf(out, "Grader Results:\n")
+		if opts.FailuresOnly && len(output.Results) == 0 {
+			fmt.Fprintf(out, "  No failing graders\n")
+		}
+		for _, r := range output.Results {
 			if r.Skipped {
-				fmt.Printf("  %s: SKIPPED (%s)\n", r.GraderName, r.SkipReason)
+				fmt.Fprintf(out, "  %s: SKIPPED (%s)\n", r.GraderName, r.SkipReason)
 			} else {
 				status := "PASS"
 				if !r.Passed {
 					status = "FAIL"
 				}
-				fmt.Printf("  %s: %s (score: %.1f) - %s\n", r.GraderName, status, r.Score, r.Details)
+				weight := ""
+				if r.Weight != codebased.DefaultWeight {
+					weight = fmt.Sprintf(", weight: %g", r.Weight)
+				}
+				fmt.Fprintf(out, "  %s: %s (score: %.1f%s) - %s\n", r.GraderName, status, r.Score, weight, r.Details)
 			}
 		}
 
-		fmt.Printf("\nOverall Score: %.1f\n", overallScore)
-		fmt.Printf("Overall Result: ")
-		if overallPassed {
-			fmt.Printf("PASS\n")
+		if status == gradeStatusNoApplicableGraders {
+			fmt.Fprintf(out, "\nOverall Score: n/a\n")
+			fmt.Fprintf(out, "Overall Result: NO APPLICABLE GRADERS (all %d graders skipped; nothing was checked)\n", len(results))
 		} else {
-			fmt.Printf("FAIL\n")
+			fmt.Fprintf(out, "\nOverall Score: %.1f\n", overallScore)
+			fmt.Fprintf(out, "Overall Result: ")
+			if overallPassed {
+				fmt.Fprintf(out, "PASS\n")
+			} else {
+				fmt.Fprintf(out, "FAIL\n")
+			}
 		}
 	}
 
+	if opts.Output != "" {
+		if err := writeOutputFile(opts.Output, buf.Bytes()); err != nil {
+			return err
+		}
+		fmt.Println(gradeTaskSummary(output, results, opts.Output))
+	}
+
 	return nil
 }
 
+// gradeTaskSummary is the one-line result printed when grade-task writes its output to a file
+func gradeTaskSummary(output GradeTaskOutput, results []codebased.GradeResult, path string) string {
+	skipped := 0
+	for _, r := range results {
+		if r.Skipped {
+			skipped++
+		}
+	}
+	ran := len(results) - skipped
+
+	switch output.Status {
+	case gradeStatusNoApplicableGraders:
+		return fmt.Sprintf("Task %s: NO APPLICABLE GRADERS (%d skipped); results written to %s", output.TaskID, skipped, path)
+	case gradeStatusFail:
+		return fmt.Sprintf("Task %s: FAIL (score %.1f, %d graders run, %d skipped); results written to %s", output.TaskID, output.OverallScore, ran, skipped, path)
+	default:
+		return fmt.Sprintf("Task %s: PASS (score %.1f, %d graders run, %d skipped); results written to %s", output.TaskID, output.OverallScore, ran, skipped, path)
+	}
+}
+
+// writeOutputFile writes a command's output to path, creating the parent directory if needed
+func writeOutputFile(path string, data []byte) error {
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return fmt.Errorf("creating output directory: %w", err)
+		}
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing output file: %w", err)
+	}
+	return nil
+}
+
+// weightedOverallScore averages the scores of applicable graders by their weights. Skipped
+// graders count in neither the numerator nor the denominator; with equal weights this is the
+// plain average.
+func weightedOverallScore(results []codebased.GradeResult) float64 {
+	totalScore := float64(0)
+	totalWeight := float64(0)
+	for _, r := range results {
+		if r.Skipped {
+			continue
+		}
+		weight := r.Weight
+		if weight <= 0 {
+			weight = codebased.DefaultWeight
+		}
+		totalScore += r.Score * weight
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return 0
+	}
+	return totalScore / totalWeight
+}
+
+// failingResults returns the results of graders that ran and failed
+func failingResults(results []codebased.GradeResult) []codebased.GradeResult {
+	failing := []codebased.GradeResult{}
+	for _, r := range results {
+		if !r.Skipped && !r.Passed {
+			failing = append(failing, r)
+		}
+	}
+	return failing
+}
+
+// noExtensionLanguage groups changed files without an extension, e.g. Makefile
+const noExtensionLanguage = "other"
+
+// countChangedFileLanguages counts files by lowercased extension without the dot; files without
+// an extension are counted as noExtensionLanguage. It returns nil for no files.
+func countChangedFileLanguages(files []string) map[string]int {
+	if len(files) == 0 {
+		return nil
+	}
+	counts := make(map[string]int)
+	for _, file := range files {
+		language := strings.ToLower(strings.TrimPrefix(filepath.Ext(file), "."))
+		if language == "" {
+			language = noExtensionLanguage
+		}
+		counts[language]++
+	}
+	return counts
+}
+
+// formatChangedFileLanguages formats language counts as "go: 4, js: 2, md: 1",
+// most files first and alphabetically among equal counts
+func formatChangedFileLanguages(counts map[string]int) string {
+	languages := make([]string, 0, len(counts))
+	for language := range counts {
+		languages = append(languages, language)
+	}
+	sort.Slice(languages, func(i, j int) bool {
+		if counts[languages[i]] != counts[languages[j]] {
+			return counts[languages[i]] > counts[languages[j]]
+		}
+		return languages[i] < languages[j]
+	})
+
+	parts := make([]string, len(languages))
+	for i, language := range languages {
+		parts[i] = fmt.Sprintf("%s: %d", language, counts[language])
+	}
+	return strings.Join(parts, ", ")
+}
+
 // TaskQualityIssue represents a quality check issue
 type TaskQualityIssue struct {
 	Check   string `json:"check"`
@@ -846,10 +2560,33 @@ type TaskQualityResult struct {
 	Score      float64            `json:"score"`
 	Issues     []TaskQualityIssue `json:"issues"`
 	Suggestion string             `json:"suggestion"`
+	// Checks lists every check with the points it contributed; only set with --verbose
+	Checks []TaskQualityCheck `json:"checks,omitempty"`
+}
+
+// TaskQualityCheck is one scored check and the points it contributed to the score
+type TaskQualityCheck struct {
+	Name   string  `json:"name"`
+	Passed bool    `json:"passed"`
+	Points float64 `json:"points"`
 }
 
 // runGradeTaskQuality evaluates task quality based on metadata
 func runGradeTaskQuality(taskID, taskTitle, taskType, description, acceptanceCriteria string, minDescLength int, format string) error {
+	return runGradeTaskQualityWithOptions(taskID, taskTitle, taskType, description, acceptanceCriteria, minDescLength, format, TaskQualityOptions{})
+}
+
+// TaskQualityOptions holds optional settings for the grade-task-quality command
+type TaskQualityOptions struct {
+	// Verbose reports each check with PASS/FAIL and the points it contributed to the score
+	Verbose bool
+	// Output writes the result to this file instead of stdout, which gets a one-line summary
+	Output string
+}
+
+// runGradeTaskQualityWithOptions evaluates task quality based on metadata with optional settings
+func runGradeTaskQualityWithOptions(taskID, taskTitle, taskType, description, acceptanceCriteria string, minDescLength int, format string, opts TaskQualityOptions) error {
+	verbose := opts.Verbose
 	// Validate task type
 	validTaskTypes := []string{"feature", "bug", "test", "spike", "chore"}
 	isValid := false
@@ -875,6 +2612,12 @@ func runGradeTaskQuality(taskID, taskTitle, taskType, description, acceptanceCri
 	// Track total checks and failed checks for scoring
 	totalChecks := 4.0 // description_length, acceptance_criteria, ambiguous_keywords, spike_type
 	failedChecks := 0.0
+	checkPassed := map[string]bool{
+		"description_length":  true,
+		"acceptance_criteria": true,
+		"ambiguous_keywords":  true,
+		"spike_type":          true,
+	}
 
 	// Check 1: Description length
 	descLength := len(description)
@@ -883,6 +2626,7 @@ func runGradeTaskQuality(taskID, taskTitle, taskType, description, acceptanceCri
 			Check:   "description_length",
 			Message: fmt.Sprintf("Description too short (%d chars, minimum %d)", descLength, minDescLength),
 		})
+		checkPassed["description_length"] = false
 		failedChecks++
 	}
 
@@ -895,6 +2639,7 @@ func runGradeTaskQuality(taskID, taskTitle, taskType, description, acceptanceCri
 			Check:   "acceptance_criteria",
 			Message: "Missing acceptance criteria",
 		})
+		checkPassed["acceptance_criteria"] = false
 		failedChecks++
 	}
 
@@ -908,6 +2653,7 @@ func runGradeTaskQuality(taskID, taskTitle, taskType, description, acceptanceCri
 				Check:   "ambiguous_keywords",
 				Message: fmt.Sprintf("Contains ambiguous keyword '%s' - task may be too vague", keyword),
 			})
+			checkPassed["ambiguous_keywords"] = false
 			failedChecks++
 			break // Only report once
 		}
@@ -920,37 +2666,76 @@ func runGradeTaskQuality(taskID, taskTitle, taskType, description, acceptanceCri
 	result.Score = ((totalChecks - failedChecks) / totalChecks) * 100.0
 	result.Passed = len(result.Issues) == 0
 
+	if verbose {
+		pointsPerCheck := 100.0 / totalChecks
+		for _, name := range []string{"description_length", "acceptance_criteria", "ambiguous_keywords", "spike_type"} {
+			check := TaskQualityCheck{Name: name, Passed: checkPassed[name]}
+			if check.Passed {
+				check.Points = pointsPerCheck
+			}
+			result.Checks = append(result.Checks, check)
+		}
+	}
+
 	// Add suggestion if failed
 	if !result.Passed {
 		result.Suggestion = "Run /pokayokay:brainstorm to refine task requirements"
 	}
 
-	// Format output
+	// Format output, to the --output file when set
+	var out io.Writer = os.Stdout
+	var buf bytes.Buffer
+	if opts.Output != "" {
+		out = &buf
+	}
 	if format == "json" {
-		encoder := json.NewEncoder(os.Stdout)
+		encoder := json.NewEncoder(out)
 		encoder.SetIndent("", "  ")
 		if err := encoder.Encode(result); err != nil {
 			return fmt.Errorf("encoding JSON output: %w", err)
 		}
 	} else {
 		// Text format
-		fmt.Printf("Task Quality Check\n")
-		fmt.Printf("==================\n\n")
-		fmt.Printf("Task ID: %s\n", taskID)
-		fmt.Printf("Task Title: %s\n", taskTitle)
-		fmt.Printf("Task Type: %s\n", taskType)
-		fmt.Printf("Score: %.1f/100\n\n", result.Score)
+		fmt.Fprintf(out, "Task Quality Check\n")
+		fmt.Fprintf(out, "==================\n\n")
+		fmt.Fprintf(out, "Task ID: %s\n", taskID)
+		fmt.Fprintf(out, "Task Title: %s\n", taskTitle)
+		fmt.Fprintf(out, "Task Type: %s\n", taskType)
+		fmt.Fprintf(out, "Score: %.1f/100\n\n", result.Score)
+
+		if verbose {
+			fmt.Fprintf(out, "Checks:\n")
+			for _, check := range result.Checks {
+				status := "PASS"
+				if !check.Passed {
+					status = "FAIL"
+				}
+				fmt.Fprintf(out, "  %s %-20s %4.1f points\n", status, check.Name, check.Points)
+			}
+			fmt.Fprintf(out, "\n")
+		}
 
 		if result.Passed {
-			fmt.Printf("Status: PASSED\n")
+			fmt.Fprintf(out, "Status: PASSED\n")
 		} else {
-			fmt.Printf("Status: FAILED\n\n")
-			fmt.Printf("Issues:\n")
+			fmt.Fprintf(out, "Status: FAILED\n\n")
+			fmt.Fprintf(out, "Issues:\n")
 			for _, issue := range result.Issues {
-				fmt.Printf("  - [%s] %s\n", issue.Check, issue.Message)
+				fmt.Fprintf(out, "  - [%s] %s\n", issue.Check, issue.Message)
 			}
-			fmt.Printf("\nSuggestion: %s\n", result.Suggestion)
+			fmt.Fprintf(out, "\nSuggestion: %s\n", result.Suggestion)
+		}
+	}
+
+	if opts.Output != "" {
+		if err := writeOutputFile(opts.Output, buf.Bytes()); err != nil {
+			return err
+		}
+		status := "PASSED"
+		if !result.Passed {
+			status = "FAILED"
 		}
+		fmt.Printf("Task %s quality: %s (score %.1f/100, %d issues); result written to %s\n", taskID, status, result.Score, len(result.Issues), opts.Output)
 	}
 
 	return nil
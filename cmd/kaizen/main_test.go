@@ -1,10 +1,20 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/llm"
 )
 
 func TestGradeSkillsCommand(t *testing.T) {
@@ -158,7 +168,7 @@ func TestGenerateReport(t *testing.T) {
 		},
 	}
 
-	err := generateReport(results, reportPath)
+	err := generateReport(results, reportPath, SkillReportOptions{})
 	if err != nil {
 		t.Fatalf("generateReport failed: %v", err)
 	}
@@ -264,7 +274,7 @@ func TestGenerateReportWithMalformedDetails(t *testing.T) {
 	}
 
 	// Should not panic even with malformed details
-	err := generateReport(results, reportPath)
+	err := generateReport(results, reportPath, SkillReportOptions{})
 	if err != nil {
 		t.Fatalf("generateReport failed: %v", err)
 	}
@@ -296,3 +306,893 @@ func TestGenerateReportWithMalformedDetails(t *testing.T) {
 		t.Error("Report missing feedback from normal skill")
 	}
 }
+
+func TestGradeSkillsJSONIncludesCriteria(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportPath := filepath.Join(tmpDir, "skill-clarity.json")
+
+	skillsDir := filepath.Join(tmpDir, "skills")
+	if err := os.MkdirAll(filepath.Join(skillsDir, "json-skill"), 0755); err != nil {
+		t.Fatalf("Failed to create test skills dir: %v", err)
+	}
+
+	sampleSkill := `---
+name: json-skill
+description: A skill for JSON report validation
+---
+
+# JSON Skill
+
+## Instructions
+
+1. Run the command
+2. Check the output
+
+## Examples
+
+` + "```bash\nkaizen grade-skills --format json\n```" + `
+`
+	if err := os.WriteFile(filepath.Join(skillsDir, "json-skill", "SKILL.md"), []byte(sampleSkill), 0644); err != nil {
+		t.Fatalf("Failed to write test skill: %v", err)
+	}
+
+	if err := gradeSkillsWithFormat(skillsDir, reportPath, "json"); err != nil {
+		t.Fatalf("gradeSkillsWithFormat failed: %v", err)
+	}
+
+	content, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+
+	var report SkillReportJSON
+	if err := json.Unmarshal(content, &report); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+
+	if report.TotalSkills != 1 || len(report.Skills) != 1 {
+		t.Fatalf("Expected 1 skill in report, got total=%d skills=%d", report.TotalSkills, len(report.Skills))
+	}
+
+	skill := report.Skills[0]
+	if skill.Name != "json-skill" {
+		t.Errorf("Expected skill name json-skill, got %s", skill.Name)
+	}
+
+	expectedCriteria := []string{"clear_instructions", "actionable_steps", "good_examples", "appropriate_scope"}
+	if len(skill.Criteria) != len(expectedCriteria) {
+		t.Fatalf("Expected %d criteria, got %d: %+v", len(expectedCriteria), len(skill.Criteria), skill.Criteria)
+	}
+	for i, name := range expectedCriteria {
+		criterion := skill.Criteria[i]
+		if criterion.Name != name {
+			t.Errorf("Expected criterion %d to be %s, got %s", i, name, criterion.Name)
+		}
+		if criterion.Feedback == "" {
+			t.Errorf("Expected feedback for criterion %s", name)
+		}
+		if criterion.Weight <= 0 {
+			t.Errorf("Expected positive weight for criterion %s, got %f", name, criterion.Weight)
+		}
+	}
+}
+
+// stubSkillLLMClient returns a fixed skill clarity response
+type stubSkillLLMClient struct{}
+
+func (stubSkillLLMClient) Complete(ctx context.Context, prompt string, options ...llm.CompletionOption) (string, error) {
+	return `CLEAR_INSTRUCTIONS: 80
+CLEAR_INSTRUCTIONS_FEEDBACK: Clear
+ACTIONABLE_STEPS: 80
+ACTIONABLE_STEPS_FEEDBACK: Concrete
+GOOD_EXAMPLES: 80
+GOOD_EXAMPLES_FEEDBACK: Helpful
+APPROPRIATE_SCOPE: 80
+APPROPRIATE_SCOPE_FEEDBACK: Focused`, nil
+}
+
+// barrierSkillLLMClient holds every call until n calls are in flight, so grading only
+// completes when skills are graded concurrently
+type barrierSkillLLMClient struct {
+	n       int
+	mu      sync.Mutex
+	started int
+	release chan struct{}
+}
+
+func (c *barrierSkillLLMClient) Complete(ctx context.Context, prompt string, options ...llm.CompletionOption) (string, error) {
+	c.mu.Lock()
+	c.started++
+	if c.started == c.n {
+		close(c.release)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-c.release:
+	case <-time.After(5 * time.Second):
+		return "", errors.New("skills were not graded concurrently")
+	}
+	return stubSkillLLMClient{}.Complete(ctx, prompt, options...)
+}
+
+// TestGradeSkillsConcurrency verifies skills are graded in parallel and an unreadable skill is skipped
+func TestGradeSkillsConcurrency(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	names := []string{"alpha", "beta", "delta", "gamma"}
+	for _, skill := range names {
+		if err := os.MkdirAll(filepath.Join(skillsDir, skill), 0755); err != nil {
+			t.Fatalf("Failed to create test skills dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(skillsDir, skill, "SKILL.md"), []byte("# "+skill+"\n"), 0644); err != nil {
+			t.Fatalf("Failed to write test skill: %v", err)
+		}
+	}
+	// A dangling symlink is found as a skill but can't be read
+	if err := os.MkdirAll(filepath.Join(skillsDir, "broken"), 0755); err != nil {
+		t.Fatalf("Failed to create test skills dir: %v", err)
+	}
+	if err := os.Symlink(filepath.Join(tmpDir, "missing.md"), filepath.Join(skillsDir, "broken", "SKILL.md")); err != nil {
+		t.Fatalf("Failed to create symlink: %v", err)
+	}
+
+	reportPath := filepath.Join(tmpDir, "skill-clarity.json")
+	client := &barrierSkillLLMClient{n: len(names), release: make(chan struct{})}
+	err := gradeSkillsWithOptions(skillsDir, reportPath, GradeSkillsOptions{
+		Format:      "json",
+		LLMClient:   client,
+		Concurrency: len(names),
+	})
+	if err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+
+	content, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	var report SkillReportJSON
+	if err := json.Unmarshal(content, &report); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+
+	var graded []string
+	for _, skill := range report.Skills {
+		if skill.Score != 80 {
+			t.Errorf("Expected %s to score 80, got %.1f", skill.Name, skill.Score)
+		}
+		graded = append(graded, skill.Name)
+	}
+	sort.Strings(graded)
+	if got := strings.Join(graded, ","); got != strings.Join(names, ",") {
+		t.Errorf("Expected skills %v in report, got %s", names, got)
+	}
+	if len(report.Warnings) != 1 || !strings.Contains(report.Warnings[0], "Failed to read") {
+		t.Errorf("Expected one read warning for the broken skill, got %v", report.Warnings)
+	}
+}
+
+func TestGradeSkillsRecordsModel(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	if err := os.MkdirAll(filepath.Join(skillsDir, "model-skill"), 0755); err != nil {
+		t.Fatalf("Failed to create test skills dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(skillsDir, "model-skill", "SKILL.md"), []byte("# Model Skill\n"), 0644); err != nil {
+		t.Fatalf("Failed to write test skill: %v", err)
+	}
+
+	opts := GradeSkillsOptions{LLMClient: stubSkillLLMClient{}, Model: "claude-sonnet-4"}
+
+	jsonPath := filepath.Join(tmpDir, "skill-clarity.json")
+	opts.Format = "json"
+	if err := gradeSkillsWithOptions(skillsDir, jsonPath, opts); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	content, err := os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	var report SkillReportJSON
+	if err := json.Unmarshal(content, &report); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+	if report.Model != "claude-sonnet-4" {
+		t.Errorf("Expected model claude-sonnet-4 in JSON report, got %q", report.Model)
+	}
+
+	markdownPath := filepath.Join(tmpDir, "skill-clarity.md")
+	opts.Format = "markdown"
+	if err := gradeSkillsWithOptions(skillsDir, markdownPath, opts); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	content, err = os.ReadFile(markdownPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	if !strings.Contains(string(content), "Model: claude-sonnet-4") {
+		t.Errorf("Expected model in markdown report header, got:\n%s", content)
+	}
+}
+
+func TestGradeSkillsReportNote(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	if err := os.MkdirAll(filepath.Join(skillsDir, "note-skill"), 0755); err != nil {
+		t.Fatalf("Failed to create test skills dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(skillsDir, "note-skill", "SKILL.md"), []byte("# Note Skill\n"), 0644); err != nil {
+		t.Fatalf("Failed to write test skill: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		opts     GradeSkillsOptions
+		wantNote string
+	}{
+		{
+			name:     "LLM grading names the model",
+			opts:     GradeSkillsOptions{LLMClient: stubSkillLLMClient{}, Model: "claude-sonnet-4"},
+			wantNote: "Skills were graded by LLM (model claude-sonnet-4).",
+		},
+		{
+			name:     "heuristic grading states the stub",
+			opts:     GradeSkillsOptions{},
+			wantNote: "Skills were graded by heuristic evaluation (stub implementation, no LLM client configured).",
+		},
+		{
+			name:     "custom note replaces the mode",
+			opts:     GradeSkillsOptions{LLMClient: stubSkillLLMClient{}, ReportNote: "Weekly baseline run."},
+			wantNote: "Weekly baseline run.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			markdownPath := filepath.Join(t.TempDir(), "skill-clarity.md")
+			tt.opts.Format = "markdown"
+			if err := gradeSkillsWithOptions(skillsDir, markdownPath, tt.opts); err != nil {
+				t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+			}
+			content, err := os.ReadFile(markdownPath)
+			if err != nil {
+				t.Fatalf("Failed to read report: %v", err)
+			}
+			if !strings.Contains(string(content), "**Note**: "+tt.wantNote+"\n") {
+				t.Errorf("Expected note %q, got:\n%s", tt.wantNote, content)
+			}
+			if tt.opts.LLMClient != nil && strings.Contains(string(content), "stub implementation") {
+				t.Errorf("Expected no stub note for LLM grading, got:\n%s", content)
+			}
+
+			jsonPath := filepath.Join(t.TempDir(), "skill-clarity.json")
+			tt.opts.Format = "json"
+			if err := gradeSkillsWithOptions(skillsDir, jsonPath, tt.opts); err != nil {
+				t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+			}
+			content, err = os.ReadFile(jsonPath)
+			if err != nil {
+				t.Fatalf("Failed to read report: %v", err)
+			}
+			var report SkillReportJSON
+			if err := json.Unmarshal(content, &report); err != nil {
+				t.Fatalf("Report is not valid JSON: %v", err)
+			}
+			if report.Note != tt.wantNote {
+				t.Errorf("Expected JSON note %q, got %q", tt.wantNote, report.Note)
+			}
+		})
+	}
+}
+
+// rubricSkillLLMClient returns the stub skill clarity response and records the system prompt
+type rubricSkillLLMClient struct {
+	systemPrompt string
+}
+
+func (c *rubricSkillLLMClient) Complete(ctx context.Context, prompt string, options ...llm.CompletionOption) (string, error) {
+	c.systemPrompt = llm.SystemPrompt(options...)
+	return stubSkillLLMClient{}.Complete(ctx, prompt, options...)
+}
+
+func TestGradeSkillsRubric(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	if err := os.MkdirAll(filepath.Join(skillsDir, "rubric-skill"), 0755); err != nil {
+		t.Fatalf("Failed to create test skills dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(skillsDir, "rubric-skill", "SKILL.md"), []byte("# Rubric Skill\n"), 0644); err != nil {
+		t.Fatalf("Failed to write test skill: %v", err)
+	}
+	rubricPath := filepath.Join(tmpDir, "rubric.md")
+	if err := os.WriteFile(rubricPath, []byte("# Team Rubric\r\nEvery step names a command.\r\n"), 0644); err != nil {
+		t.Fatalf("Failed to write rubric: %v", err)
+	}
+
+	client := &rubricSkillLLMClient{}
+	markdownPath := filepath.Join(tmpDir, "skill-clarity.md")
+	opts := GradeSkillsOptions{Format: "markdown", LLMClient: client, Rubric: rubricPath}
+	if err := gradeSkillsWithOptions(skillsDir, markdownPath, opts); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	if !strings.Contains(client.systemPrompt, "# Team Rubric\nEvery step names a command.") {
+		t.Errorf("Expected rubric in system prompt, got %q", client.systemPrompt)
+	}
+	content, err := os.ReadFile(markdownPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	if !strings.Contains(string(content), "Rubric: "+rubricPath+"\n") {
+		t.Errorf("Expected rubric path in report header, got:\n%s", content)
+	}
+
+	jsonPath := filepath.Join(tmpDir, "skill-clarity.json")
+	opts.Format = "json"
+	if err := gradeSkillsWithOptions(skillsDir, jsonPath, opts); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	content, err = os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	var report SkillReportJSON
+	if err := json.Unmarshal(content, &report); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+	if report.Rubric != rubricPath {
+		t.Errorf("Expected JSON rubric %q, got %q", rubricPath, report.Rubric)
+	}
+
+	// Without --rubric the report names the built-in criteria
+	opts = GradeSkillsOptions{Format: "markdown", LLMClient: &rubricSkillLLMClient{}}
+	if err := gradeSkillsWithOptions(skillsDir, markdownPath, opts); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	content, err = os.ReadFile(markdownPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	if !strings.Contains(string(content), "Rubric: built-in\n") {
+		t.Errorf("Expected built-in rubric in report header, got:\n%s", content)
+	}
+
+	// A missing or empty rubric fails before grading
+	if err := gradeSkillsWithOptions(skillsDir, markdownPath, GradeSkillsOptions{Format: "markdown", Rubric: filepath.Join(tmpDir, "missing.md")}); err == nil || !strings.Contains(err.Error(), "reading rubric") {
+		t.Errorf("Expected missing rubric error, got %v", err)
+	}
+	emptyPath := filepath.Join(tmpDir, "empty.md")
+	if err := os.WriteFile(emptyPath, []byte("\n"), 0644); err != nil {
+		t.Fatalf("Failed to write rubric: %v", err)
+	}
+	if err := gradeSkillsWithOptions(skillsDir, markdownPath, GradeSkillsOptions{Format: "markdown", Rubric: emptyPath}); err == nil || !strings.Contains(err.Error(), "is empty") {
+		t.Errorf("Expected empty rubric error, got %v", err)
+	}
+}
+
+func TestGradeSkillsBaseline(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	for _, name := range []string{"steady-skill", "slipping-skill", "new-skill"} {
+		if err := os.MkdirAll(filepath.Join(skillsDir, name), 0755); err != nil {
+			t.Fatalf("Failed to create test skills dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(skillsDir, name, "SKILL.md"), []byte("# Skill\n"), 0644); err != nil {
+			t.Fatalf("Failed to write test skill: %v", err)
+		}
+	}
+
+	// stubSkillLLMClient scores 80: slipping-skill drops from 92.5, steady-skill improves from 75.0
+	baselinePath := filepath.Join(tmpDir, "baseline.md")
+	baseline := `# Skill Clarity Grading Report
+
+## Summary
+
+- **Total Skills**: 2
+
+## Detailed Breakdown
+
+### slipping-skill
+
+**Overall Score**: 92.5/100 - Skill is clear
+
+### steady-skill
+
+**Overall Score**: 75.0/100 - Skill is clear
+`
+	if err := os.WriteFile(baselinePath, []byte(baseline), 0644); err != nil {
+		t.Fatalf("Failed to write baseline: %v", err)
+	}
+
+	// Capture console output
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	markdownPath := filepath.Join(tmpDir, "skill-clarity.md")
+	opts := GradeSkillsOptions{Format: "markdown", LLMClient: stubSkillLLMClient{}, Baseline: baselinePath}
+	err := gradeSkillsWithOptions(skillsDir, markdownPath, opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+	captured, _ := io.ReadAll(r)
+	output := string(captured)
+
+	if err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	if !strings.Contains(output, "1 skills regressed vs baseline") || !strings.Contains(output, "slipping-skill: 92.5 → 80.0 (-12.5)") {
+		t.Errorf("Expected slipping-skill flagged on the console, got:\n%s", output)
+	}
+	if strings.Contains(output, "steady-skill:") || strings.Contains(output, "new-skill:") {
+		t.Errorf("Expected only slipping-skill flagged, got:\n%s", output)
+	}
+
+	content, err := os.ReadFile(markdownPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	if !strings.Contains(string(content), "## Regressions vs Baseline (1)") || !strings.Contains(string(content), "| slipping-skill | 92.5 | 80.0 | -12.5 ↓ |") {
+		t.Errorf("Expected regression section in markdown report, got:\n%s", content)
+	}
+
+	// A JSON report works as a baseline too, and carries the regressions
+	jsonPath := filepath.Join(tmpDir, "skill-clarity.json")
+	opts.Format = "json"
+	if err := gradeSkillsWithOptions(skillsDir, jsonPath, opts); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	content, err = os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	var report SkillReportJSON
+	if err := json.Unmarshal(content, &report); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+	if len(report.Regressions) != 1 || report.Regressions[0].Name != "slipping-skill" || report.Regressions[0].Delta != -12.5 {
+		t.Errorf("Expected slipping-skill regression in JSON report, got %+v", report.Regressions)
+	}
+
+	scores, err := loadSkillBaseline(jsonPath)
+	if err != nil {
+		t.Fatalf("loadSkillBaseline failed: %v", err)
+	}
+	if len(scores) != 3 || scores["new-skill"] != 80 {
+		t.Errorf("Expected scores for all three skills from JSON baseline, got %v", scores)
+	}
+
+	// A baseline without per-skill scores fails before grading
+	if err := gradeSkillsWithOptions(skillsDir, markdownPath, GradeSkillsOptions{Format: "markdown", Baseline: filepath.Join(tmpDir, "missing.md")}); err == nil || !strings.Contains(err.Error(), "loading baseline") {
+		t.Errorf("Expected missing baseline error, got %v", err)
+	}
+	emptyPath := filepath.Join(tmpDir, "empty.md")
+	if err := os.WriteFile(emptyPath, []byte("# Skill Clarity Grading Report\n"), 0644); err != nil {
+		t.Fatalf("Failed to write baseline: %v", err)
+	}
+	if err := gradeSkillsWithOptions(skillsDir, markdownPath, GradeSkillsOptions{Format: "markdown", Baseline: emptyPath}); err == nil || !strings.Contains(err.Error(), "no per-skill scores") {
+		t.Errorf("Expected empty baseline error, got %v", err)
+	}
+}
+
+func TestGradeSkillsInvalidFormat(t *testing.T) {
+	err := gradeSkillsWithFormat(t.TempDir(), "report.txt", "xml")
+	if err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+}
+
+// TestGenerateReportLabels verifies skills below the warning threshold are labeled by the given
+// thresholds, with configured labels replacing the defaults
+func TestGenerateReportLabels(t *testing.T) {
+	results := func() []skillResult {
+		return []skillResult{
+			{Name: "solid-skill", Score: 90, Passed: true, Details: map[string]any{}},
+			{Name: "shaky-skill", Score: 75, Passed: true, Details: map[string]any{}},
+			{Name: "broken-skill", Score: 60, Passed: false, Details: map[string]any{}},
+		}
+	}
+	thresholds := SkillThresholds{Passing: 65, Warn: 85}
+
+	tests := []struct {
+		name   string
+		labels SkillReportLabels
+		want   []string
+	}{
+		{
+			name: "defaults",
+			want: []string{
+				"- **shaky-skill** - 75.0/100 - Needs Improvement",
+				"- **broken-skill** - 60.0/100 - **FAILED**",
+			},
+		},
+		{
+			name:   "custom",
+			labels: SkillReportLabels{NeedsImprovement: "Revise soon", Failed: "🔴 Blocked"},
+			want: []string{
+				"- **shaky-skill** - 75.0/100 - Revise soon",
+				"- **broken-skill** - 60.0/100 - 🔴 Blocked",
+			},
+		},
+		{
+			name:   "partial",
+			labels: SkillReportLabels{Failed: "Rejected"},
+			want: []string{
+				"- **shaky-skill** - 75.0/100 - Needs Improvement",
+				"- **broken-skill** - 60.0/100 - Rejected",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportPath := filepath.Join(t.TempDir(), "skill-clarity.md")
+			if err := generateReport(results(), reportPath, SkillReportOptions{Thresholds: thresholds, Labels: tt.labels}); err != nil {
+				t.Fatalf("generateReport failed: %v", err)
+			}
+			content, err := os.ReadFile(reportPath)
+			if err != nil {
+				t.Fatalf("Failed to read report: %v", err)
+			}
+			report := string(content)
+			for _, want := range append(tt.want, "## Skills Below Threshold (< 85%)") {
+				if !strings.Contains(report, want) {
+					t.Errorf("Expected report to contain %q, got:\n%s", want, report)
+				}
+			}
+			if strings.Contains(report, "- **solid-skill**") {
+				t.Errorf("Expected solid-skill above the warning threshold to be unlabeled, got:\n%s", report)
+			}
+		})
+	}
+}
+
+func TestGradeSkillsThresholds(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	if err := os.MkdirAll(filepath.Join(skillsDir, "strict-skill"), 0755); err != nil {
+		t.Fatalf("Failed to create test skills dir: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(skillsDir, "strict-skill", "SKILL.md"), []byte("# Strict Skill\n"), 0644); err != nil {
+		t.Fatalf("Failed to write test skill: %v", err)
+	}
+
+	// stubSkillLLMClient scores 80, which fails a threshold of 85
+	opts := GradeSkillsOptions{
+		Format:     "markdown",
+		LLMClient:  stubSkillLLMClient{},
+		Thresholds: SkillThresholds{Passing: 85, Warn: 92.5},
+	}
+	markdownPath := filepath.Join(tmpDir, "skill-clarity.md")
+	if err := gradeSkillsWithOptions(skillsDir, markdownPath, opts); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	content, err := os.ReadFile(markdownPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	for _, want := range []string{
+		"- **Passing Threshold**: 85.0",
+		"## Skills Below Threshold (< 92.5%)",
+		"- **strict-skill** - 80.0/100 - **FAILED**",
+		"| 1 | strict-skill | 80.0 | ❌ Fail |",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("Expected markdown report to contain %q, got:\n%s", want, content)
+		}
+	}
+
+	jsonPath := filepath.Join(tmpDir, "skill-clarity.json")
+	opts.Format = "json"
+	if err := gradeSkillsWithOptions(skillsDir, jsonPath, opts); err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	content, err = os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	var report SkillReportJSON
+	if err := json.Unmarshal(content, &report); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+	if report.PassingThreshold != 85 || report.WarnThreshold != 92.5 {
+		t.Errorf("Expected thresholds 85/92.5, got %.1f/%.1f", report.PassingThreshold, report.WarnThreshold)
+	}
+	if len(report.Skills) != 1 || report.Skills[0].Passed {
+		t.Errorf("Expected strict-skill to fail, got %+v", report.Skills)
+	}
+}
+
+// TestResolveSkillThresholds verifies the warn threshold follows --threshold unless set explicitly
+func TestResolveSkillThresholds(t *testing.T) {
+	tests := []struct {
+		name    string
+		passing float64
+		warn    float64
+		warnSet bool
+		want    SkillThresholds
+		wantErr bool
+	}{
+		{name: "defaults", passing: 70, warn: defaultSkillWarnThreshold, want: SkillThresholds{Passing: 70, Warn: 80}},
+		{name: "threshold 90 alone", passing: 90, warn: defaultSkillWarnThreshold, want: SkillThresholds{Passing: 90, Warn: 90}},
+		{name: "explicit warn threshold", passing: 90, warn: 95, warnSet: true, want: SkillThresholds{Passing: 90, Warn: 95}},
+		{name: "explicit warn threshold below threshold", passing: 90, warn: 85, warnSet: true, wantErr: true},
+		{name: "threshold out of range", passing: 101, warn: defaultSkillWarnThreshold, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveSkillThresholds(tt.passing, tt.warn, tt.warnSet)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveSkillThresholds failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGradeSkillsSummaryOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	for _, skill := range []string{"alpha", "beta", "gamma"} {
+		if err := os.MkdirAll(filepath.Join(skillsDir, skill), 0755); err != nil {
+			t.Fatalf("Failed to create test skills dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(skillsDir, skill, "SKILL.md"), []byte("# "+skill+"\n"), 0644); err != nil {
+			t.Fatalf("Failed to write test skill: %v", err)
+		}
+	}
+	reportPath := filepath.Join(tmpDir, "skill-clarity.json")
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	err := gradeSkillsWithOptions(skillsDir, reportPath, GradeSkillsOptions{
+		Format:      "json",
+		LLMClient:   stubSkillLLMClient{},
+		SummaryOnly: true,
+	})
+	w.Close()
+	os.Stdout = oldStdout
+	output, _ := io.ReadAll(r)
+
+	if err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+	if _, err := os.Stat(reportPath); !os.IsNotExist(err) {
+		t.Error("Expected no report file in summary-only mode")
+	}
+
+	var summary SkillSummaryJSON
+	if err := json.Unmarshal(output, &summary); err != nil {
+		t.Fatalf("Summary is not valid JSON: %v\n%s", err, output)
+	}
+	want := SkillSummaryJSON{SchemaVersion: reportSchemaVersion, TotalSkills: 3, AverageScore: 80, PassRate: 100, PassingThreshold: 70, BelowThresholdCount: 0}
+	if summary != want {
+		t.Errorf("Expected summary %+v, got %+v", want, summary)
+	}
+	for _, field := range []string{"schema_version", "total_skills", "average_score", "pass_rate", "passing_threshold", "below_threshold_count"} {
+		if !strings.Contains(string(output), `"`+field+`"`) {
+			t.Errorf("Expected summary field %q, got:\n%s", field, output)
+		}
+	}
+	if strings.Contains(string(output), "alpha") {
+		t.Errorf("Expected no per-skill detail in summary, got:\n%s", output)
+	}
+}
+
+// TestGradeSkillsJSONLines verifies jsonl streams one skill object per line to stdout and writes no report
+func TestGradeSkillsJSONLines(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	for _, skill := range []string{"alpha", "beta"} {
+		if err := os.MkdirAll(filepath.Join(skillsDir, skill), 0755); err != nil {
+			t.Fatalf("Failed to create test skills dir: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(skillsDir, skill, "SKILL.md"), []byte("# "+skill+"\n"), 0644); err != nil {
+			t.Fatalf("Failed to write test skill: %v", err)
+		}
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+	err := gradeSkillsWithOptions(skillsDir, "", GradeSkillsOptions{
+		Format:    "jsonl",
+		LLMClient: stubSkillLLMClient{},
+	})
+	w.Close()
+	os.Stdout = oldStdout
+	output, _ := io.ReadAll(r)
+
+	if err != nil {
+		t.Fatalf("gradeSkillsWithOptions failed: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(string(output), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected one line per skill and no progress output, got:\n%s", output)
+	}
+	for i, name := range []string{"alpha", "beta"} {
+		var entry SkillReportEntry
+		if err := json.Unmarshal([]byte(lines[i]), &entry); err != nil {
+			t.Fatalf("Line %d is not valid JSON: %v\n%s", i+1, err, lines[i])
+		}
+		if entry.Name != name || entry.Path != filepath.Join(skillsDir, name, "SKILL.md") || entry.Score != 80 || !entry.Passed {
+			t.Errorf("Unexpected entry on line %d: %+v", i+1, entry)
+		}
+		if len(entry.Criteria) != len(skillCriteria) {
+			t.Errorf("Expected %d criteria for %s, got %+v", len(skillCriteria), name, entry.Criteria)
+		}
+	}
+
+	err = gradeSkillsWithOptions(skillsDir, "", GradeSkillsOptions{Format: "jsonl", SummaryOnly: true})
+	if err == nil || !strings.Contains(err.Error(), "--summary-only") {
+		t.Errorf("Expected jsonl with --summary-only to be rejected, got %v", err)
+	}
+}
+
+func TestCalculateSkillSummary(t *testing.T) {
+	var results []skillResult
+	for i, score := range []float64{90, 40, 70, 60} {
+		results = append(results, skillResult{Name: fmt.Sprintf("skill-%d", i), Score: score, Passed: score >= 70})
+	}
+
+	summary := calculateSkillSummary(results, defaultSkillPassingThreshold)
+	want := SkillSummaryJSON{TotalSkills: 4, AverageScore: 65, PassRate: 50, PassingThreshold: 70, BelowThresholdCount: 2}
+	if summary != want {
+		t.Errorf("Expected summary %+v, got %+v", want, summary)
+	}
+}
+
+func TestGenerateReportScoreDistribution(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	scores := []float64{90, 40, 70, 60, 100}
+	newResults := func() []skillResult {
+		results := make([]skillResult, 0, len(scores))
+		for i, score := range scores {
+			results = append(results, skillResult{
+				Name:   fmt.Sprintf("skill-%d", i),
+				Score:  score,
+				Passed: score >= 70,
+			})
+		}
+		return results
+	}
+
+	// Sorted: 40, 60, 70, 90, 100 -> p25 at rank 1 = 60, median at rank 2 = 70, p75 at rank 3 = 90
+	stats := calculateSkillScoreStats(newResults())
+	want := SkillScoreStats{Min: 40, P25: 60, Median: 70, P75: 90, Max: 100}
+	if stats != want {
+		t.Errorf("Expected stats %+v, got %+v", want, stats)
+	}
+
+	markdownPath := filepath.Join(tmpDir, "report.md")
+	if err := generateReport(newResults(), markdownPath, SkillReportOptions{}); err != nil {
+		t.Fatalf("generateReport failed: %v", err)
+	}
+	content, err := os.ReadFile(markdownPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	expectedLine := "- **Score Distribution**: min 40.0, p25 60.0, median 70.0, p75 90.0, max 100.0"
+	if !strings.Contains(string(content), expectedLine) {
+		t.Errorf("Expected summary to contain %q", expectedLine)
+	}
+
+	jsonPath := filepath.Join(tmpDir, "report.json")
+	if err := generateReportJSON(newResults(), jsonPath, SkillReportOptions{}); err != nil {
+		t.Fatalf("generateReportJSON failed: %v", err)
+	}
+	content, err = os.ReadFile(jsonPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	var report SkillReportJSON
+	if err := json.Unmarshal(content, &report); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+	if report.ScoreStats != want {
+		t.Errorf("Expected JSON stats %+v, got %+v", want, report.ScoreStats)
+	}
+}
+
+func TestTallyFailingCriteria(t *testing.T) {
+	// criteriaDetails builds skill details from scores in skillCriteria order
+	criteriaDetails := func(scores ...float64) map[string]any {
+		details := make(map[string]any)
+		for i, name := range skillCriteria {
+			details[name] = map[string]any{"score": scores[i], "feedback": "feedback", "weight": 0.25}
+		}
+		return details
+	}
+
+	results := []skillResult{
+		{Name: "weak-examples-1", Score: 50, Details: criteriaDetails(70, 60, 20, 50)},
+		{Name: "weak-examples-2", Score: 55, Details: criteriaDetails(80, 70, 30, 40)},
+		{Name: "weak-scope", Score: 60, Details: criteriaDetails(90, 80, 70, 10)},
+		// Tie between actionable_steps and good_examples goes to actionable_steps
+		{Name: "tied", Score: 65, Details: criteriaDetails(90, 40, 40, 60)},
+		// Passing skills are not tallied, even with a weak criterion
+		{Name: "passing", Score: 85, Passed: true, Details: criteriaDetails(100, 100, 0, 100)},
+		// Skills without criteria details are ignored
+		{Name: "no-details", Score: 10},
+	}
+
+	tallies := tallyFailingCriteria(results)
+	want := []CriterionTally{
+		{Name: "good_examples", Count: 2},
+		{Name: "actionable_steps", Count: 1},
+		{Name: "appropriate_scope", Count: 1},
+	}
+	if fmt.Sprint(tallies) != fmt.Sprint(want) {
+		t.Errorf("Expected tallies %v, got %v", want, tallies)
+	}
+
+	reportPath := filepath.Join(t.TempDir(), "report.md")
+	if err := generateReport(results, reportPath, SkillReportOptions{}); err != nil {
+		t.Fatalf("generateReport failed: %v", err)
+	}
+	content, err := os.ReadFile(reportPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	for _, expected := range []string{
+		"## Most Common Failing Criteria",
+		"of the 4 skills below the passing threshold",
+		"| Good Examples | 2 | 50.0% |",
+		"| Actionable Steps | 1 | 25.0% |",
+	} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected report to contain %q", expected)
+		}
+	}
+}
+
+func TestDefaultReportsAndSkillsDirs(t *testing.T) {
+	tests := []struct {
+		name       string
+		cwd        string
+		wantReport string
+		wantSkills string
+	}{
+		{
+			name:       "project root",
+			cwd:        "/home/dev/pokayokay",
+			wantReport: "/home/dev/pokayokay/reports",
+			wantSkills: "/home/dev/pokayokay/plugins/pokayokay/skills",
+		},
+		{
+			name:       "project subdirectory",
+			cwd:        "/home/dev/pokayokay/yokay-evals/cmd",
+			wantReport: "/home/dev/pokayokay/reports",
+			wantSkills: "/home/dev/pokayokay/plugins/pokayokay/skills",
+		},
+		{
+			name:       "outside the project",
+			cwd:        "/home/dev/other",
+			wantReport: "reports",
+			wantSkills: "skills",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := defaultReportsDir(filepath.FromSlash(tt.cwd)); got != filepath.FromSlash(tt.wantReport) {
+				t.Errorf("defaultReportsDir(%q) = %q, want %q", tt.cwd, got, tt.wantReport)
+			}
+			if got := defaultSkillsDir(filepath.FromSlash(tt.cwd)); got != filepath.FromSlash(tt.wantSkills) {
+				t.Errorf("defaultSkillsDir(%q) = %q, want %q", tt.cwd, got, tt.wantSkills)
+			}
+		})
+	}
+}
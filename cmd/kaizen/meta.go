@@ -3,13 +3,18 @@ package main
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -61,7 +66,9 @@ func validateAgentName(agentName string) error {
 
 // EvalConfig represents the structure of an eval.yaml file
 type EvalConfig struct {
-	Agent                string     `yaml:"agent"`
+	Agent string `yaml:"agent"`
+	// BoundaryType is the level the agent is evaluated at, e.g. "task" or "epic" (default: metaBoundaryType)
+	BoundaryType         string     `yaml:"boundary_type"`
 	ConsistencyThreshold float64    `yaml:"consistency_threshold"`
 	TestCases            []TestCase `yaml:"test_cases"`
 }
@@ -90,12 +97,74 @@ type TestResult struct {
 	Name     string
 	Expected string
 	Runs     []string // Each run's verdict
+	Attempts []int    // Agent executions per run; more than 1 means the run was retried
+	// Durations is the wall-clock time of each run's agent executions, retries included
+	// but not the backoff between them (nil when timing wasn't recorded)
+	Durations []time.Duration
+	// TiePolicy decides the majority verdict when runs are tied (empty means tiePolicyAlphabetical)
+	TiePolicy string
+}
+
+// MajorityVerdict returns the most common verdict across the runs, breaking ties by TiePolicy
+func (tr TestResult) MajorityVerdict() string {
+	return getMajorityVerdictWithPolicy(tr.Runs, tr.Expected, tr.TiePolicy)
+}
+
+// Retries returns the total number of retries across the test case's runs
+func (tr TestResult) Retries() int {
+	retries := 0
+	for _, attempts := range tr.Attempts {
+		if attempts > 1 {
+			retries += attempts - 1
+		}
+	}
+	return retries
+}
+
+// Latency returns the latency stats of the test case's timed runs
+func (tr TestResult) Latency() LatencyStats {
+	return calculateLatency(tr.Durations)
 }
 
 // EvaluationResult represents the complete evaluation result for an agent
 type EvaluationResult struct {
-	Agent       string
-	TestResults []TestResult
+	Agent        string
+	BoundaryType string
+	TestResults  []TestResult
+}
+
+// Latency returns the latency stats of every timed run across the agent's test cases
+func (er EvaluationResult) Latency() LatencyStats {
+	var durations []time.Duration
+	for _, tr := range er.TestResults {
+		durations = append(durations, tr.Durations...)
+	}
+	return calculateLatency(durations)
+}
+
+// LatencyStats summarizes the wall-clock time of agent runs
+type LatencyStats struct {
+	Runs int
+	Mean time.Duration
+	Max  time.Duration
+}
+
+// calculateLatency returns the mean and max of durations; Runs is 0 when there are none
+func calculateLatency(durations []time.Duration) LatencyStats {
+	stats := LatencyStats{Runs: len(durations)}
+	if stats.Runs == 0 {
+		return stats
+	}
+
+	var total time.Duration
+	for _, d := range durations {
+		total += d
+		if d > stats.Max {
+			stats.Max = d
+		}
+	}
+	stats.Mean = total / time.Duration(stats.Runs)
+	return stats
 }
 
 // Metrics represents calculated metrics for the evaluation
@@ -224,6 +293,74 @@ func findEvalFiles(dir string) ([]string, error) {
 	return evalFiles, nil
 }
 
+// findEvalFilesByGlob returns the files matching a slash-separated glob pattern, sorted.
+// Besides filepath.Match syntax, a "**" path segment matches any number of directories,
+// e.g. "services/**/evals/*.yaml".
+func findEvalFilesByGlob(pattern string) ([]string, error) {
+	pattern = filepath.ToSlash(pattern)
+	if _, err := filepath.Match(strings.ReplaceAll(pattern, "**", "*"), ""); err != nil {
+		return nil, fmt.Errorf("invalid eval glob %q: %w", pattern, err)
+	}
+
+	// Walk from the longest directory prefix without wildcards
+	segments := strings.Split(pattern, "/")
+	root := "."
+	for i, segment := range segments {
+		if strings.ContainsAny(segment, "*?[") {
+			if i > 0 {
+				root = strings.Join(segments[:i], "/")
+				if root == "" {
+					root = "/"
+				}
+			}
+			break
+		}
+	}
+
+	var evalFiles []string
+	err := filepath.Walk(filepath.FromSlash(root), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && path == filepath.FromSlash(root) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if !info.IsDir() && matchGlobSegments(segments, strings.Split(filepath.ToSlash(path), "/")) {
+			evalFiles = append(evalFiles, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(evalFiles)
+	return evalFiles, nil
+}
+
+// matchGlobSegments reports whether path segments match pattern segments, where a "**"
+// pattern segment matches zero or more path segments
+func matchGlobSegments(pattern, path []string) bool {
+	if len(pattern) == 0 {
+		return len(path) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(path); i++ {
+			if matchGlobSegments(pattern[1:], path[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(path) == 0 {
+		return false
+	}
+	if matched, _ := filepath.Match(pattern[0], path[0]); !matched {
+		return false
+	}
+	return matchGlobSegments(pattern[1:], path[1:])
+}
+
 // findAgentEvalFiles finds all eval.yaml files in the agents directory
 // DEPRECATED: Use findEvalFiles instead
 func findAgentEvalFiles(agentsDir string) ([]string, error) {
@@ -239,17 +376,48 @@ func findSkillEvalFiles(skillsDir string) ([]string, error) {
 // runMetaEvaluation runs meta-evaluation on a single eval.yaml file
 // kOverride: if > 0, overrides the k value from YAML test cases
 func runMetaEvaluation(evalPath string, kOverride int) (EvaluationResult, error) {
+	return runMetaEvaluationWithContext(context.Background(), evalPath, kOverride, 1, defaultMetaMaxRetries, os.Stdout)
+}
+
+// metaRun identifies a single agent execution within an eval file
+type metaRun struct {
+	testIdx int
+	runIdx  int
+}
+
+// runMetaEvaluationWithContext runs an eval file with up to parallel agent executions at once.
+// Verdicts are stored by run index, so TestResult.Runs is deterministic regardless of completion order.
+// Runs ending in ERROR are retried up to maxRetries times before ERROR is recorded.
+// Cancelling ctx stops dispatching new runs and kills in-flight agent processes.
+// Progress lines are written to progress; pass io.Discard to silence them.
+func runMetaEvaluationWithContext(ctx context.Context, evalPath string, kOverride int, parallel int, maxRetries int, progress io.Writer) (EvaluationResult, error) {
+	return runMetaEvaluationWithRunner(ctx, evalPath, kOverride, parallel, maxRetries, runAgent, progress)
+}
+
+// runMetaEvaluationWithRunner is runMetaEvaluationWithContext with each agent run executed by execute
+func runMetaEvaluationWithRunner(ctx context.Context, evalPath string, kOverride int, parallel int, maxRetries int, execute agentRunFunc, progress io.Writer) (EvaluationResult, error) {
 	config, err := loadEvalYAML(evalPath)
 	if err != nil {
 		return EvaluationResult{}, err
 	}
 
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	boundaryType := config.BoundaryType
+	if boundaryType == "" {
+		boundaryType = metaBoundaryType(evalPath)
+	}
+
 	result := EvaluationResult{
-		Agent:       config.Agent,
-		TestResults: make([]TestResult, 0, len(config.TestCases)),
+		Agent:        config.Agent,
+		BoundaryType: boundaryType,
+		TestResults:  make([]TestResult, len(config.TestCases)),
 	}
 
-	// For each test case, run k times
+	// Queue k runs for each test case
+	var runs []metaRun
 	for tcIdx, tc := range config.TestCases {
 		// Determine k: CLI override takes precedence over YAML, with 5 as default
 		k := kOverride
@@ -260,29 +428,90 @@ func runMetaEvaluation(evalPath string, kOverride int) (EvaluationResult, error)
 			}
 		}
 
-		testResult := TestResult{
-			TestID:   tc.ID,
-			Name:     tc.Name,
-			Expected: tc.Expected,
-			Runs:     make([]string, k),
+		result.TestResults[tcIdx] = TestResult{
+			TestID:    tc.ID,
+			Name:      tc.Name,
+			Expected:  tc.Expected,
+			Runs:      make([]string, k),
+			Attempts:  make([]int, k),
+			Durations: make([]time.Duration, k),
 		}
 
-		fmt.Printf("  [%d/%d] Running test %s (k=%d)...\n", tcIdx+1, len(config.TestCases), tc.ID, k)
-
-		// Run the test k times
 		for i := 0; i < k; i++ {
-			// Execute the agent and get the verdict
-			verdict, err := executeAgent(config.Agent, tc.Input)
-			if err != nil {
-				// Log error but continue - mark as ERROR verdict
-				fmt.Fprintf(os.Stderr, "Warning: Agent execution failed for %s (run %d/%d): %v\n", tc.ID, i+1, k, err)
-				verdict = "ERROR"
+			runs = append(runs, metaRun{testIdx: tcIdx, runIdx: i})
+		}
+	}
+
+	// Worker pool executing agent runs; mu guards result writes and progress output
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan metaRun)
+	for w := 0; w < parallel; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for run := range queue {
+				// Drain runs that were queued before cancellation without executing them
+				if ctx.Err() != nil {
+					continue
+				}
+
+				tc := config.TestCases[run.testIdx]
+				testResult := &result.TestResults[run.testIdx]
+				k := len(testResult.Runs)
+
+				if run.runIdx == 0 {
+					mu.Lock()
+					fmt.Fprintf(progress, "  [%d/%d] Running test %s (k=%d)...\n", run.testIdx+1, len(config.TestCases), tc.ID, k)
+					mu.Unlock()
+				}
+
+				// Execute the agent, retrying ERROR verdicts, and get the verdict. Only the
+				// executions are timed so retry backoff doesn't count as agent latency.
+				var elapsed time.Duration
+				timed := func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+					start := time.Now()
+					defer func() { elapsed += time.Since(start) }()
+					return execute(ctx, agentName, input)
+				}
+				verdict, retries, err := runAgentWithRetry(ctx, timed, config.Agent, tc.Input, maxRetries)
+
+				mu.Lock()
+				if err != nil {
+					// Log error but continue - mark as ERROR verdict
+					if ctx.Err() == nil {
+						fmt.Fprintf(os.Stderr, "Warning: Agent execution failed for %s (run %d/%d) after %d retries: %v\n", tc.ID, run.runIdx+1, k, retries, err)
+					}
+					verdict = "ERROR"
+				} else if retries > 0 {
+					fmt.Fprintf(os.Stderr, "Warning: Agent run for %s (run %d/%d) needed %d retries\n", tc.ID, run.runIdx+1, k, retries)
+				}
+				testResult.Runs[run.runIdx] = verdict
+				testResult.Attempts[run.runIdx] = retries + 1
+				testResult.Durations[run.runIdx] = elapsed
+				if parallel > 1 {
+					fmt.Fprintf(progress, "    %s run %d/%d: %s\n", tc.ID, run.runIdx+1, k, verdict)
+				} else {
+					fmt.Fprintf(progress, "    Run %d/%d: %s\n", run.runIdx+1, k, verdict)
+				}
+				mu.Unlock()
 			}
-			testResult.Runs[i] = verdict
-			fmt.Printf("    Run %d/%d: %s\n", i+1, k, verdict)
+		}()
+	}
+
+dispatch:
+	for _, run := range runs {
+		select {
+		case queue <- run:
+		case <-ctx.Done():
+			break dispatch
 		}
+	}
+	close(queue)
+	wg.Wait()
 
-		result.TestResults = append(result.TestResults, testResult)
+	if ctx.Err() != nil {
+		return result, fmt.Errorf("meta-evaluation cancelled: %w", context.Cause(ctx))
 	}
 
 	return result, nil
@@ -353,8 +582,77 @@ func extractVerdict(output string) string {
 	return "ERROR"
 }
 
+// agentRunFunc executes a single agent run and returns its verdict
+type agentRunFunc func(ctx context.Context, agentName string, input TaskInput) (string, error)
+
+// runAgent executes a single agent run; tests replace it to avoid invoking the claude CLI
+var runAgent agentRunFunc = executeAgentContext
+
+// defaultAgentRunner is the command that runs an agent when no runner is configured
+const defaultAgentRunner = "claude --agent {agent} --print"
+
+// parseAgentRunner splits a runner template such as "mycli run {agent}" into a command and
+// its arguments. The template is not passed through a shell, and {agent} must appear in it.
+func parseAgentRunner(template string) ([]string, error) {
+	runner := strings.Fields(template)
+	if len(runner) == 0 {
+		return nil, fmt.Errorf("agent runner must not be empty")
+	}
+	if !strings.Contains(template, "{agent}") {
+		return nil, fmt.Errorf("agent runner %q must contain {agent}", template)
+	}
+	return runner, nil
+}
+
+// defaultMetaMaxRetries is how many times an ERROR agent run is retried by default
+const defaultMetaMaxRetries = 2
+
+// metaRetryBaseDelay is the backoff before the first retry; it doubles for each further retry
+var metaRetryBaseDelay = 2 * time.Second
+
+// runAgentWithRetry runs an agent, retrying with exponential backoff while it fails,
+// times out or returns an ERROR verdict. It returns the final verdict, the number of
+// retries used, and an error once retries are exhausted. Cancellation is not retried.
+func runAgentWithRetry(ctx context.Context, execute agentRunFunc, agentName string, input TaskInput, maxRetries int) (string, int, error) {
+	delay := metaRetryBaseDelay
+	for retries := 0; ; retries++ {
+		verdict, err := execute(ctx, agentName, input)
+		if err == nil && verdict != "ERROR" {
+			return verdict, retries, nil
+		}
+		if err == nil {
+			err = fmt.Errorf("no verdict found in agent output")
+		}
+		if retries >= maxRetries || ctx.Err() != nil {
+			return "ERROR", retries, err
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return "ERROR", retries, err
+		}
+		delay *= 2
+	}
+}
+
 // executeAgent executes an agent via Claude CLI and returns the verdict
 func executeAgent(agentName string, input TaskInput) (string, error) {
+	return executeAgentContext(context.Background(), agentName, input)
+}
+
+// executeAgentContext executes an agent via Claude CLI, stopping it if ctx is cancelled
+func executeAgentContext(parent context.Context, agentName string, input TaskInput) (string, error) {
+	runner, err := parseAgentRunner(defaultAgentRunner)
+	if err != nil {
+		return "ERROR", err
+	}
+	return executeAgentWithRunner(parent, runner, agentName, input)
+}
+
+// executeAgentWithRunner executes an agent with the given runner command, substituting {agent}
+// in its arguments and piping the prompt via stdin, stopping it if ctx is cancelled
+func executeAgentWithRunner(parent context.Context, runner []string, agentName string, input TaskInput) (string, error) {
 	// Security: Validate agent name against whitelist before execution
 	// CWE-78: OS Command Injection mitigation
 	if err := validateAgentName(agentName); err != nil {
@@ -365,11 +663,15 @@ func executeAgent(agentName string, input TaskInput) (string, error) {
 	prompt := formatAgentPrompt(agentName, input)
 
 	// Create context with 5-minute timeout
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	ctx, cancel := context.WithTimeout(parent, 5*time.Minute)
 	defer cancel()
 
-	// Build command: claude --agent <name> --print
-	cmd := exec.CommandContext(ctx, "claude", "--agent", agentName, "--print")
+	// Build command, e.g. claude --agent <name> --print
+	args := make([]string, len(runner))
+	for i, arg := range runner {
+		args[i] = strings.ReplaceAll(arg, "{agent}", agentName)
+	}
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
 
 	// Pipe the prompt as stdin
 	cmd.Stdin = bytes.NewBufferString(prompt)
@@ -381,6 +683,9 @@ func executeAgent(agentName string, input TaskInput) (string, error) {
 		if ctx.Err() == context.DeadlineExceeded {
 			return "ERROR", fmt.Errorf("agent execution timed out after 5 minutes")
 		}
+		if parent.Err() != nil {
+			return "ERROR", fmt.Errorf("agent execution cancelled")
+		}
 		// Security: Sanitize error messages to prevent information leakage
 		// CWE-209: Information Exposure Through Error Messages mitigation
 		// Only include exit code, not full output which may contain sensitive info
@@ -401,7 +706,7 @@ func calculateMetrics(results []TestResult) Metrics {
 
 	for _, tr := range results {
 		// Check if correct (majority vote matches expected)
-		verdict := getMajorityVerdict(tr.Runs)
+		verdict := tr.MajorityVerdict()
 		if verdict == tr.Expected {
 			metrics.CorrectCount++
 		}
@@ -421,9 +726,38 @@ func calculateMetrics(results []TestResult) Metrics {
 	return metrics
 }
 
+// Tie policies for the majority verdict when several verdicts share the highest count
+const (
+	// tiePolicyAlphabetical picks the alphabetically first tied verdict
+	tiePolicyAlphabetical = "alphabetical"
+	// tiePolicyPreferExpected picks the expected verdict when it is among the tied, else alphabetical
+	tiePolicyPreferExpected = "prefer-expected"
+	// tiePolicyInconclusive reports inconclusiveVerdict, which never matches the expected verdict
+	tiePolicyInconclusive = "mark-inconclusive"
+)
+
+// inconclusiveVerdict is the majority verdict of a tied test case under tiePolicyInconclusive
+const inconclusiveVerdict = "INCONCLUSIVE"
+
+// validateTiePolicy returns an error for an unknown tie policy; empty means tiePolicyAlphabetical
+func validateTiePolicy(policy string) error {
+	switch policy {
+	case "", tiePolicyAlphabetical, tiePolicyPreferExpected, tiePolicyInconclusive:
+		return nil
+	}
+	return fmt.Errorf("invalid tie policy: %s (must be '%s', '%s' or '%s')",
+		policy, tiePolicyAlphabetical, tiePolicyPreferExpected, tiePolicyInconclusive)
+}
+
 // getMajorityVerdict returns the most common verdict from runs
 // In case of a tie, returns the alphabetically first verdict for determinism
 func getMajorityVerdict(runs []string) string {
+	return getMajorityVerdictWithPolicy(runs, "", tiePolicyAlphabetical)
+}
+
+// getMajorityVerdictWithPolicy returns the most common verdict from runs, breaking ties by policy.
+// expected is the test's expected verdict, used by tiePolicyPreferExpected.
+func getMajorityVerdictWithPolicy(runs []string, expected string, policy string) string {
 	if len(runs) == 0 {
 		return ""
 	}
@@ -447,11 +781,23 @@ func getMajorityVerdict(runs []string) string {
 		}
 	}
 
-	// If multiple verdicts are tied, sort and return first (deterministic)
-	if len(tiedVerdicts) > 1 {
-		sort.Strings(tiedVerdicts)
+	if len(tiedVerdicts) == 1 {
+		return tiedVerdicts[0]
 	}
 
+	switch policy {
+	case tiePolicyInconclusive:
+		return inconclusiveVerdict
+	case tiePolicyPreferExpected:
+		for _, verdict := range tiedVerdicts {
+			if verdict == expected {
+				return verdict
+			}
+		}
+	}
+
+	// If multiple verdicts are tied, sort and return first (deterministic)
+	sort.Strings(tiedVerdicts)
 	return tiedVerdicts[0]
 }
 
@@ -471,14 +817,59 @@ func areAllRunsConsistent(runs []string) bool {
 	return true
 }
 
-// formatMetaReport formats the evaluation result into a readable report
-func formatMetaReport(result EvaluationResult) string {
+// calculateRunLevelAgreement returns how many individual runs across all tests and
+// agents agreed with their test's majority verdict, out of the total number of runs
+func calculateRunLevelAgreement(results []EvaluationResult) (agreeing, total int) {
+	for _, result := range results {
+		for _, tr := range result.TestResults {
+			verdict := tr.MajorityVerdict()
+			for _, v := range tr.Runs {
+				if v == verdict {
+					agreeing++
+				}
+			}
+			total += len(tr.Runs)
+		}
+	}
+	return agreeing, total
+}
+
+// formatSuiteSummary formats suite-wide metrics across all evaluated agents
+func formatSuiteSummary(results []EvaluationResult) string {
+	var sb strings.Builder
+
+	totalTests := 0
+	for _, result := range results {
+		totalTests += len(result.TestResults)
+	}
+
+	agreeing, totalRuns := calculateRunLevelAgreement(results)
+	agreement := 0.0
+	if totalRuns > 0 {
+		agreement = float64(agreeing) / float64(totalRuns)
+	}
+
+	sb.WriteString("Suite Summary\n")
+	sb.WriteString("=============\n\n")
+	sb.WriteString(fmt.Sprintf("Evaluations: %d\n", len(results)))
+	sb.WriteString(fmt.Sprintf("Test Cases: %d\n", totalTests))
+	sb.WriteString(fmt.Sprintf("Run-level agreement: %.1f%% (%d/%d runs agree with their test's majority)\n",
+		agreement*100, agreeing, totalRuns))
+
+	return sb.String()
+}
+
+// formatMetaReportText formats the evaluation result into a readable report
+func formatMetaReportText(result EvaluationResult) string {
 	var sb strings.Builder
 
 	sb.WriteString("Meta-Evaluation Report\n")
 	sb.WriteString("======================\n\n")
 
 	sb.WriteString(fmt.Sprintf("Agent: %s\n", result.Agent))
+	if result.BoundaryType != "" {
+		sb.WriteString(fmt.Sprintf("Boundary Type: %s\n", result.BoundaryType))
+	}
 	sb.WriteString(fmt.Sprintf("Test Cases: %d\n\n", len(result.TestResults)))
 
 	// Calculate metrics once
@@ -487,7 +878,7 @@ func formatMetaReport(result EvaluationResult) string {
 	sb.WriteString("Results:\n")
 	for _, tr := range result.TestResults {
 		// Get majority verdict (calculate once)
-		verdict := getMajorityVerdict(tr.Runs)
+		verdict := tr.MajorityVerdict()
 
 		// Calculate consistent count
 		consistentCount := 0
@@ -507,8 +898,15 @@ func formatMetaReport(result EvaluationResult) string {
 			status = fmt.Sprintf("FAIL (expected %s, got %s)", tr.Expected, verdict)
 		}
 
-		sb.WriteString(fmt.Sprintf("  %s: %s (%d/%d consistent)\n",
-			tr.TestID, status, consistentCount, len(tr.Runs)))
+		retries := ""
+		if n := tr.Retries(); n == 1 {
+			retries = " [required 1 retry]"
+		} else if n > 1 {
+			retries = fmt.Sprintf(" [required %d retries]", n)
+		}
+
+		sb.WriteString(fmt.Sprintf("  %s: %s (%d/%d consistent)%s\n",
+			tr.TestID, status, consistentCount, len(tr.Runs), retries))
 	}
 
 	sb.WriteString("\nMetrics:\n")
@@ -517,11 +915,171 @@ func formatMetaReport(result EvaluationResult) string {
 	sb.WriteString(fmt.Sprintf("  Consistency (pass^k): %.1f%% (%d/%d all runs agree)\n",
 		metrics.Consistency*100, metrics.ConsistentCount, metrics.TotalTests))
 
+	if latency := result.Latency(); latency.Runs > 0 {
+		sb.WriteString("\nLatency:\n")
+		for _, tr := range result.TestResults {
+			if tl := tr.Latency(); tl.Runs > 0 {
+				sb.WriteString(fmt.Sprintf("  %s: %s\n", tr.TestID, formatLatency(tl)))
+			}
+		}
+		sb.WriteString(fmt.Sprintf("  Overall: %s\n", formatLatency(latency)))
+	}
+
 	return sb.String()
 }
 
-// confirmMetaExecution estimates API calls and prompts for confirmation if needed
-func confirmMetaExecution(evalFiles []string, k int, confirm bool) error {
+// formatLatency formats latency stats as e.g. "mean 1.2s, max 3.4s (5 runs)"
+func formatLatency(stats LatencyStats) string {
+	return fmt.Sprintf("mean %s, max %s (%d runs)",
+		stats.Mean.Round(time.Millisecond), stats.Max.Round(time.Millisecond), stats.Runs)
+}
+
+// MetaReportJSON is the stable JSON schema for a single meta-evaluation result
+type MetaReportJSON struct {
+	// SchemaVersion is the report schema version, repeated on each report of a suite array
+	SchemaVersion string          `json:"schema_version"`
+	Agent         string          `json:"agent"`
+	BoundaryType  string          `json:"boundary_type,omitempty"`
+	Metrics       MetaMetricsJSON `json:"metrics"`
+	// Latency covers every timed run of the agent (omitted when timing wasn't recorded)
+	Latency     *MetaLatencyJSON     `json:"latency,omitempty"`
+	TestResults []MetaTestResultJSON `json:"test_results"`
+}
+
+// MetaLatencyJSON holds the wall-clock latency stats of agent runs in milliseconds
+type MetaLatencyJSON struct {
+	Runs   int     `json:"runs"`
+	MeanMs float64 `json:"mean_ms"`
+	MaxMs  float64 `json:"max_ms"`
+}
+
+// newMetaLatencyJSON converts latency stats, returning nil when no runs were timed
+func newMetaLatencyJSON(stats LatencyStats) *MetaLatencyJSON {
+	if stats.Runs == 0 {
+		return nil
+	}
+	return &MetaLatencyJSON{
+		Runs:   stats.Runs,
+		MeanMs: float64(stats.Mean) / float64(time.Millisecond),
+		MaxMs:  float64(stats.Max) / float64(time.Millisecond),
+	}
+}
+
+// MetaMetricsJSON holds the accuracy and consistency metrics of a meta-evaluation
+type MetaMetricsJSON struct {
+	Accuracy        float64 `json:"accuracy"`
+	Consistency     float64 `json:"consistency"`
+	TotalTests      int     `json:"total_tests"`
+	CorrectCount    int     `json:"correct_count"`
+	ConsistentCount int     `json:"consistent_count"`
+}
+
+// MetaTestResultJSON holds the raw runs and outcome of a single test case
+type MetaTestResultJSON struct {
+	TestID          string   `json:"test_id"`
+	Name            string   `json:"name"`
+	Expected        string   `json:"expected"`
+	MajorityVerdict string   `json:"majority_verdict"`
+	Passed          bool     `json:"passed"`
+	Consistent      bool     `json:"consistent"`
+	Runs            []string `json:"runs"`
+	Attempts        []int    `json:"attempts"`
+	Retries         int      `json:"retries"`
+	// DurationsMs is each run's agent execution time in milliseconds
+	DurationsMs []float64        `json:"durations_ms,omitempty"`
+	Latency     *MetaLatencyJSON `json:"latency,omitempty"`
+}
+
+// newMetaReportJSON converts an evaluation result into its JSON representation
+func newMetaReportJSON(result EvaluationResult) MetaReportJSON {
+	metrics := calculateMetrics(result.TestResults)
+
+	report := MetaReportJSON{
+		SchemaVersion: reportSchemaVersion,
+		Agent:         result.Agent,
+		BoundaryType:  result.BoundaryType,
+		Metrics: MetaMetricsJSON{
+			Accuracy:        metrics.Accuracy,
+			Consistency:     metrics.Consistency,
+			TotalTests:      metrics.TotalTests,
+			CorrectCount:    metrics.CorrectCount,
+			ConsistentCount: metrics.ConsistentCount,
+		},
+		Latency:     newMetaLatencyJSON(result.Latency()),
+		TestResults: make([]MetaTestResultJSON, 0, len(result.TestResults)),
+	}
+
+	for _, tr := range result.TestResults {
+		verdict := tr.MajorityVerdict()
+		runs := tr.Runs
+		if runs == nil {
+			runs = []string{}
+		}
+		// Results built without attempt tracking count as one attempt per run
+		attempts := make([]int, len(runs))
+		for i := range attempts {
+			attempts[i] = 1
+			if i < len(tr.Attempts) && tr.Attempts[i] > 0 {
+				attempts[i] = tr.Attempts[i]
+			}
+		}
+		report.TestResults = append(report.TestResults, MetaTestResultJSON{
+			TestID:          tr.TestID,
+			Name:            tr.Name,
+			Expected:        tr.Expected,
+			MajorityVerdict: verdict,
+			Passed:          verdict == tr.Expected,
+			Consistent:      areAllRunsConsistent(tr.Runs),
+			Runs:            runs,
+			Attempts:        attempts,
+			Retries:         tr.Retries(),
+			DurationsMs:     durationsMs(tr.Durations),
+			Latency:         newMetaLatencyJSON(tr.Latency()),
+		})
+	}
+
+	return report
+}
+
+// durationsMs converts durations to milliseconds, returning nil for none
+func durationsMs(durations []time.Duration) []float64 {
+	if len(durations) == 0 {
+		return nil
+	}
+	ms := make([]float64, len(durations))
+	for i, d := range durations {
+		ms[i] = float64(d) / float64(time.Millisecond)
+	}
+	return ms
+}
+
+// formatMetaReportJSONResult serializes the evaluation result as indented JSON
+func formatMetaReportJSONResult(result EvaluationResult) (string, error) {
+	data, err := json.MarshalIndent(newMetaReportJSON(result), "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling meta report: %w", err)
+	}
+	return string(data), nil
+}
+
+// defaultMetaCostPerCall is the assumed dollar cost of one agent run when none is configured
+const defaultMetaCostPerCall = 0.015
+
+// confirmMetaExecution estimates API calls and prompts for confirmation if needed.
+// The estimate and prompt are written to out.
+func confirmMetaExecution(evalFiles []string, k int, confirm bool, out io.Writer) error {
+	return confirmMetaExecutionWithOptions(evalFiles, k, confirm, out, MetaOptions{})
+}
+
+// confirmMetaExecutionWithOptions estimates API calls and cost using opts.CostPerCall, and a
+// worst case in which every run uses all opts.MaxRetries retries. It aborts when the worst case
+// exceeds opts.MaxCost, even with confirm, and otherwise prompts for confirmation if needed.
+func confirmMetaExecutionWithOptions(evalFiles []string, k int, confirm bool, out io.Writer, opts MetaOptions) error {
+	costPerCall := opts.CostPerCall
+	if costPerCall == 0 {
+		costPerCall = defaultMetaCostPerCall
+	}
+
 	// Calculate total API calls estimate
 	totalTests := 0
 	for _, evalPath := range evalFiles {
@@ -544,10 +1102,24 @@ func confirmMetaExecution(evalFiles []string, k int, confirm bool) error {
 	}
 
 	// Show estimate
-	fmt.Printf("\nMeta-Evaluation Estimate:\n")
-	fmt.Printf("  Eval files: %d\n", len(evalFiles))
-	fmt.Printf("  Total API calls: %d\n", totalTests)
-	fmt.Printf("  Estimated cost: ~$%.2f (assuming $0.015 per call)\n\n", float64(totalTests)*0.015)
+	fmt.Fprintf(out, "\nMeta-Evaluation Estimate:\n")
+	fmt.Fprintf(out, "  Eval files: %d\n", len(evalFiles))
+	fmt.Fprintf(out, "  Total API calls: %d\n", totalTests)
+	fmt.Fprintf(out, "  Estimated cost: ~$%.2f (assuming $%g per call)\n", float64(totalTests)*costPerCall, costPerCall)
+	worstCaseCalls := totalTests * (1 + opts.MaxRetries)
+	worstCaseCost := float64(worstCaseCalls) * costPerCall
+	if opts.MaxRetries > 0 {
+		fmt.Fprintf(out, "  Worst case with %d retries per run: %d calls, ~$%.2f\n", opts.MaxRetries, worstCaseCalls, worstCaseCost)
+	}
+	if opts.MaxCost > 0 {
+		fmt.Fprintf(out, "  Cost ceiling: $%.2f\n", opts.MaxCost)
+	}
+	fmt.Fprintln(out)
+
+	// The ceiling holds even with --confirm
+	if opts.MaxCost > 0 && worstCaseCost > opts.MaxCost {
+		return fmt.Errorf("worst-case estimated cost $%.2f exceeds max cost $%.2f (lower --k or raise --max-cost)", worstCaseCost, opts.MaxCost)
+	}
 
 	// If --confirm flag is set, skip prompt
 	if confirm {
@@ -555,7 +1127,7 @@ func confirmMetaExecution(evalFiles []string, k int, confirm bool) error {
 	}
 
 	// Prompt user for confirmation
-	fmt.Print("Proceed with meta-evaluation? [y/N]: ")
+	fmt.Fprint(out, "Proceed with meta-evaluation? [y/N]: ")
 	var response string
 	fmt.Scanln(&response)
 
@@ -567,12 +1139,147 @@ func confirmMetaExecution(evalFiles []string, k int, confirm bool) error {
 	return nil
 }
 
+// MetaOptions holds optional settings for the meta command
+type MetaOptions struct {
+	// Parallel is the maximum number of concurrent agent executions (default 1)
+	Parallel int
+	// ReportsDir is where consistency-log.json is appended (default: reports/ next to the meta directory)
+	ReportsDir string
+	// NoLog skips writing consistency-log.json, e.g. for dry runs
+	NoLog bool
+	// MaxRetries is how many times an agent run ending in ERROR is retried (0 disables retries)
+	MaxRetries int
+	// Format is the output format: "text" (default) or "json"
+	Format string
+	// OutputDir, when set, receives one report file per agent plus a summary instead of stdout
+	OutputDir string
+	// Runner is the command template that runs an agent, e.g. "mycli run {agent}" (default: defaultAgentRunner)
+	Runner string
+	// CostPerCall is the assumed dollar cost of one agent run in the estimate (0 uses defaultMetaCostPerCall)
+	CostPerCall float64
+	// MaxCost aborts before running when the worst-case estimated cost exceeds it, even with confirm (0 disables)
+	MaxCost float64
+	// TiePolicy decides the majority verdict of tied runs: alphabetical (default), prefer-expected or mark-inconclusive
+	TiePolicy string
+	// EvalGlob runs the eval files matching this glob instead of the --suite or --agent layout;
+	// every matched file is validated before any agent runs
+	EvalGlob string
+}
+
+// metaBoundaryType returns the kind of boundary an eval file tests, based on its suite directory.
+// It is the default when the eval file does not set boundary_type.
+func metaBoundaryType(evalPath string) string {
+	if filepath.Base(filepath.Dir(filepath.Dir(evalPath))) == "skills" {
+		return "skill"
+	}
+	return "agent"
+}
+
+// newConsistencyResult builds the consistency log record for a completed evaluation
+func newConsistencyResult(result EvaluationResult, timestamp time.Time) ConsistencyResult {
+	metrics := calculateMetrics(result.TestResults)
+	return ConsistencyResult{
+		Timestamp:             timestamp.UTC().Format(time.RFC3339),
+		Agent:                 result.Agent,
+		BoundaryType:          result.BoundaryType,
+		ConsistencyPercentage: metrics.Consistency * 100,
+		ConsistentCount:       metrics.ConsistentCount,
+		TotalCount:            metrics.TotalTests,
+	}
+}
+
 // runMetaCommand executes the meta CLI command
 func runMetaCommand(suite, agent string, k int, metaDir string, confirm bool) error {
+	return runMetaCommandWithOptions(suite, agent, k, metaDir, confirm, MetaOptions{Parallel: 1, MaxRetries: defaultMetaMaxRetries})
+}
+
+// runMetaCommandWithOptions executes the meta CLI command with optional settings
+func runMetaCommandWithOptions(suite, agent string, k int, metaDir string, confirm bool, opts MetaOptions) error {
+	return runMetaCommandWithContext(context.Background(), suite, agent, k, metaDir, confirm, opts)
+}
+
+// runMetaCommandWithContext executes the meta CLI command, stopping agent runs when ctx is done
+func runMetaCommandWithContext(parent context.Context, suite, agent string, k int, metaDir string, confirm bool, opts MetaOptions) error {
+	if opts.Parallel < 1 {
+		return fmt.Errorf("parallel must be at least 1, got: %d", opts.Parallel)
+	}
+	if opts.MaxRetries < 0 {
+		return fmt.Errorf("max retries must not be negative, got: %d", opts.MaxRetries)
+	}
+	if opts.CostPerCall < 0 {
+		return fmt.Errorf("cost per call must not be negative, got: %g", opts.CostPerCall)
+	}
+	if opts.MaxCost < 0 {
+		return fmt.Errorf("max cost must not be negative, got: %g", opts.MaxCost)
+	}
+	if err := validateTiePolicy(opts.TiePolicy); err != nil {
+		return err
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = "text"
+	}
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid format: %s (must be 'text' or 'json')", format)
+	}
+
+	execute := runAgent
+	if opts.Runner != "" {
+		runner, err := parseAgentRunner(opts.Runner)
+		if err != nil {
+			return err
+		}
+		execute = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+			return executeAgentWithRunner(ctx, runner, agentName, input)
+		}
+	}
+
+	// JSON output keeps stdout machine-readable: the estimate goes to stderr and progress is dropped
+	var progress io.Writer = os.Stdout
+	var estimateOut io.Writer = os.Stdout
+	if format == "json" {
+		progress = io.Discard
+		estimateOut = os.Stderr
+	}
+
+	if opts.OutputDir != "" {
+		if err := os.MkdirAll(opts.OutputDir, 0755); err != nil {
+			return fmt.Errorf("creating output directory: %w", err)
+		}
+	}
+
+	reportsDir := opts.ReportsDir
+	if reportsDir == "" {
+		reportsDir = filepath.Join(filepath.Dir(metaDir), "reports")
+	}
+
 	var evalFiles []string
 	var err error
 
-	if agent != "" {
+	if opts.EvalGlob != "" {
+		if suite != "" || agent != "" {
+			return fmt.Errorf("--eval-glob cannot be combined with --suite or --agent")
+		}
+		evalFiles, err = findEvalFilesByGlob(opts.EvalGlob)
+		if err != nil {
+			return fmt.Errorf("finding eval files: %w", err)
+		}
+		if len(evalFiles) == 0 {
+			return fmt.Errorf("no eval files match %s", opts.EvalGlob)
+		}
+
+		// Files outside the meta layout haven't been through validate, so check them all up front
+		var invalid []string
+		for _, evalPath := range evalFiles {
+			if validation := validateEvalFile(evalPath); !validation.Valid {
+				invalid = append(invalid, evalPath+": "+strings.Join(validation.Errors, "; "))
+			}
+		}
+		if len(invalid) > 0 {
+			return fmt.Errorf("%d of %d eval files matching %s are invalid:\n  %s", len(invalid), len(evalFiles), opts.EvalGlob, strings.Join(invalid, "\n  "))
+		}
+	} else if agent != "" {
 		// Run specific agent
 		evalPath := filepath.Join(metaDir, "agents", agent, "eval.yaml")
 		if _, err := os.Stat(evalPath); os.IsNotExist(err) {
@@ -606,23 +1313,143 @@ func runMetaCommand(suite, agent string, k int, metaDir string, confirm bool) er
 	}
 
 	// Cost safeguard: estimate API calls and prompt for confirmation
-	if err := confirmMetaExecution(evalFiles, k, confirm); err != nil {
+	if err := confirmMetaExecutionWithOptions(evalFiles, k, confirm, estimateOut, opts); err != nil {
 		return err
 	}
 
+	// Ctrl-C cancels in-flight agent runs instead of leaving them orphaned
+	ctx, stop := signal.NotifyContext(parent, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
 	// Run evaluation for each file
+	results := make([]EvaluationResult, 0, len(evalFiles))
+	writtenReports := make(map[string]string)
 	for _, evalPath := range evalFiles {
-		fmt.Printf("\nRunning evaluation: %s\n", evalPath)
-		fmt.Println(strings.Repeat("=", 60))
+		fmt.Fprintf(progress, "\nRunning evaluation: %s\n", evalPath)
+		fmt.Fprintln(progress, strings.Repeat("=", 60))
 
-		result, err := runMetaEvaluation(evalPath, k)
+		result, err := runMetaEvaluationWithRunner(ctx, evalPath, k, opts.Parallel, opts.MaxRetries, execute, progress)
 		if err != nil {
 			return fmt.Errorf("running evaluation for %s: %w", evalPath, err)
 		}
+		for i := range result.TestResults {
+			result.TestResults[i].TiePolicy = opts.TiePolicy
+		}
+		results = append(results, result)
+
+		if opts.OutputDir != "" {
+			if err := writeMetaReportFiles(opts.OutputDir, result, format, writtenReports); err != nil {
+				return err
+			}
+		} else if format == "text" {
+			fmt.Println(formatMetaReportText(result))
+		}
 
-		report := formatMetaReport(result)
-		fmt.Println(report)
+		// Append to the consistency log so report/gate/trends can read it
+		if !opts.NoLog {
+			logPath := filepath.Join(reportsDir, "consistency-log.json")
+			record := newConsistencyResult(result, time.Now())
+			if err := appendMetaResult(logPath, record); err != nil {
+				return fmt.Errorf("appending to consistency log: %w", err)
+			}
+		}
+	}
+
+	if opts.OutputDir != "" {
+		if err := writeMetaSummaryFiles(opts.OutputDir, results, format); err != nil {
+			return err
+		}
+		fmt.Fprintf(estimateOut, "Wrote %d meta reports to %s\n", len(results), opts.OutputDir)
+		return nil
 	}
 
+	if format == "json" {
+		return printMetaReportsJSON(results)
+	}
+
+	fmt.Println(formatSuiteSummary(results))
+
+	return nil
+}
+
+// metaReportBaseName turns an agent name into a safe report file name, without extension
+func metaReportBaseName(agent string) string {
+	name := strings.NewReplacer("/", "-", "\\", "-").Replace(agent)
+	if name == "" || name == "." || name == ".." {
+		name = "unknown-agent"
+	}
+	return name
+}
+
+// metaSummaryFileName is the base name of the suite summary files written by --output-dir
+const metaSummaryFileName = "summary"
+
+// writeMetaReportFiles writes <agent>.md, and <agent>.json for the json format, into dir.
+// written maps the (lowercased) base names already used in dir to their agent; an agent whose
+// files would overwrite the summary or another agent's report is rejected.
+func writeMetaReportFiles(dir string, result EvaluationResult, format string, written map[string]string) error {
+	name := metaReportBaseName(result.Agent)
+	key := strings.ToLower(name)
+	if key == metaSummaryFileName {
+		return fmt.Errorf("agent %q cannot be written to the output directory: %s.md is the suite summary", result.Agent, name)
+	}
+	if other, ok := written[key]; ok {
+		return fmt.Errorf("agents %q and %q would both be written to %s.md in the output directory", other, result.Agent, name)
+	}
+	written[key] = result.Agent
+
+	mdPath := filepath.Join(dir, name+".md")
+	if err := os.WriteFile(mdPath, []byte(formatMetaReportText(result)), 0644); err != nil {
+		return fmt.Errorf("writing meta report: %w", err)
+	}
+
+	if format == "json" {
+		output, err := formatMetaReportJSONResult(result)
+		if err != nil {
+			return err
+		}
+		jsonPath := filepath.Join(dir, name+".json")
+		if err := os.WriteFile(jsonPath, []byte(output+"\n"), 0644); err != nil {
+			return fmt.Errorf("writing meta report: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeMetaSummaryFiles writes summary.md, and summary.json holding every report for the
+// json format, into dir
+func writeMetaSummaryFiles(dir string, results []EvaluationResult, format string) error {
+	if err := os.WriteFile(filepath.Join(dir, metaSummaryFileName+".md"), []byte(formatSuiteSummary(results)), 0644); err != nil {
+		return fmt.Errorf("writing meta summary: %w", err)
+	}
+
+	if format == "json" {
+		reports := make([]MetaReportJSON, 0, len(results))
+		for _, result := range results {
+			reports = append(reports, newMetaReportJSON(result))
+		}
+		data, err := json.MarshalIndent(reports, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshaling meta reports: %w", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, metaSummaryFileName+".json"), append(data, '\n'), 0644); err != nil {
+			return fmt.Errorf("writing meta summary: %w", err)
+		}
+	}
+	return nil
+}
+
+// printMetaReportsJSON writes the results to stdout as a JSON array with one object per agent,
+// so the shape is the same whether one agent or a whole suite was run
+func printMetaReportsJSON(results []EvaluationResult) error {
+	reports := make([]MetaReportJSON, 0, len(results))
+	for _, result := range results {
+		reports = append(reports, newMetaReportJSON(result))
+	}
+	data, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling meta reports: %w", err)
+	}
+	fmt.Println(string(data))
 	return nil
 }
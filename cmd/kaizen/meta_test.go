@@ -1,12 +1,24 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
+func init() {
+	// Agent runs fail fast in tests; don't wait out the real retry backoff
+	metaRetryBaseDelay = time.Millisecond
+}
+
 func TestLoadEvalYAML(t *testing.T) {
 	// Setup: Create temp directory with test eval.yaml
 	tmpDir := t.TempDir()
@@ -363,7 +375,7 @@ func TestFormatMetaReport(t *testing.T) {
 		},
 	}
 
-	report := formatMetaReport(evalResult)
+	report := formatMetaReportText(evalResult)
 
 	// Verify report contains expected sections
 	if !strings.Contains(report, "Meta-Evaluation Report") {
@@ -391,6 +403,50 @@ func TestFormatMetaReport(t *testing.T) {
 	}
 }
 
+// TestCalculateRunLevelAgreement tests suite-wide run-level agreement across agents
+func TestCalculateRunLevelAgreement(t *testing.T) {
+	results := []EvaluationResult{
+		{
+			Agent: "agent-a",
+			TestResults: []TestResult{
+				{TestID: "A1", Runs: []string{"PASS", "PASS", "PASS"}},         // 3/3 agree
+				{TestID: "A2", Runs: []string{"PASS", "FAIL", "FAIL"}},         // 2/3 agree
+				{TestID: "A3", Runs: []string{"FAIL", "PASS", "FAIL", "FAIL"}}, // 3/4 agree
+			},
+		},
+		{
+			Agent: "agent-b",
+			TestResults: []TestResult{
+				{TestID: "B1", Runs: []string{"PASS", "FAIL"}}, // tie: FAIL is majority, 1/2 agree
+				{TestID: "B2", Runs: []string{}},               // no runs
+			},
+		},
+	}
+
+	agreeing, total := calculateRunLevelAgreement(results)
+	if agreeing != 9 || total != 12 {
+		t.Errorf("Expected 9/12 agreeing runs, got %d/%d", agreeing, total)
+	}
+
+	summary := formatSuiteSummary(results)
+	expected := []string{
+		"Suite Summary",
+		"Evaluations: 2",
+		"Test Cases: 5",
+		"Run-level agreement: 75.0% (9/12 runs agree with their test's majority)",
+	}
+	for _, want := range expected {
+		if !strings.Contains(summary, want) {
+			t.Errorf("Expected summary to contain %q, got:\n%s", want, summary)
+		}
+	}
+
+	// An empty suite reports 0% without dividing by zero
+	if !strings.Contains(formatSuiteSummary(nil), "Run-level agreement: 0.0% (0/0 runs") {
+		t.Error("Expected 0.0% agreement for empty suite")
+	}
+}
+
 // TestGetMajorityVerdictTieBreaking tests deterministic tie-breaking behavior
 func TestGetMajorityVerdictTieBreaking(t *testing.T) {
 	tests := []struct {
@@ -435,6 +491,59 @@ func TestGetMajorityVerdictTieBreaking(t *testing.T) {
 	}
 }
 
+// TestGetMajorityVerdictWithPolicy tests each tie policy on tied and untied runs
+func TestGetMajorityVerdictWithPolicy(t *testing.T) {
+	tied := []string{"PASS", "FAIL", "PASS", "FAIL"}
+	tests := []struct {
+		name            string
+		runs            []string
+		expectedVerdict string
+		policy          string
+		want            string
+	}{
+		{name: "alphabetical picks first tied verdict", runs: tied, expectedVerdict: "PASS", policy: tiePolicyAlphabetical, want: "FAIL"},
+		{name: "empty policy is alphabetical", runs: tied, expectedVerdict: "PASS", policy: "", want: "FAIL"},
+		{name: "prefer-expected picks expected when tied", runs: tied, expectedVerdict: "PASS", policy: tiePolicyPreferExpected, want: "PASS"},
+		{name: "prefer-expected falls back to alphabetical", runs: []string{"REFINED", "FAIL"}, expectedVerdict: "PASS", policy: tiePolicyPreferExpected, want: "FAIL"},
+		{name: "mark-inconclusive reports tie", runs: tied, expectedVerdict: "PASS", policy: tiePolicyInconclusive, want: inconclusiveVerdict},
+		{name: "policies ignore clear majority", runs: []string{"FAIL", "FAIL", "PASS"}, expectedVerdict: "PASS", policy: tiePolicyPreferExpected, want: "FAIL"},
+		{name: "mark-inconclusive ignores clear majority", runs: []string{"PASS", "PASS", "FAIL"}, expectedVerdict: "PASS", policy: tiePolicyInconclusive, want: "PASS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getMajorityVerdictWithPolicy(tt.runs, tt.expectedVerdict, tt.policy); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestCalculateMetricsTiePolicy verifies a test result's tie policy decides whether a tied test is correct
+func TestCalculateMetricsTiePolicy(t *testing.T) {
+	for _, tt := range []struct {
+		policy      string
+		wantCorrect int
+	}{
+		{policy: tiePolicyAlphabetical, wantCorrect: 0},
+		{policy: tiePolicyPreferExpected, wantCorrect: 1},
+		{policy: tiePolicyInconclusive, wantCorrect: 0},
+	} {
+		results := []TestResult{{TestID: "T1", Expected: "PASS", Runs: []string{"PASS", "FAIL"}, TiePolicy: tt.policy}}
+		if got := calculateMetrics(results).CorrectCount; got != tt.wantCorrect {
+			t.Errorf("%s: expected %d correct, got %d", tt.policy, tt.wantCorrect, got)
+		}
+	}
+
+	report := formatMetaReportText(EvaluationResult{
+		Agent:       "tied-agent",
+		TestResults: []TestResult{{TestID: "T1", Expected: "PASS", Runs: []string{"PASS", "FAIL"}, TiePolicy: tiePolicyInconclusive}},
+	})
+	if !strings.Contains(report, "T1: FAIL (expected PASS, got INCONCLUSIVE)") {
+		t.Errorf("Expected inconclusive verdict in report, got:\n%s", report)
+	}
+}
+
 // TestFindEvalFiles tests the consolidated findEvalFiles function
 func TestFindEvalFiles(t *testing.T) {
 	// Setup: Create temp directory with eval files
@@ -804,7 +913,7 @@ test_cases:
 		evalFiles := []string{evalPath}
 
 		// Test with confirm=true (should skip prompt and succeed)
-		err := confirmMetaExecution(evalFiles, 0, true)
+		err := confirmMetaExecution(evalFiles, 0, true, os.Stdout)
 		if err != nil {
 			t.Errorf("confirmMetaExecution with confirm=true should not error, got: %v", err)
 		}
@@ -814,7 +923,7 @@ test_cases:
 		// When confirm=true, should skip prompt
 		evalFiles := []string{evalPath}
 
-		err := confirmMetaExecution(evalFiles, 0, true)
+		err := confirmMetaExecution(evalFiles, 0, true, os.Stdout)
 		if err != nil {
 			t.Errorf("Expected no error with confirm=true, got: %v", err)
 		}
@@ -825,7 +934,7 @@ test_cases:
 		evalFiles := []string{evalPath}
 
 		// With k=10, expected calls = 2 test cases * 10 = 20
-		err := confirmMetaExecution(evalFiles, 10, true)
+		err := confirmMetaExecution(evalFiles, 10, true, os.Stdout)
 		if err != nil {
 			t.Errorf("Expected no error with k override, got: %v", err)
 		}
@@ -862,7 +971,7 @@ test_cases:
 		evalFiles := []string{evalPath2}
 
 		// Should use default k=5
-		err = confirmMetaExecution(evalFiles, 0, true)
+		err = confirmMetaExecution(evalFiles, 0, true, os.Stdout)
 		if err != nil {
 			t.Errorf("Expected no error with default k, got: %v", err)
 		}
@@ -871,7 +980,7 @@ test_cases:
 	t.Run("Error on invalid eval file", func(t *testing.T) {
 		evalFiles := []string{"/nonexistent/eval.yaml"}
 
-		err := confirmMetaExecution(evalFiles, 0, true)
+		err := confirmMetaExecution(evalFiles, 0, true, os.Stdout)
 		if err == nil {
 			t.Error("Expected error for nonexistent file, got nil")
 		}
@@ -881,6 +990,84 @@ test_cases:
 	})
 }
 
+// TestConfirmMetaExecutionCost verifies the configurable per-call cost, the retry worst case and
+// the max cost ceiling, which holds even with confirm
+func TestConfirmMetaExecutionCost(t *testing.T) {
+	metaDir := filepath.Join(t.TempDir(), "meta")
+	writeMetaAgentEval(t, metaDir)
+	evalFiles := []string{filepath.Join(metaDir, "agents", "test-agent", "eval.yaml")}
+
+	config, err := loadEvalYAML(evalFiles[0])
+	if err != nil {
+		t.Fatalf("loadEvalYAML failed: %v", err)
+	}
+	calls := len(config.TestCases) * 10
+
+	var out bytes.Buffer
+	opts := MetaOptions{CostPerCall: 0.1, MaxRetries: 2}
+	if err := confirmMetaExecutionWithOptions(evalFiles, 10, true, &out, opts); err != nil {
+		t.Fatalf("confirmMetaExecutionWithOptions failed: %v", err)
+	}
+	for _, want := range []string{
+		fmt.Sprintf("Total API calls: %d", calls),
+		fmt.Sprintf("Estimated cost: ~$%.2f (assuming $0.1 per call)", float64(calls)*0.1),
+		fmt.Sprintf("Worst case with 2 retries per run: %d calls, ~$%.2f", calls*3, float64(calls*3)*0.1),
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected estimate to contain %q, got:\n%s", want, out.String())
+		}
+	}
+
+	// The ceiling compares the worst case, so it aborts even though the base estimate fits
+	opts.MaxCost = float64(calls) * 0.1 * 2
+	err = confirmMetaExecutionWithOptions(evalFiles, 10, true, io.Discard, opts)
+	if err == nil || !strings.Contains(err.Error(), "exceeds max cost") {
+		t.Errorf("Expected max cost error with confirm, got %v", err)
+	}
+	opts.MaxCost = float64(calls) * 0.1 * 3.5
+	if err := confirmMetaExecutionWithOptions(evalFiles, 10, true, io.Discard, opts); err != nil {
+		t.Errorf("Expected worst case within max cost to proceed, got %v", err)
+	}
+
+	// Without options the estimate keeps the default rate and has no worst case line
+	out.Reset()
+	if err := confirmMetaExecution(evalFiles, 10, true, &out); err != nil {
+		t.Fatalf("confirmMetaExecution failed: %v", err)
+	}
+	if !strings.Contains(out.String(), "assuming $0.015 per call") || strings.Contains(out.String(), "Worst case") {
+		t.Errorf("Expected default estimate, got:\n%s", out.String())
+	}
+}
+
+// TestRunMetaCommandMaxCostAborts verifies a run over the max cost aborts before any agent runs
+func TestRunMetaCommandMaxCostAborts(t *testing.T) {
+	metaDir := filepath.Join(t.TempDir(), "meta")
+	writeMetaAgentEval(t, metaDir)
+
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	agentRuns := 0
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		agentRuns++
+		return input.TaskTitle, nil
+	}
+
+	err := runMetaCommandWithOptions("", "test-agent", 50, metaDir, true, MetaOptions{Parallel: 1, NoLog: true, MaxCost: 0.01})
+	if err == nil || !strings.Contains(err.Error(), "exceeds max cost $0.01") {
+		t.Errorf("Expected max cost error, got %v", err)
+	}
+	if agentRuns != 0 {
+		t.Errorf("Expected no agent runs, got %d", agentRuns)
+	}
+
+	for _, opts := range []MetaOptions{{Parallel: 1, CostPerCall: -1}, {Parallel: 1, MaxCost: -1}} {
+		err := runMetaCommandWithOptions("", "test-agent", 1, metaDir, true, opts)
+		if err == nil || !strings.Contains(err.Error(), "must not be negative") {
+			t.Errorf("Expected negative value error for %+v, got %v", opts, err)
+		}
+	}
+}
+
 // TestRunMetaCommandWithAgent tests running meta command with specific agent
 func TestRunMetaCommandWithAgent(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -1428,3 +1615,989 @@ func TestValidateEvalConfig(t *testing.T) {
 		})
 	}
 }
+
+// writeParallelEvalYAML writes an eval.yaml whose test titles encode the verdict the stub agent returns
+func writeParallelEvalYAML(t *testing.T) string {
+	t.Helper()
+	sampleEval := `agent: yokay-test-agent
+consistency_threshold: 0.95
+
+test_cases:
+  - id: TST-001
+    name: "Slow pass case"
+    input:
+      task_title: "PASS"
+      task_description: "Stub agent echoes the title"
+    expected: PASS
+    k: 4
+    rationale: "Returns PASS"
+  - id: TST-002
+    name: "Fast fail case"
+    input:
+      task_title: "FAIL"
+      task_description: "Stub agent echoes the title"
+    expected: FAIL
+    k: 4
+    rationale: "Returns FAIL"
+  - id: TST-003
+    name: "Skip case"
+    input:
+      task_title: "SKIP"
+      task_description: "Stub agent echoes the title"
+    expected: SKIP
+    k: 2
+    rationale: "Returns SKIP"
+`
+	evalPath := filepath.Join(t.TempDir(), "eval.yaml")
+	if err := os.WriteFile(evalPath, []byte(sampleEval), 0644); err != nil {
+		t.Fatalf("Failed to write test eval.yaml: %v", err)
+	}
+	return evalPath
+}
+
+// TestRunMetaEvaluationParallel verifies concurrent runs are bounded and results stay in order
+func TestRunMetaEvaluationParallel(t *testing.T) {
+	evalPath := writeParallelEvalYAML(t)
+
+	var mu sync.Mutex
+	active, maxActive := 0, 0
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		mu.Lock()
+		active++
+		if active > maxActive {
+			maxActive = active
+		}
+		mu.Unlock()
+
+		// Earlier test cases finish last to scramble completion order
+		delay := map[string]time.Duration{"PASS": 30, "FAIL": 10, "SKIP": 1}[input.TaskTitle]
+		time.Sleep(delay * time.Millisecond)
+
+		mu.Lock()
+		active--
+		mu.Unlock()
+		return input.TaskTitle, nil
+	}
+
+	result, err := runMetaEvaluationWithContext(context.Background(), evalPath, 0, 3, 0, os.Stdout)
+	if err != nil {
+		t.Fatalf("runMetaEvaluationWithContext failed: %v", err)
+	}
+
+	if maxActive > 3 {
+		t.Errorf("Expected at most 3 concurrent runs, got %d", maxActive)
+	}
+	if maxActive < 2 {
+		t.Errorf("Expected runs to execute concurrently, max concurrency was %d", maxActive)
+	}
+
+	expected := []struct {
+		id   string
+		runs int
+	}{{"TST-001", 4}, {"TST-002", 4}, {"TST-003", 2}}
+	if len(result.TestResults) != len(expected) {
+		t.Fatalf("Expected %d test results, got %d", len(expected), len(result.TestResults))
+	}
+	for i, want := range expected {
+		tr := result.TestResults[i]
+		if tr.TestID != want.id {
+			t.Errorf("Result %d: expected %s, got %s", i, want.id, tr.TestID)
+		}
+		if len(tr.Runs) != want.runs {
+			t.Errorf("%s: expected %d runs, got %d", tr.TestID, want.runs, len(tr.Runs))
+		}
+		for j, verdict := range tr.Runs {
+			if verdict != tr.Expected {
+				t.Errorf("%s run %d: expected %s, got %q", tr.TestID, j+1, tr.Expected, verdict)
+			}
+		}
+	}
+
+	// Report output is the same as a sequential run,
+	sequential, err := runMetaEvaluationWithContext(context.Background(), evalPath, 0, 1, 0, os.Stdout)
+	if err != nil {
+		t.Fatalf("sequential run failed: %v", err)
+	}
+	// apart from the run timings
+	for _, r := range []*EvaluationResult{&result, &sequential} {
+		for i := range r.TestResults {
+			r.TestResults[i].Durations = nil
+		}
+	}
+	if formatMetaReportText(result) != formatMetaReportText(sequential) {
+		t.Error("Expected parallel and sequential reports to match")
+	}
+}
+
+// TestRunMetaEvaluationRetriesErrors verifies ERROR runs are retried and still count as one run
+func TestRunMetaEvaluationRetriesErrors(t *testing.T) {
+	evalPath := writeParallelEvalYAML(t)
+
+	var mu sync.Mutex
+	calls := make(map[string]int)
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[input.TaskTitle]++
+		switch input.TaskTitle {
+		case "PASS":
+			// Every other attempt times out, so each run needs one retry
+			if calls["PASS"]%2 == 1 {
+				return "ERROR", fmt.Errorf("agent execution timed out after 5 minutes")
+			}
+		case "SKIP":
+			// Always fails, so retries are exhausted
+			return "ERROR", fmt.Errorf("agent execution failed")
+		}
+		return input.TaskTitle, nil
+	}
+
+	// Capture stderr warnings
+	oldStderr := os.Stderr
+	r, w, _ := os.Pipe()
+	os.Stderr = w
+
+	result, err := runMetaEvaluationWithContext(context.Background(), evalPath, 0, 1, 2, os.Stdout)
+
+	w.Close()
+	os.Stderr = oldStderr
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	if err != nil {
+		t.Fatalf("runMetaEvaluationWithContext failed: %v", err)
+	}
+
+	want := map[string]struct {
+		runs  []string
+		calls int
+	}{
+		"TST-001": {[]string{"PASS", "PASS", "PASS", "PASS"}, 8},
+		"TST-002": {[]string{"FAIL", "FAIL", "FAIL", "FAIL"}, 4},
+		"TST-003": {[]string{"ERROR", "ERROR"}, 6},
+	}
+	for _, tr := range result.TestResults {
+		expected := want[tr.TestID]
+		if strings.Join(tr.Runs, ",") != strings.Join(expected.runs, ",") {
+			t.Errorf("%s: expected runs %v, got %v", tr.TestID, expected.runs, tr.Runs)
+		}
+		if got := calls[tr.Expected]; got != expected.calls {
+			t.Errorf("%s: expected %d agent calls, got %d", tr.TestID, expected.calls, got)
+		}
+	}
+
+	stderr := buf.String()
+	if !strings.Contains(stderr, "TST-001 (run 1/4) needed 1 retries") {
+		t.Errorf("Expected retry warning for TST-001, got: %s", stderr)
+	}
+	if !strings.Contains(stderr, "TST-003 (run 2/2) after 2 retries") {
+		t.Errorf("Expected exhausted-retries warning for TST-003, got: %s", stderr)
+	}
+}
+
+// TestRunMetaEvaluationRecordsAttempts verifies per-run attempt counts survive into the report
+func TestRunMetaEvaluationRecordsAttempts(t *testing.T) {
+	evalPath := writeParallelEvalYAML(t)
+
+	var mu sync.Mutex
+	failed := false
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		// The first PASS attempt fails once, then every attempt succeeds
+		if input.TaskTitle == "PASS" && !failed {
+			failed = true
+			return "ERROR", fmt.Errorf("agent execution timed out after 5 minutes")
+		}
+		return input.TaskTitle, nil
+	}
+
+	oldStderr := os.Stderr
+	_, w, _ := os.Pipe()
+	os.Stderr = w
+	result, err := runMetaEvaluationWithContext(context.Background(), evalPath, 0, 1, 2, io.Discard)
+	w.Close()
+	os.Stderr = oldStderr
+
+	if err != nil {
+		t.Fatalf("runMetaEvaluationWithContext failed: %v", err)
+	}
+
+	for _, tr := range result.TestResults {
+		if len(tr.Attempts) != len(tr.Runs) {
+			t.Fatalf("%s: expected one attempt count per run, got %v", tr.TestID, tr.Attempts)
+		}
+		wantRetries := 0
+		if tr.TestID == "TST-001" {
+			wantRetries = 1
+		}
+		if got := tr.Retries(); got != wantRetries {
+			t.Errorf("%s: expected %d retries, got %d (attempts %v)", tr.TestID, wantRetries, got, tr.Attempts)
+		}
+	}
+
+	first := result.TestResults[0]
+	if first.Attempts[0] != 2 || strings.Join(first.Runs, ",") != "PASS,PASS,PASS,PASS" {
+		t.Errorf("Expected first run to take 2 attempts and all runs to pass, got attempts %v runs %v", first.Attempts, first.Runs)
+	}
+
+	if report := formatMetaReportText(result); !strings.Contains(report, "TST-001: PASS (4/4 consistent) [required 1 retry]") {
+		t.Errorf("Expected retries annotation in text report, got:\n%s", report)
+	}
+
+	result.TestResults[0].Attempts[1] = 2
+	if report := formatMetaReportText(result); !strings.Contains(report, "TST-001: PASS (4/4 consistent) [required 2 retries]") {
+		t.Errorf("Expected plural retries annotation in text report, got:\n%s", report)
+	}
+	result.TestResults[0].Attempts[1] = 1
+
+	output, err := formatMetaReportJSONResult(result)
+	if err != nil {
+		t.Fatalf("formatMetaReportJSONResult failed: %v", err)
+	}
+	var report MetaReportJSON
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	got := report.TestResults[0]
+	if got.Retries != 1 || fmt.Sprint(got.Attempts) != "[2 1 1 1]" {
+		t.Errorf("Expected attempts [2 1 1 1] and 1 retry in JSON, got %v and %d", got.Attempts, got.Retries)
+	}
+}
+
+// TestRunMetaEvaluationRecordsLatency verifies each run's agent execution time is recorded and
+// aggregated per test case and agent, without counting retry backoff
+func TestRunMetaEvaluationRecordsLatency(t *testing.T) {
+	evalPath := writeParallelEvalYAML(t)
+
+	oldDelay := metaRetryBaseDelay
+	metaRetryBaseDelay = 200 * time.Millisecond
+	defer func() { metaRetryBaseDelay = oldDelay }()
+
+	var mu sync.Mutex
+	skipFailed := false
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		delay := map[string]time.Duration{"PASS": 40, "FAIL": 10, "SKIP": 5}[input.TaskTitle]
+		time.Sleep(delay * time.Millisecond)
+
+		// The first SKIP attempt fails, so one run waits out the retry backoff
+		mu.Lock()
+		defer mu.Unlock()
+		if input.TaskTitle == "SKIP" && !skipFailed {
+			skipFailed = true
+			return "ERROR", fmt.Errorf("agent execution failed")
+		}
+		return input.TaskTitle, nil
+	}
+
+	oldStderr := os.Stderr
+	_, w, _ := os.Pipe()
+	os.Stderr = w
+	result, err := runMetaEvaluationWithContext(context.Background(), evalPath, 0, 3, 1, io.Discard)
+	w.Close()
+	os.Stderr = oldStderr
+
+	if err != nil {
+		t.Fatalf("runMetaEvaluationWithContext failed: %v", err)
+	}
+
+	// Sleeps can overrun but never undershoot; the upper bound stays well below the backoff
+	want := map[string]struct{ min, max time.Duration }{
+		"TST-001": {40 * time.Millisecond, 40 * time.Millisecond},
+		"TST-002": {10 * time.Millisecond, 10 * time.Millisecond},
+		"TST-003": {5 * time.Millisecond, 10 * time.Millisecond}, // the retried run executed twice
+	}
+	for _, tr := range result.TestResults {
+		if len(tr.Durations) != len(tr.Runs) {
+			t.Fatalf("%s: expected one duration per run, got %v", tr.TestID, tr.Durations)
+		}
+		latency := tr.Latency()
+		w := want[tr.TestID]
+		if latency.Runs != len(tr.Runs) {
+			t.Errorf("%s: expected %d timed runs, got %d", tr.TestID, len(tr.Runs), latency.Runs)
+		}
+		if latency.Mean < w.min || latency.Max < w.max {
+			t.Errorf("%s: expected mean >= %s and max >= %s, got %+v", tr.TestID, w.min, w.max, latency)
+		}
+		if latency.Max >= 150*time.Millisecond {
+			t.Errorf("%s: expected max below the retry backoff, got %s", tr.TestID, latency.Max)
+		}
+		if latency.Mean > latency.Max {
+			t.Errorf("%s: mean %s exceeds max %s", tr.TestID, latency.Mean, latency.Max)
+		}
+	}
+
+	overall := result.Latency()
+	if overall.Runs != 10 || overall.Max < 40*time.Millisecond {
+		t.Errorf("Expected 10 timed runs with max >= 40ms, got %+v", overall)
+	}
+
+	report := formatMetaReportText(result)
+	for _, want := range []string{"Latency:", "  TST-001: mean ", "  Overall: mean "} {
+		if !strings.Contains(report, want) {
+			t.Errorf("Expected %q in text report, got:\n%s", want, report)
+		}
+	}
+
+	output, err := formatMetaReportJSONResult(result)
+	if err != nil {
+		t.Fatalf("formatMetaReportJSONResult failed: %v", err)
+	}
+	var jsonReport MetaReportJSON
+	if err := json.Unmarshal([]byte(output), &jsonReport); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	if jsonReport.Latency == nil || jsonReport.Latency.Runs != 10 || jsonReport.Latency.MaxMs < 40 {
+		t.Errorf("Expected agent latency over 10 runs with max_ms >= 40, got %+v", jsonReport.Latency)
+	}
+	first := jsonReport.TestResults[0]
+	if len(first.DurationsMs) != 4 || first.Latency == nil || first.Latency.MeanMs < 40 {
+		t.Errorf("Expected 4 durations and mean_ms >= 40 for TST-001, got %v and %+v", first.DurationsMs, first.Latency)
+	}
+}
+
+// TestCalculateLatency verifies the mean and max of run durations
+func TestCalculateLatency(t *testing.T) {
+	if got := calculateLatency(nil); got != (LatencyStats{}) {
+		t.Errorf("Expected zero stats for no runs, got %+v", got)
+	}
+
+	got := calculateLatency([]time.Duration{time.Second, 3 * time.Second, 2 * time.Second})
+	want := LatencyStats{Runs: 3, Mean: 2 * time.Second, Max: 3 * time.Second}
+	if got != want {
+		t.Errorf("calculateLatency = %+v, want %+v", got, want)
+	}
+
+	// Results built without timing report no latency
+	report := formatMetaReportText(EvaluationResult{Agent: "a", TestResults: []TestResult{{TestID: "t", Expected: "PASS", Runs: []string{"PASS"}}}})
+	if strings.Contains(report, "Latency:") {
+		t.Errorf("Expected no latency section without timings, got:\n%s", report)
+	}
+}
+
+// TestRunMetaCommandNegativeMaxRetries verifies --max-retries must not be negative
+func TestRunMetaCommandNegativeMaxRetries(t *testing.T) {
+	err := runMetaCommandWithOptions("agents", "", 1, t.TempDir(), true, MetaOptions{Parallel: 1, MaxRetries: -1})
+	if err == nil || !strings.Contains(err.Error(), "max retries") {
+		t.Errorf("Expected max retries validation error, got %v", err)
+	}
+}
+
+// TestRunMetaCommandBoundaryType verifies boundary_type from eval.yaml reaches the report and consistency log
+func TestRunMetaCommandBoundaryType(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	agentDir := filepath.Join(metaDir, "agents", "epic-agent")
+	if err := os.MkdirAll(agentDir, 0755); err != nil {
+		t.Fatalf("Failed to create agent dir: %v", err)
+	}
+	evalYAML := `agent: yokay-test-agent
+boundary_type: epic
+consistency_threshold: 0.95
+
+test_cases:
+  - id: EPC-001
+    name: "Epic case"
+    input:
+      task_title: "PASS"
+      task_description: "Stub agent echoes the title"
+    expected: PASS
+    k: 2
+    rationale: "Should pass"
+`
+	if err := os.WriteFile(filepath.Join(agentDir, "eval.yaml"), []byte(evalYAML), 0644); err != nil {
+		t.Fatalf("Failed to write eval.yaml: %v", err)
+	}
+
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		return input.TaskTitle, nil
+	}
+
+	result, err := runMetaEvaluationWithContext(context.Background(), filepath.Join(agentDir, "eval.yaml"), 0, 1, 0, io.Discard)
+	if err != nil {
+		t.Fatalf("runMetaEvaluationWithContext failed: %v", err)
+	}
+	if result.BoundaryType != "epic" {
+		t.Errorf("Expected boundary type epic, got %q", result.BoundaryType)
+	}
+	if report := formatMetaReportText(result); !strings.Contains(report, "Boundary Type: epic\n") {
+		t.Errorf("Expected boundary type in text report, got:\n%s", report)
+	}
+	if report := newMetaReportJSON(result); report.BoundaryType != "epic" {
+		t.Errorf("Expected boundary type in JSON report, got %q", report.BoundaryType)
+	}
+
+	if err := runMetaCommandWithOptions("", "epic-agent", 0, metaDir, true, MetaOptions{Parallel: 1}); err != nil {
+		t.Fatalf("runMetaCommandWithOptions failed: %v", err)
+	}
+	results, err := loadMetaResults(filepath.Join(tmpDir, "reports", "consistency-log.json"))
+	if err != nil {
+		t.Fatalf("loadMetaResults failed: %v", err)
+	}
+	if len(results) != 1 || results[0].BoundaryType != "epic" {
+		t.Errorf("Expected epic record in consistency log, got %+v", results)
+	}
+}
+
+// TestRunMetaCommandEvalGlob verifies --eval-glob runs eval files outside the meta directory layout
+func TestRunMetaCommandEvalGlob(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeEval := func(relPath, agent string) string {
+		path := filepath.Join(tmpDir, filepath.FromSlash(relPath))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create eval dir: %v", err)
+		}
+		evalYAML := "agent: " + agent + `
+consistency_threshold: 0.95
+
+test_cases:
+  - id: GLB-001
+    name: "Glob case"
+    input:
+      task_title: "PASS"
+      task_description: "Stub agent echoes the title"
+    expected: PASS
+    k: 1
+    rationale: "Should pass"
+`
+		if err := os.WriteFile(path, []byte(evalYAML), 0644); err != nil {
+			t.Fatalf("Failed to write eval file: %v", err)
+		}
+		return path
+	}
+	writeEval("services/billing/evals/review.yaml", "yokay-test-agent")
+	writeEval("tools/qa/evals/nested/more/alt.yaml", "yokay-test-agent-alt")
+	writeEval("tools/qa/not-evals/skipped.yaml", "yokay-test-agent")
+
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		return input.TaskTitle, nil
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	glob := filepath.ToSlash(tmpDir) + "/**/evals/**/*.yaml"
+	err := runMetaCommandWithOptions("", "", 0, filepath.Join(tmpDir, "meta"), true, MetaOptions{Parallel: 1, NoLog: true, Format: "json", EvalGlob: glob})
+
+	w.Close()
+	os.Stdout = oldStdout
+	output, _ := io.ReadAll(r)
+
+	if err != nil {
+		t.Fatalf("runMetaCommandWithOptions failed: %v", err)
+	}
+	var reports []MetaReportJSON
+	if err := json.Unmarshal(output, &reports); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, output)
+	}
+	var agents []string
+	for _, report := range reports {
+		agents = append(agents, report.Agent)
+	}
+	if got := strings.Join(agents, ","); got != "yokay-test-agent,yokay-test-agent-alt" {
+		t.Errorf("Expected the two eval files under evals/ directories, got %s", got)
+	}
+
+	// Every matched file is validated before anything runs
+	writeEval("services/broken/evals/bad.yaml", "not-an-approved-agent")
+	err = runMetaCommandWithOptions("", "", 0, filepath.Join(tmpDir, "meta"), true, MetaOptions{Parallel: 1, NoLog: true, EvalGlob: glob})
+	if err == nil || !strings.Contains(err.Error(), "1 of 3 eval files") || !strings.Contains(err.Error(), "bad.yaml") {
+		t.Errorf("Expected validation error naming bad.yaml, got %v", err)
+	}
+
+	err = runMetaCommandWithOptions("", "", 0, filepath.Join(tmpDir, "meta"), true, MetaOptions{Parallel: 1, EvalGlob: filepath.ToSlash(tmpDir) + "/**/*.json"})
+	if err == nil || !strings.Contains(err.Error(), "no eval files match") {
+		t.Errorf("Expected no-match error, got %v", err)
+	}
+
+	err = runMetaCommandWithOptions("agents", "", 0, filepath.Join(tmpDir, "meta"), true, MetaOptions{Parallel: 1, EvalGlob: glob})
+	if err == nil || !strings.Contains(err.Error(), "cannot be combined") {
+		t.Errorf("Expected error combining --eval-glob with --suite, got %v", err)
+	}
+}
+
+// TestMatchGlobSegments verifies "**" matches any number of directories
+func TestMatchGlobSegments(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"**/eval.yaml", "eval.yaml", true},
+		{"**/eval.yaml", "a/b/c/eval.yaml", true},
+		{"a/**/evals/*.yaml", "a/evals/x.yaml", true},
+		{"a/**/evals/*.yaml", "a/b/c/evals/x.yaml", true},
+		{"a/**/evals/*.yaml", "a/b/evals/nested/x.yaml", false},
+		{"a/*/eval.yaml", "a/b/c/eval.yaml", false},
+		{"a/*/eval.yaml", "a/b/eval.yml", false},
+	}
+	for _, tt := range tests {
+		if got := matchGlobSegments(strings.Split(tt.pattern, "/"), strings.Split(tt.path, "/")); got != tt.want {
+			t.Errorf("matchGlobSegments(%q, %q) = %v, want %v", tt.pattern, tt.path, got, tt.want)
+		}
+	}
+}
+
+// TestRunMetaCommandInvalidTiePolicy verifies --tie-policy must be a known policy
+func TestRunMetaCommandInvalidTiePolicy(t *testing.T) {
+	err := runMetaCommandWithOptions("agents", "", 1, t.TempDir(), true, MetaOptions{Parallel: 1, TiePolicy: "coin-flip"})
+	if err == nil || !strings.Contains(err.Error(), "invalid tie policy") {
+		t.Errorf("Expected tie policy validation error, got %v", err)
+	}
+}
+
+// TestRunMetaEvaluationCancelled verifies cancellation stops dispatching new runs
+func TestRunMetaEvaluationCancelled(t *testing.T) {
+	evalPath := writeParallelEvalYAML(t)
+
+	var mu sync.Mutex
+	started := 0
+	ctx, cancel := context.WithCancel(context.Background())
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		mu.Lock()
+		started++
+		if started == 2 {
+			cancel()
+		}
+		mu.Unlock()
+		<-ctx.Done()
+		return "ERROR", ctx.Err()
+	}
+
+	_, err := runMetaEvaluationWithContext(ctx, evalPath, 0, 2, defaultMetaMaxRetries, os.Stdout)
+	if err == nil || !strings.Contains(err.Error(), "cancelled") {
+		t.Fatalf("Expected cancellation error, got %v", err)
+	}
+	if started > 3 {
+		t.Errorf("Expected dispatch to stop after cancellation, %d runs started", started)
+	}
+}
+
+// TestRunMetaCommandInvalidParallel verifies --parallel must be positive
+func TestRunMetaCommandInvalidParallel(t *testing.T) {
+	err := runMetaCommandWithOptions("agents", "", 1, t.TempDir(), true, MetaOptions{Parallel: 0})
+	if err == nil || !strings.Contains(err.Error(), "parallel must be at least 1") {
+		t.Errorf("Expected parallel validation error, got %v", err)
+	}
+}
+
+// writeMetaAgentEval writes a single-test eval.yaml for test-agent under metaDir
+func writeMetaAgentEval(t *testing.T, metaDir string) {
+	t.Helper()
+	agentDir := filepath.Join(metaDir, "agents", "test-agent")
+	if err := os.MkdirAll(agentDir, 0755); err != nil {
+		t.Fatalf("Failed to create agent dir: %v", err)
+	}
+	sampleEval := `agent: yokay-test-agent
+consistency_threshold: 0.95
+
+test_cases:
+  - id: TST-001
+    name: "Consistent case"
+    input:
+      task_title: "PASS"
+      task_description: "Stub agent echoes the title"
+    expected: PASS
+    k: 3
+    rationale: "Should pass"
+  - id: TST-002
+    name: "Flaky case"
+    input:
+      task_title: "FLAKY"
+      task_description: "Stub agent alternates verdicts"
+    expected: PASS
+    k: 2
+    rationale: "Alternates"
+`
+	if err := os.WriteFile(filepath.Join(agentDir, "eval.yaml"), []byte(sampleEval), 0644); err != nil {
+		t.Fatalf("Failed to write eval.yaml: %v", err)
+	}
+}
+
+// TestRunMetaCommandAppendsConsistencyLog verifies each run appends a record to consistency-log.json
+func TestRunMetaCommandAppendsConsistencyLog(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	writeMetaAgentEval(t, metaDir)
+
+	var mu sync.Mutex
+	flakyCalls := 0
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		if input.TaskTitle != "FLAKY" {
+			return input.TaskTitle, nil
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		flakyCalls++
+		if flakyCalls%2 == 0 {
+			return "FAIL", nil
+		}
+		return "PASS", nil
+	}
+
+	// Default location is reports/ next to the meta directory; run twice to verify appending
+	for i := 0; i < 2; i++ {
+		if err := runMetaCommandWithOptions("", "test-agent", 0, metaDir, true, MetaOptions{Parallel: 1}); err != nil {
+			t.Fatalf("runMetaCommandWithOptions failed: %v", err)
+		}
+	}
+
+	logPath := filepath.Join(tmpDir, "reports", "consistency-log.json")
+	results, err := loadMetaResults(logPath)
+	if err != nil {
+		t.Fatalf("loadMetaResults failed: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 appended records, got %d", len(results))
+	}
+
+	record := results[0]
+	if record.Agent != "yokay-test-agent" || record.BoundaryType != "agent" {
+		t.Errorf("Unexpected record identity: %+v", record)
+	}
+	if record.ConsistentCount != 1 || record.TotalCount != 2 || record.ConsistencyPercentage != 50.0 {
+		t.Errorf("Expected 1/2 consistent (50%%), got %+v", record)
+	}
+	if record.Timestamp == "" {
+		t.Error("Expected timestamp to be set")
+	}
+
+	// A custom reports dir is honored
+	customDir := filepath.Join(tmpDir, "custom-reports")
+	if err := runMetaCommandWithOptions("", "test-agent", 0, metaDir, true, MetaOptions{Parallel: 1, ReportsDir: customDir}); err != nil {
+		t.Fatalf("runMetaCommandWithOptions failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(customDir, "consistency-log.json")); err != nil {
+		t.Errorf("Expected consistency log in custom reports dir: %v", err)
+	}
+}
+
+// TestRunMetaCommandNoLog verifies --no-log suppresses writing the consistency log
+func TestRunMetaCommandNoLog(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	writeMetaAgentEval(t, metaDir)
+
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		return "PASS", nil
+	}
+
+	if err := runMetaCommandWithOptions("", "test-agent", 0, metaDir, true, MetaOptions{Parallel: 1, NoLog: true}); err != nil {
+		t.Fatalf("runMetaCommandWithOptions failed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(tmpDir, "reports", "consistency-log.json")); !os.IsNotExist(err) {
+		t.Errorf("Expected no consistency log with NoLog, stat error: %v", err)
+	}
+}
+
+// TestFormatMetaReportJSONResult verifies the JSON schema includes metrics and raw runs
+func TestFormatMetaReportJSONResult(t *testing.T) {
+	result := EvaluationResult{
+		Agent: "yokay-test-agent",
+		TestResults: []TestResult{
+			{TestID: "TST-001", Name: "Consistent", Expected: "PASS", Runs: []string{"PASS", "PASS", "PASS"}},
+			{TestID: "TST-002", Name: "Flaky", Expected: "PASS", Runs: []string{"FAIL", "PASS", "FAIL"}},
+		},
+	}
+
+	output, err := formatMetaReportJSONResult(result)
+	if err != nil {
+		t.Fatalf("formatMetaReportJSONResult failed: %v", err)
+	}
+
+	var report MetaReportJSON
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, output)
+	}
+
+	if report.Agent != "yokay-test-agent" {
+		t.Errorf("Expected agent yokay-test-agent, got %s", report.Agent)
+	}
+	if report.Metrics.Accuracy != 0.5 || report.Metrics.Consistency != 0.5 {
+		t.Errorf("Expected accuracy and consistency 0.5, got %+v", report.Metrics)
+	}
+	if len(report.TestResults) != 2 {
+		t.Fatalf("Expected 2 test results, got %d", len(report.TestResults))
+	}
+
+	flaky := report.TestResults[1]
+	if flaky.MajorityVerdict != "FAIL" || flaky.Expected != "PASS" || flaky.Passed || flaky.Consistent {
+		t.Errorf("Unexpected flaky test result: %+v", flaky)
+	}
+	if strings.Join(flaky.Runs, ",") != "FAIL,PASS,FAIL" {
+		t.Errorf("Expected raw runs FAIL,PASS,FAIL, got %v", flaky.Runs)
+	}
+
+	for _, key := range []string{`"agent"`, `"metrics"`, `"test_results"`, `"runs"`, `"majority_verdict"`} {
+		if !strings.Contains(output, key) {
+			t.Errorf("Expected JSON to contain %s", key)
+		}
+	}
+}
+
+// TestRunMetaCommandJSONFormat verifies --format json keeps stdout valid JSON
+func TestRunMetaCommandJSONFormat(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	writeMetaAgentEval(t, metaDir)
+
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		return "PASS", nil
+	}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runMetaCommandWithOptions("", "test-agent", 0, metaDir, true, MetaOptions{Parallel: 1, NoLog: true, Format: "json"})
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	if err != nil {
+		t.Fatalf("runMetaCommandWithOptions failed: %v", err)
+	}
+
+	var reports []MetaReportJSON
+	if err := json.Unmarshal(buf.Bytes(), &reports); err != nil {
+		t.Fatalf("Stdout is not a JSON array: %v\n%s", err, buf.String())
+	}
+	if len(reports) != 1 {
+		t.Fatalf("Expected one report for a single agent, got %d", len(reports))
+	}
+	report := reports[0]
+	if report.Agent != "yokay-test-agent" || len(report.TestResults) != 2 {
+		t.Errorf("Unexpected report: %+v", report)
+	}
+	if report.Metrics.Accuracy != 1 {
+		t.Errorf("Expected accuracy 1, got %v", report.Metrics.Accuracy)
+	}
+}
+
+// TestRunMetaCommandInvalidFormat verifies unknown formats are rejected
+func TestRunMetaCommandInvalidFormat(t *testing.T) {
+	err := runMetaCommandWithOptions("agents", "", 1, t.TempDir(), true, MetaOptions{Parallel: 1, Format: "xml"})
+	if err == nil || !strings.Contains(err.Error(), "invalid format") {
+		t.Errorf("Expected format validation error, got %v", err)
+	}
+}
+
+// TestRunMetaCommandOutputDir verifies --output-dir writes one report per agent plus a summary
+func TestRunMetaCommandOutputDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	for _, agent := range []string{"yokay-test-agent", "yokay-test-agent-alt"} {
+		agentDir := filepath.Join(metaDir, "agents", agent)
+		if err := os.MkdirAll(agentDir, 0755); err != nil {
+			t.Fatalf("Failed to create agent dir: %v", err)
+		}
+		evalYAML := fmt.Sprintf(`agent: %s
+consistency_threshold: 0.95
+
+test_cases:
+  - id: TST-001
+    name: "Passing case"
+    input:
+      task_title: "PASS"
+      task_description: "Stub agent echoes the title"
+    expected: PASS
+    k: 2
+    rationale: "Should pass"
+`, agent)
+		if err := os.WriteFile(filepath.Join(agentDir, "eval.yaml"), []byte(evalYAML), 0644); err != nil {
+			t.Fatalf("Failed to write eval.yaml: %v", err)
+		}
+	}
+
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		return input.TaskTitle, nil
+	}
+
+	outputDir := filepath.Join(tmpDir, "meta-reports")
+	opts := MetaOptions{Parallel: 1, NoLog: true, Format: "json", OutputDir: outputDir}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runMetaCommandWithOptions("agents", "", 0, metaDir, true, opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	if err != nil {
+		t.Fatalf("runMetaCommandWithOptions failed: %v", err)
+	}
+	if strings.Contains(buf.String(), "Meta-Evaluation Report") {
+		t.Errorf("Expected reports in files, not stdout:\n%s", buf.String())
+	}
+
+	for _, agent := range []string{"yokay-test-agent", "yokay-test-agent-alt"} {
+		text, err := os.ReadFile(filepath.Join(outputDir, agent+".md"))
+		if err != nil {
+			t.Fatalf("Expected text report for %s: %v", agent, err)
+		}
+		if !strings.Contains(string(text), "Agent: "+agent) {
+			t.Errorf("Report for %s has wrong content:\n%s", agent, text)
+		}
+
+		data, err := os.ReadFile(filepath.Join(outputDir, agent+".json"))
+		if err != nil {
+			t.Fatalf("Expected JSON report for %s: %v", agent, err)
+		}
+		var report MetaReportJSON
+		if err := json.Unmarshal(data, &report); err != nil {
+			t.Fatalf("JSON report for %s is invalid: %v", agent, err)
+		}
+		if report.Agent != agent {
+			t.Errorf("Expected agent %s, got %s", agent, report.Agent)
+		}
+	}
+
+	summary, err := os.ReadFile(filepath.Join(outputDir, "summary.md"))
+	if err != nil {
+		t.Fatalf("Expected summary.md: %v", err)
+	}
+	if !strings.Contains(string(summary), "Evaluations: 2") {
+		t.Errorf("Unexpected summary:\n%s", summary)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "summary.json")); err != nil {
+		t.Errorf("Expected summary.json: %v", err)
+	}
+}
+
+// TestWriteMetaReportFilesCollisions verifies an agent is rejected rather than overwriting the summary or another report
+func TestWriteMetaReportFilesCollisions(t *testing.T) {
+	outputDir := t.TempDir()
+	written := make(map[string]string)
+
+	if err := writeMetaReportFiles(outputDir, EvaluationResult{Agent: "Summary"}, "text", written); err == nil || !strings.Contains(err.Error(), "suite summary") {
+		t.Errorf("Expected summary collision error, got %v", err)
+	}
+
+	if err := writeMetaReportFiles(outputDir, EvaluationResult{Agent: "team/reviewer"}, "text", written); err != nil {
+		t.Fatalf("writeMetaReportFiles failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(outputDir, "team-reviewer.md")); err != nil {
+		t.Errorf("Expected team-reviewer.md: %v", err)
+	}
+	if err := writeMetaReportFiles(outputDir, EvaluationResult{Agent: "team-reviewer"}, "text", written); err == nil || !strings.Contains(err.Error(), "both be written") {
+		t.Errorf("Expected duplicate file name error, got %v", err)
+	}
+}
+
+// TestParseAgentRunner verifies runner templates are split and require {agent}
+func TestParseAgentRunner(t *testing.T) {
+	runner, err := parseAgentRunner(defaultAgentRunner)
+	if err != nil || strings.Join(runner, " ") != "claude --agent {agent} --print" {
+		t.Errorf("Expected default claude runner, got %v (err: %v)", runner, err)
+	}
+
+	runner, err = parseAgentRunner("  mycli run  {agent} ")
+	if err != nil || len(runner) != 3 || runner[2] != "{agent}" {
+		t.Errorf("Expected [mycli run {agent}], got %v (err: %v)", runner, err)
+	}
+
+	if _, err := parseAgentRunner("mycli run"); err == nil || !strings.Contains(err.Error(), "{agent}") {
+		t.Errorf("Expected error for runner without {agent}, got %v", err)
+	}
+	if _, err := parseAgentRunner("   "); err == nil {
+		t.Error("Expected error for empty runner")
+	}
+}
+
+// TestRunMetaCommandCustomRunner verifies a configured runner is invoked with {agent} substituted
+// and the prompt piped via stdin
+func TestRunMetaCommandCustomRunner(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	writeMetaAgentEval(t, metaDir)
+
+	promptDir := filepath.Join(tmpDir, "prompts")
+	if err := os.MkdirAll(promptDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	script := filepath.Join(tmpDir, "runner.sh")
+	scriptBody := "#!/bin/sh\ncat > \"" + promptDir + "/$2.txt\"\necho \"VERDICT: PASS\"\n"
+	if err := os.WriteFile(script, []byte(scriptBody), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		t.Error("Expected the configured runner to be used instead of runAgent")
+		return "ERROR", nil
+	}
+
+	opts := MetaOptions{Parallel: 1, NoLog: true, Format: "json", Runner: script + " run {agent}"}
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runMetaCommandWithOptions("", "test-agent", 1, metaDir, true, opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	if err != nil {
+		t.Fatalf("runMetaCommandWithOptions failed: %v", err)
+	}
+
+	var reports []MetaReportJSON
+	if err := json.Unmarshal(buf.Bytes(), &reports); err != nil || len(reports) != 1 {
+		t.Fatalf("Expected a JSON array with one report: %v\n%s", err, buf.String())
+	}
+	for _, tr := range reports[0].TestResults {
+		if strings.Join(tr.Runs, ",") != "PASS" {
+			t.Errorf("Expected runner verdict PASS for %s, got %v", tr.TestID, tr.Runs)
+		}
+	}
+
+	prompt, err := os.ReadFile(filepath.Join(promptDir, "yokay-test-agent.txt"))
+	if err != nil {
+		t.Fatalf("Expected runner to receive the agent name as an argument: %v", err)
+	}
+	if !strings.Contains(string(prompt), "FLAKY") {
+		t.Errorf("Expected prompt piped via stdin, got:\n%s", prompt)
+	}
+}
+
+// TestRunMetaCommandInvalidRunner verifies runners without {agent} are rejected
+func TestRunMetaCommandInvalidRunner(t *testing.T) {
+	err := runMetaCommandWithOptions("agents", "", 1, t.TempDir(), true, MetaOptions{Parallel: 1, Runner: "mycli run"})
+	if err == nil || !strings.Contains(err.Error(), "{agent}") {
+		t.Errorf("Expected runner validation error, got %v", err)
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// PruneOptions holds the settings for the prune command
+type PruneOptions struct {
+	// OlderThan is the minimum age of the failures to delete
+	OlderThan time.Duration
+	// DryRun reports what would be deleted without touching the database
+	DryRun bool
+	// Now is the reference time for the cutoff (default: time.Now())
+	Now time.Time
+}
+
+// parseAge parses an age such as "90d", "2w" or any time.ParseDuration value like "36h"
+func parseAge(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	var age time.Duration
+	if n, ok := strings.CutSuffix(value, "d"); ok {
+		days, err := strconv.Atoi(n)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age %q (use e.g. 90d, 2w or 36h)", value)
+		}
+		age = time.Duration(days) * 24 * time.Hour
+	} else if n, ok := strings.CutSuffix(value, "w"); ok {
+		weeks, err := strconv.Atoi(n)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age %q (use e.g. 90d, 2w or 36h)", value)
+		}
+		age = time.Duration(weeks) * 7 * 24 * time.Hour
+	} else {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid age %q (use e.g. 90d, 2w or 36h)", value)
+		}
+		age = d
+	}
+
+	if age <= 0 {
+		return 0, fmt.Errorf("age must be positive, got %q", value)
+	}
+	return age, nil
+}
+
+// runPruneCommandWithConfig deletes failures older than opts.OlderThan and returns a summary
+func runPruneCommandWithConfig(dbPath string, opts PruneOptions) (string, error) {
+	if opts.OlderThan <= 0 {
+		return "", fmt.Errorf("older-than must be positive")
+	}
+
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	cutoff := now.Add(-opts.OlderThan).UTC()
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return "", fmt.Errorf("opening database: %w", err)
+	}
+	defer store.Close()
+
+	if !opts.DryRun {
+		deleted, err := store.DeleteOlderThan(cutoff)
+		if err != nil {
+			return "", fmt.Errorf("pruning failures: %w", err)
+		}
+		return fmt.Sprintf("Deleted %d failures created before %s\n", deleted, cutoff.Format(time.RFC3339)), nil
+	}
+
+	counts, err := store.CountOlderThan(cutoff)
+	if err != nil {
+		return "", fmt.Errorf("counting failures: %w", err)
+	}
+
+	categories := make([]string, 0, len(counts))
+	total := 0
+	for category, count := range counts {
+		categories = append(categories, category)
+		total += count
+	}
+	sort.Strings(categories)
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Dry run: would delete %d failures created before %s\n", total, cutoff.Format(time.RFC3339)))
+	for _, category := range categories {
+		sb.WriteString(fmt.Sprintf("  %-24s %d\n", category, counts[category]))
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// seedPruneStore creates a failures database with failures of different ages relative to now
+func seedPruneStore(t *testing.T, now time.Time) string {
+	t.Helper()
+	dbPath := filepath.Join(t.TempDir(), "failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	for _, r := range []struct {
+		category string
+		days     int
+	}{
+		{"missing-tests", 120},
+		{"scope-creep", 100},
+		{"missing-tests", 5},
+	} {
+		f := failures.Failure{TaskID: "task-1", Category: r.category, Details: "d", Source: "spec-review", CreatedAt: now.AddDate(0, 0, -r.days)}
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+	return dbPath
+}
+
+func TestParseAge(t *testing.T) {
+	tests := map[string]time.Duration{
+		"90d": 90 * 24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+		"36h": 36 * time.Hour,
+	}
+	for value, want := range tests {
+		got, err := parseAge(value)
+		if err != nil || got != want {
+			t.Errorf("parseAge(%q) = %v, %v; want %v", value, got, err, want)
+		}
+	}
+
+	for _, value := range []string{"", "d", "ninety days", "0d", "-5d"} {
+		if _, err := parseAge(value); err == nil {
+			t.Errorf("Expected error for %q", value)
+		}
+	}
+}
+
+// TestRunPruneCommandDryRun verifies a dry run reports counts without deleting
+func TestRunPruneCommandDryRun(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	dbPath := seedPruneStore(t, now)
+
+	output, err := runPruneCommandWithConfig(dbPath, PruneOptions{OlderThan: 90 * 24 * time.Hour, DryRun: true, Now: now})
+	if err != nil {
+		t.Fatalf("runPruneCommandWithConfig failed: %v", err)
+	}
+	for _, want := range []string{"would delete 2 failures", "missing-tests", "scope-creep"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	defer store.Close()
+	remaining, err := store.GetRecent(time.Time{})
+	if err != nil {
+		t.Fatalf("GetRecent failed: %v", err)
+	}
+	if len(remaining) != 3 {
+		t.Errorf("Expected dry run to keep all 3 failures, got %d", len(remaining))
+	}
+}
+
+// TestRunPruneCommandDeletes verifies old failures are removed
+func TestRunPruneCommandDeletes(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	dbPath := seedPruneStore(t, now)
+
+	output, err := runPruneCommandWithConfig(dbPath, PruneOptions{OlderThan: 90 * 24 * time.Hour, Now: now})
+	if err != nil {
+		t.Fatalf("runPruneCommandWithConfig failed: %v", err)
+	}
+	if !strings.Contains(output, "Deleted 2 failures created before 2026-03-03T12:00:00Z") {
+		t.Errorf("Unexpected output: %s", output)
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	defer store.Close()
+	remaining, err := store.GetRecent(time.Time{})
+	if err != nil {
+		t.Fatalf("GetRecent failed: %v", err)
+	}
+	if len(remaining) != 1 || remaining[0].Category != "missing-tests" {
+		t.Errorf("Expected only the recent failure to remain, got %+v", remaining)
+	}
+}
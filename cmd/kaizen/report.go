@@ -2,15 +2,64 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
 	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/textutil"
 )
 
+// reportSchemaVersion is the "schema_version" of every JSON report output, so consumers can
+// branch on format changes. Bump the minor version when fields are added and the major version
+// when fields are removed or change meaning, and record the change in the README's report section.
+const reportSchemaVersion = "1.3"
+
+// defaultGradeReportPattern is the default filename pattern for grade-skills reports
+const defaultGradeReportPattern = "skill-clarity-{date}.md"
+
+// gradeReportDatePlaceholder is replaced with the report date (YYYY-MM-DD)
+const gradeReportDatePlaceholder = "{date}"
+
+// validateGradeReportPattern checks that a report filename pattern contains
+// exactly one {date} placeholder and no path separators
+func validateGradeReportPattern(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf("report filename pattern cannot be empty")
+	}
+	if count := strings.Count(pattern, gradeReportDatePlaceholder); count != 1 {
+		return fmt.Errorf("report filename pattern %q must contain exactly one %s placeholder", pattern, gradeReportDatePlaceholder)
+	}
+	if strings.ContainsAny(pattern, `/\`) {
+		return fmt.Errorf("report filename pattern %q must not contain path separators", pattern)
+	}
+	return nil
+}
+
+// gradeReportFilename returns the report filename for the given pattern and date
+func gradeReportFilename(pattern string, date time.Time) (string, error) {
+	if err := validateGradeReportPattern(pattern); err != nil {
+		return "", err
+	}
+	return strings.Replace(pattern, gradeReportDatePlaceholder, date.Format("2006-01-02"), 1), nil
+}
+
+// gradeReportRegexp derives the regex matching report filenames generated from pattern
+func gradeReportRegexp(pattern string) (*regexp.Regexp, error) {
+	if err := validateGradeReportPattern(pattern); err != nil {
+		return nil, err
+	}
+	parts := strings.SplitN(pattern, gradeReportDatePlaceholder, 2)
+	return regexp.Compile("^" + regexp.QuoteMeta(parts[0]) + `(\d{4}-\d{2}-\d{2})` + regexp.QuoteMeta(parts[1]) + "$")
+}
+
 // CriteriaScore represents the average score for a specific criteria across all skills
 type CriteriaScore struct {
 	Name    string
@@ -26,18 +75,42 @@ type GradeReport struct {
 	PassRate         float64
 	PassingThreshold float64
 	CriteriaScores   []CriteriaScore
+	Skills           []GradeReportSkill
+}
+
+// GradeReportSkill is one skill's result parsed from a skill-clarity report
+type GradeReportSkill struct {
+	Name   string
+	Score  float64
+	Passed bool
+	// Criteria maps criterion display names (e.g. "Clear Instructions") to the skill's score
+	Criteria map[string]float64
 }
 
 // findGradeReports finds all skill-clarity-*.md reports in the given directory
 // Returns reports sorted by date (newest first)
 func findGradeReports(reportsDir string) ([]string, error) {
+	return findGradeReportsWithPattern(reportsDir, defaultGradeReportPattern)
+}
+
+// findGradeReportsWithPattern finds all reports matching the filename pattern in the given directory
+// Returns reports sorted by date (newest first)
+func findGradeReportsWithPattern(reportsDir, filenamePattern string) ([]string, error) {
+	pattern, err := gradeReportRegexp(filenamePattern)
+	if err != nil {
+		return nil, err
+	}
+
 	entries, err := os.ReadDir(reportsDir)
 	if err != nil {
+		// A fresh setup has no reports directory yet, which just means no reports
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
 		return nil, fmt.Errorf("reading reports directory: %w", err)
 	}
 
 	var reports []string
-	pattern := regexp.MustCompile(`^skill-clarity-\d{4}-\d{2}-\d{2}\.md$`)
 
 	for _, entry := range entries {
 		if entry.IsDir() {
@@ -68,7 +141,7 @@ func parseGradeReport(reportPath string) (GradeReport, error) {
 		FilePath: reportPath,
 	}
 
-	lines := strings.Split(string(content), "\n")
+	lines := strings.Split(textutil.NormalizeLineEndings(string(content)), "\n")
 
 	// Regex patterns to extract metrics
 	generatedPattern := regexp.MustCompile(`Generated:\s*(.+)`)
@@ -114,6 +187,7 @@ func parseGradeReport(reportPath string) (GradeReport, error) {
 
 	// Extract per-criteria scores from Detailed Breakdown section
 	report.CriteriaScores = extractCriteriaScores(lines)
+	report.Skills = extractSkillResults(lines)
 
 	return report, nil
 }
@@ -130,6 +204,8 @@ func extractCriteriaScores(lines []string) []CriteriaScore {
 	inDetailedBreakdown := false
 
 	for _, line := range lines {
+		line = strings.TrimSuffix(line, "\r")
+
 		// Check if we're in the Detailed Breakdown section
 		if strings.Contains(line, "## Detailed Breakdown") {
 			inDetailedBreakdown = true
@@ -186,8 +262,97 @@ func extractCriteriaScores(lines []string) []CriteriaScore {
 	return result
 }
 
-// formatReportSummaryMarkdown formats a GradeReport as markdown
-func formatReportSummaryMarkdown(report GradeReport, trends *GradeTrends, enableTrends bool) string {
+// extractSkillResults parses each skill's score and status from the Skills by Score table and
+// its criteria scores from the Detailed Breakdown section, in ranked order
+func extractSkillResults(lines []string) []GradeReportSkill {
+	// Ranked rows look like: | 1 | skill-name | 82.5 | ✅ Pass |
+	rowPattern := regexp.MustCompile(`^\|\s*\d+\s*\|\s*([^|]+?)\s*\|\s*([\d.]+)\s*\|\s*([^|]+?)\s*\|$`)
+	criteriaPattern := regexp.MustCompile(`^\s*-\s*\*\*([^*]+)\*\*\s*\(weight:[^)]+\):\s*([\d.]+)/100`)
+
+	var skills []GradeReportSkill
+	index := make(map[string]int)
+	section := ""
+	current := -1
+
+	for _, line := range lines {
+		if strings.HasPrefix(line, "## ") {
+			section = strings.TrimSpace(strings.TrimPrefix(line, "## "))
+			current = -1
+			continue
+		}
+
+		switch section {
+		case "Skills by Score":
+			matches := rowPattern.FindStringSubmatch(line)
+			if matches == nil {
+				continue
+			}
+			score, err := strconv.ParseFloat(matches[2], 64)
+			if err != nil {
+				continue
+			}
+			index[matches[1]] = len(skills)
+			skills = append(skills, GradeReportSkill{
+				Name:     matches[1],
+				Score:    score,
+				Passed:   !strings.Contains(matches[3], "Fail"),
+				Criteria: make(map[string]float64),
+			})
+
+		case "Detailed Breakdown":
+			if strings.HasPrefix(line, "### ") {
+				current = -1
+				if i, ok := index[strings.TrimSpace(strings.TrimPrefix(line, "### "))]; ok {
+					current = i
+				}
+				continue
+			}
+			if current < 0 {
+				continue
+			}
+			if matches := criteriaPattern.FindStringSubmatch(line); matches != nil {
+				if score, err := strconv.ParseFloat(matches[2], 64); err == nil {
+					skills[current].Criteria[strings.TrimSpace(matches[1])] = score
+				}
+			}
+		}
+	}
+
+	return skills
+}
+
+// parseSkillBreakdownScores extracts each skill's Overall Score from a markdown report's Detailed Breakdown
+func parseSkillBreakdownScores(content string) map[string]float64 {
+	overallPattern := regexp.MustCompile(`^\*\*Overall Score\*\*:\s*([\d.]+)/100`)
+
+	scores := make(map[string]float64)
+	inBreakdown := false
+	skill := ""
+	for _, line := range strings.Split(textutil.NormalizeLineEndings(content), "\n") {
+		if strings.HasPrefix(line, "## ") {
+			inBreakdown = strings.TrimSpace(strings.TrimPrefix(line, "## ")) == "Detailed Breakdown"
+			skill = ""
+			continue
+		}
+		if !inBreakdown {
+			continue
+		}
+		if strings.HasPrefix(line, "### ") {
+			skill = strings.TrimSpace(strings.TrimPrefix(line, "### "))
+			continue
+		}
+		if matches := overallPattern.FindStringSubmatch(line); matches != nil && skill != "" {
+			if score, err := strconv.ParseFloat(matches[1], 64); err == nil {
+				scores[skill] = score
+			}
+		}
+	}
+	return scores
+}
+
+// formatReportSummaryMarkdown formats a GradeReport as markdown, flagging trends that regress
+// beyond threshold percent
+func formatReportSummaryMarkdown(report GradeReport, trends *GradeTrends, enableTrends bool, threshold float64) string {
 	var sb strings.Builder
 
 	sb.WriteString("# Evaluation Report Summary\n\n")
@@ -207,9 +372,6 @@ func formatReportSummaryMarkdown(report GradeReport, trends *GradeTrends, enable
 		sb.WriteString("| Metric | Previous | Current | Change | Status |\n")
 		sb.WriteString("|--------|----------|---------|--------|--------|\n")
 
-		// Threshold for warnings (5% regression)
-		threshold := 5.0
-
 		// Average Score trend
 		exceeds := exceedsRegressionThreshold(trends.AverageScore, threshold)
 		sb.WriteString(formatTrendMarkdown("Average Score", trends.AverageScore, exceeds))
@@ -263,8 +425,70 @@ func formatReportSummaryMarkdown(report GradeReport, trends *GradeTrends, enable
 	return sb.String()
 }
 
-// formatReportSummaryJSON formats a GradeReport as JSON
-func formatReportSummaryJSON(report GradeReport, trends *GradeTrends, enableTrends bool) (string, error) {
+// sparklineBars are the bar heights used by sparkline, lowest first
+var sparklineBars = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders values as bars scaled between their minimum and maximum.
+// A flat series renders as mid-height bars.
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	lowest, highest := values[0], values[0]
+	for _, v := range values {
+		lowest = math.Min(lowest, v)
+		highest = math.Max(highest, v)
+	}
+
+	bars := make([]rune, len(values))
+	for i, v := range values {
+		level := len(sparklineBars) / 2
+		if highest > lowest {
+			level = int(math.Round((v - lowest) / (highest - lowest) * float64(len(sparklineBars)-1)))
+		}
+		bars[i] = sparklineBars[level]
+	}
+	return string(bars)
+}
+
+// formatGradeHistoryMarkdown formats the average score of each report in history (oldest first)
+// as a sparkline and a table
+func formatGradeHistoryMarkdown(history []GradeReport) string {
+	if len(history) < 2 {
+		return ""
+	}
+
+	scores := make([]float64, len(history))
+	for i, report := range history {
+		scores[i] = report.AverageScore
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n## Score History\n\n")
+	sb.WriteString(fmt.Sprintf("Average score over the last %d reports (oldest to newest): `%s`\n\n", len(history), sparkline(scores)))
+	sb.WriteString("| Report | Generated | Average Score | Pass Rate |\n")
+	sb.WriteString("|--------|-----------|---------------|-----------|\n")
+	for _, report := range history {
+		sb.WriteString(fmt.Sprintf("| %s | %s | %.1f | %.1f%% |\n",
+			filepath.Base(report.FilePath), report.GeneratedDate, report.AverageScore, report.PassRate))
+	}
+
+	return sb.String()
+}
+
+// criteriaRadar maps each criterion to its average score normalized to 0-1, the shape a radar
+// chart of criteria balance expects
+func criteriaRadar(criteriaScores []CriteriaScore) map[string]float64 {
+	radar := make(map[string]float64, len(criteriaScores))
+	for _, criteria := range criteriaScores {
+		radar[criteria.Name] = math.Max(0, math.Min(1, criteria.Average/100))
+	}
+	return radar
+}
+
+// formatReportSummaryJSON formats a GradeReport as JSON, listing warnings when non-nil
+func formatReportSummaryJSON(report GradeReport, trends *GradeTrends, enableTrends bool, warnings *Warnings) (string, error) {
 	// Convert CriteriaScores to JSON-friendly format
 	criteriaScores := make([]map[string]interface{}, 0, len(report.CriteriaScores))
 	for _, criteria := range report.CriteriaScores {
@@ -275,7 +499,9 @@ func formatReportSummaryJSON(report GradeReport, trends *GradeTrends, enableTren
 	}
 
 	data := map[string]interface{}{
+		"schema_version":    reportSchemaVersion,
 		"file_path":         report.FilePath,
+		"radar":             criteriaRadar(report.CriteriaScores),
 		"generated_date":    report.GeneratedDate,
 		"total_skills":      report.TotalSkills,
 		"average_score":     report.AverageScore,
@@ -304,6 +530,8 @@ func formatReportSummaryJSON(report GradeReport, trends *GradeTrends, enableTren
 		data["trend"] = trendData
 	}
 
+	addWarnings(data, warnings)
+
 	jsonBytes, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("marshaling to JSON: %w", err)
@@ -314,11 +542,16 @@ func formatReportSummaryJSON(report GradeReport, trends *GradeTrends, enableTren
 
 // listGradeReports lists all available grade reports
 func listGradeReports(reportsDir string) string {
+	return listGradeReportsWithPattern(reportsDir, defaultGradeReportPattern)
+}
+
+// listGradeReportsWithPattern lists all grade reports matching the filename pattern
+func listGradeReportsWithPattern(reportsDir, filenamePattern string) string {
 	var sb strings.Builder
 
 	sb.WriteString("# Grade Reports\n\n")
 
-	reports, err := findGradeReports(reportsDir)
+	reports, err := findGradeReportsWithPattern(reportsDir, filenamePattern)
 	if err != nil {
 		sb.WriteString(fmt.Sprintf("Error finding reports: %v\n", err))
 		return sb.String()
@@ -338,6 +571,48 @@ func listGradeReports(reportsDir string) string {
 	return sb.String()
 }
 
+// GradeReportListEntry is a discovered grade report in the JSON report list
+type GradeReportListEntry struct {
+	File string `json:"file"`
+	Date string `json:"date"`
+}
+
+// listGradeReportsJSON lists all grade reports matching the filename pattern as JSON,
+// with each report's date parsed from its filename
+func listGradeReportsJSON(reportsDir, filenamePattern string) (string, error) {
+	pattern, err := gradeReportRegexp(filenamePattern)
+	if err != nil {
+		return "", err
+	}
+
+	reports, err := findGradeReportsWithPattern(reportsDir, filenamePattern)
+	if err != nil {
+		return "", fmt.Errorf("finding grade reports: %w", err)
+	}
+
+	entries := make([]GradeReportListEntry, 0, len(reports))
+	for _, reportPath := range reports {
+		file := filepath.Base(reportPath)
+		entry := GradeReportListEntry{File: file}
+		if match := pattern.FindStringSubmatch(file); match != nil {
+			entry.Date = match[1]
+		}
+		entries = append(entries, entry)
+	}
+
+	data := map[string]interface{}{
+		"schema_version": reportSchemaVersion,
+		"reports":        entries,
+	}
+
+	jsonBytes, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling to JSON: %w", err)
+	}
+
+	return string(jsonBytes), nil
+}
+
 // formatMetaReportMarkdown formats meta-evaluation results as markdown
 func formatMetaReportMarkdown(results []ConsistencyResult, trends *MetaTrends, enableTrends bool) string {
 	var sb strings.Builder
@@ -352,17 +627,18 @@ func formatMetaReportMarkdown(results []ConsistencyResult, trends *MetaTrends, e
 		sb.WriteString("\n")
 	}
 
-	// Group results by agent to get the latest for each
+	// Group results by agent and boundary level to get the latest for each
+	agentKey := metaAgentKeyFunc(results)
 	latestByAgent := make(map[string]ConsistencyResult)
 	for _, result := range results {
 		// Keep the last occurrence for each agent
-		latestByAgent[result.Agent] = result
+		latestByAgent[agentKey(result)] = result
 	}
 
 	// Display current metrics
 	sb.WriteString("## Current Metrics\n\n")
-	sb.WriteString("| Agent | Consistency | Runs |\n")
-	sb.WriteString("|-------|-------------|------|\n")
+	sb.WriteString("| Agent | Boundary | Consistency | Runs |\n")
+	sb.WriteString("|-------|----------|-------------|------|\n")
 
 	// Sort agents for consistent output
 	var agents []string
@@ -373,8 +649,13 @@ func formatMetaReportMarkdown(results []ConsistencyResult, trends *MetaTrends, e
 
 	for _, agent := range agents {
 		result := latestByAgent[agent]
-		sb.WriteString(fmt.Sprintf("| %s | %.1f%% | %d |\n",
+		boundaryType := result.BoundaryType
+		if boundaryType == "" {
+			boundaryType = "-"
+		}
+		sb.WriteString(fmt.Sprintf("| %s | %s | %.1f%% | %d |\n",
 			result.Agent,
+			boundaryType,
 			result.ConsistencyPercentage,
 			result.TotalCount))
 	}
@@ -418,33 +699,39 @@ func formatMetaReportMarkdown(results []ConsistencyResult, trends *MetaTrends, e
 	return sb.String()
 }
 
-// formatMetaReportJSON formats meta-evaluation results as JSON
-func formatMetaReportJSON(results []ConsistencyResult, trends *MetaTrends, enableTrends bool) (string, error) {
-	// Group results by agent to get the latest for each
+// formatMetaReportJSON formats meta-evaluation results as JSON, listing warnings when non-nil
+func formatMetaReportJSON(results []ConsistencyResult, trends *MetaTrends, enableTrends bool, warnings *Warnings) (string, error) {
+	// Group results by agent and boundary level to get the latest for each
+	agentKey := metaAgentKeyFunc(results)
 	latestByAgent := make(map[string]ConsistencyResult)
 	for _, result := range results {
-		latestByAgent[result.Agent] = result
+		latestByAgent[agentKey(result)] = result
+	}
+
+	// Sort agents for consistent output
+	keys := make([]string, 0, len(latestByAgent))
+	for key := range latestByAgent {
+		keys = append(keys, key)
 	}
+	sort.Strings(keys)
 
 	// Create agents array
 	var agents []map[string]interface{}
-	for agent, result := range latestByAgent {
+	for _, key := range keys {
+		result := latestByAgent[key]
 		agents = append(agents, map[string]interface{}{
-			"agent":                 agent,
+			"agent":                 result.Agent,
+			"boundary_type":         result.BoundaryType,
 			"consistency_percentage": result.ConsistencyPercentage,
 			"total_count":           result.TotalCount,
 			"timestamp":             result.Timestamp,
 		})
 	}
 
-	// Sort agents for consistent output
-	sort.Slice(agents, func(i, j int) bool {
-		return agents[i]["agent"].(string) < agents[j]["agent"].(string)
-	})
-
 	data := map[string]interface{}{
-		"report_type": "meta",
-		"agents":      agents,
+		"schema_version": reportSchemaVersion,
+		"report_type":    "meta",
+		"agents":         agents,
 	}
 
 	// Get timestamp from latest result
@@ -460,6 +747,8 @@ func formatMetaReportJSON(results []ConsistencyResult, trends *MetaTrends, enabl
 		data["trend"] = trendData
 	}
 
+	addWarnings(data, warnings)
+
 	jsonBytes, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("marshaling to JSON: %w", err)
@@ -468,8 +757,9 @@ func formatMetaReportJSON(results []ConsistencyResult, trends *MetaTrends, enabl
 	return string(jsonBytes), nil
 }
 
-// formatEvalReportMarkdown formats eval results as markdown
-func formatEvalReportMarkdown(results []GradeTaskOutput, trends *EvalTrends, enableTrends bool) string {
+// formatEvalReportMarkdown formats eval results as markdown, flagging trends that regress beyond
+// threshold percent
+func formatEvalReportMarkdown(results []GradeTaskOutput, trends *EvalTrends, enableTrends bool, threshold float64) string {
 	var sb strings.Builder
 
 	sb.WriteString("# Evaluation Report\n\n")
@@ -490,31 +780,9 @@ func formatEvalReportMarkdown(results []GradeTaskOutput, trends *EvalTrends, ena
 	}
 
 	// Calculate current metrics (only from latest timestamp)
-	var latestResults []GradeTaskOutput
-	for _, result := range results {
-		if result.Timestamp == latestTimestamp {
-			latestResults = append(latestResults, result)
-		}
-	}
-
-	totalScore := 0.0
-	passedCount := 0
-	for _, result := range latestResults {
-		totalScore += result.OverallScore
-		if result.OverallPassed {
-			passedCount++
-		}
-	}
+	latestResults := latestEvalResults(results)
 
-	avgScore := 0.0
-	if len(latestResults) > 0 {
-		avgScore = totalScore / float64(len(latestResults))
-	}
-
-	passRate := 0.0
-	if len(latestResults) > 0 {
-		passRate = (float64(passedCount) / float64(len(latestResults))) * 100.0
-	}
+	avgScore, passRate, passedCount := summarizeEvalResults(latestResults)
 
 	// Display current metrics
 	sb.WriteString("## Current Metrics\n\n")
@@ -525,14 +793,17 @@ func formatEvalReportMarkdown(results []GradeTaskOutput, trends *EvalTrends, ena
 	if enableTrends && trends != nil {
 		sb.WriteString("\n## Trend Analysis\n\n")
 
+		// Scores from different grader logic are not directly comparable
+		if len(trends.GraderVersionChanges) > 0 {
+			sb.WriteString(fmt.Sprintf("> ⚠ **Warning**: Grader versions differ between compared runs: %s. Changes may reflect grader logic rather than agent behavior.\n\n",
+				strings.Join(trends.GraderVersionChanges, ", ")))
+		}
+
 		// Aggregate metrics trends
 		sb.WriteString("### Aggregate Metrics\n\n")
 		sb.WriteString("| Metric | Previous | Current | Change | Status |\n")
 		sb.WriteString("|--------|----------|---------|--------|--------|\n")
 
-		// Threshold for warnings (5% regression)
-		threshold := 5.0
-
 		// Average Score trend
 		exceeds := exceedsRegressionThreshold(trends.AverageScore, threshold)
 		sb.WriteString(formatTrendMarkdown("Average Score", trends.AverageScore, exceeds))
@@ -568,9 +839,133 @@ func formatEvalReportMarkdown(results []GradeTaskOutput, trends *EvalTrends, ena
 	return sb.String()
 }
 
-// formatEvalReportJSON formats eval results as JSON
-func formatEvalReportJSON(results []GradeTaskOutput, trends *EvalTrends, enableTrends bool) (string, error) {
-	// Find the latest timestamp
+// filterEvalResultsByTag returns the eval results carrying the given tag
+func filterEvalResultsByTag(results []GradeTaskOutput, tag string) []GradeTaskOutput {
+	var filtered []GradeTaskOutput
+	for _, result := range results {
+		for _, t := range result.Tags {
+			if t == tag {
+				filtered = append(filtered, result)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// errNoResultsInRange is returned when a --since/--until range excludes every log entry
+var errNoResultsInRange = errors.New("no results in range")
+
+// parseReportDate parses a --since/--until value as RFC3339 or YYYY-MM-DD.
+// A bare date used as an upper bound covers the whole day.
+func parseReportDate(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q (use RFC3339 or YYYY-MM-DD)", value)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Nanosecond)
+	}
+	return t, nil
+}
+
+// parseReportDateRange parses the --since and --until flags; empty values leave that side unbounded
+func parseReportDateRange(since, until string) (time.Time, time.Time, error) {
+	var sinceTime, untilTime time.Time
+	var err error
+	if since != "" {
+		if sinceTime, err = parseReportDate(since, false); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("--since: %w", err)
+		}
+	}
+	if until != "" {
+		if untilTime, err = parseReportDate(until, true); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("--until: %w", err)
+		}
+	}
+	if !sinceTime.IsZero() && !untilTime.IsZero() && untilTime.Before(sinceTime) {
+		return time.Time{}, time.Time{}, fmt.Errorf("--until %s is before --since %s", until, since)
+	}
+	return sinceTime, untilTime, nil
+}
+
+// inDateRange reports whether an RFC3339 timestamp falls within [since, until].
+// Zero bounds are open; unparseable timestamps are outside any bounded range.
+func inDateRange(timestamp string, since, until time.Time) bool {
+	if since.IsZero() && until.IsZero() {
+		return true
+	}
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return false
+	}
+	if !since.IsZero() && t.Before(since) {
+		return false
+	}
+	if !until.IsZero() && t.After(until) {
+		return false
+	}
+	return true
+}
+
+// describeDateRange formats a date range for messages
+func describeDateRange(since, until time.Time) string {
+	from, to := "beginning", "now"
+	if !since.IsZero() {
+		from = since.Format(time.RFC3339)
+	}
+	if !until.IsZero() {
+		to = until.Format(time.RFC3339)
+	}
+	return from + " to " + to
+}
+
+// filterEvalResultsByDate returns the eval results whose timestamp falls within [since, until]
+func filterEvalResultsByDate(results []GradeTaskOutput, since, until time.Time) []GradeTaskOutput {
+	var filtered []GradeTaskOutput
+	for _, result := range results {
+		if inDateRange(result.Timestamp, since, until) {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
+
+// filterMetaResultsByDate returns the meta results whose timestamp falls within [since, until]
+func filterMetaResultsByDate(results []ConsistencyResult, since, until time.Time) []ConsistencyResult {
+	var filtered []ConsistencyResult
+	for _, result := range results {
+		if inDateRange(result.Timestamp, since, until) {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
+
+// summarizeEvalResults computes the average score, pass rate and passed count for eval results
+func summarizeEvalResults(results []GradeTaskOutput) (avgScore, passRate float64, passedCount int) {
+	if len(results) == 0 {
+		return 0.0, 0.0, 0
+	}
+
+	totalScore := 0.0
+	for _, result := range results {
+		totalScore += result.OverallScore
+		if result.OverallPassed {
+			passedCount++
+		}
+	}
+
+	avgScore = totalScore / float64(len(results))
+	passRate = (float64(passedCount) / float64(len(results))) * 100.0
+	return avgScore, passRate, passedCount
+}
+
+// latestEvalResults returns the results from the latest timestamp bucket
+func latestEvalResults(results []GradeTaskOutput) []GradeTaskOutput {
 	latestTimestamp := ""
 	for _, result := range results {
 		if result.Timestamp > latestTimestamp {
@@ -578,39 +973,81 @@ func formatEvalReportJSON(results []GradeTaskOutput, trends *EvalTrends, enableT
 		}
 	}
 
-	// Calculate current metrics (only from latest timestamp)
 	var latestResults []GradeTaskOutput
 	for _, result := range results {
 		if result.Timestamp == latestTimestamp {
 			latestResults = append(latestResults, result)
 		}
 	}
+	return latestResults
+}
 
-	totalScore := 0.0
-	passedCount := 0
-	for _, result := range latestResults {
-		totalScore += result.OverallScore
-		if result.OverallPassed {
-			passedCount++
+// formatWorstTasksMarkdown formats the n lowest-scoring tasks from the latest bucket as a markdown table
+func formatWorstTasksMarkdown(results []GradeTaskOutput, n int) string {
+	worst := worstEvalResults(latestEvalResults(results), n)
+	if len(worst) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString("\n## Lowest Scoring Tasks\n\n")
+	sb.WriteString("| Rank | Task | Score | Status |\n")
+	sb.WriteString("|------|------|-------|--------|\n")
+	for i, result := range worst {
+		status := "✓ PASS"
+		if !result.OverallPassed {
+			status = "✗ FAIL"
 		}
+		sb.WriteString(fmt.Sprintf("| %d | %s | %.1f | %s |\n", i+1, result.TaskID, result.OverallScore, status))
+	}
+
+	return sb.String()
+}
+
+// worstEvalResults returns up to n results with the lowest overall score, lowest first
+func worstEvalResults(results []GradeTaskOutput, n int) []GradeTaskOutput {
+	if n <= 0 || len(results) == 0 {
+		return nil
+	}
+
+	worst := make([]GradeTaskOutput, len(results))
+	copy(worst, results)
+	sort.SliceStable(worst, func(i, j int) bool {
+		return worst[i].OverallScore < worst[j].OverallScore
+	})
+	if len(worst) > n {
+		worst = worst[:n]
 	}
+	return worst
+}
 
-	avgScore := 0.0
-	if len(latestResults) > 0 {
-		avgScore = totalScore / float64(len(latestResults))
+// formatEvalReportJSON formats eval results as JSON, listing warnings when non-nil
+func formatEvalReportJSON(results []GradeTaskOutput, trends *EvalTrends, enableTrends bool, warnings *Warnings) (string, error) {
+	// Find the latest timestamp
+	latestTimestamp := ""
+	for _, result := range results {
+		if result.Timestamp > latestTimestamp {
+			latestTimestamp = result.Timestamp
+		}
 	}
 
-	passRate := 0.0
-	if len(latestResults) > 0 {
-		passRate = (float64(passedCount) / float64(len(latestResults))) * 100.0
+	// Calculate current metrics (only from latest timestamp)
+	var latestResults []GradeTaskOutput
+	for _, result := range results {
+		if result.Timestamp == latestTimestamp {
+			latestResults = append(latestResults, result)
+		}
 	}
 
+	avgScore, passRate, passedCount := summarizeEvalResults(latestResults)
+
 	data := map[string]interface{}{
-		"report_type":   "eval",
-		"average_score": avgScore,
-		"pass_rate":     passRate,
-		"total_tasks":   len(latestResults),
-		"passed_tasks":  passedCount,
+		"schema_version": reportSchemaVersion,
+		"report_type":    "eval",
+		"average_score":  avgScore,
+		"pass_rate":      passRate,
+		"total_tasks":    len(latestResults),
+		"passed_tasks":   passedCount,
 	}
 
 	// Get timestamp from latest result
@@ -630,9 +1067,15 @@ func formatEvalReportJSON(results []GradeTaskOutput, trends *EvalTrends, enableT
 			trendData["per_task"] = trends.PerTaskTrends
 		}
 
+		if len(trends.GraderVersionChanges) > 0 {
+			trendData["grader_version_changes"] = trends.GraderVersionChanges
+		}
+
 		data["trend"] = trendData
 	}
 
+	addWarnings(data, warnings)
+
 	jsonBytes, err := json.MarshalIndent(data, "", "  ")
 	if err != nil {
 		return "", fmt.Errorf("marshaling to JSON: %w", err)
@@ -641,11 +1084,304 @@ func formatEvalReportJSON(results []GradeTaskOutput, trends *EvalTrends, enableT
 	return string(jsonBytes), nil
 }
 
+// loadGradeReportData parses the latest grade report and, if enabled, its trends.
+// Trends that cannot be loaded are disabled and recorded in warnings.
+func loadGradeReportData(reportsDir, gradePattern string, enableTrends bool, warnings *Warnings) (GradeReport, *GradeTrends, error) {
+	reports, err := findGradeReportsWithPattern(reportsDir, gradePattern)
+	if err != nil {
+		return GradeReport{}, nil, fmt.Errorf("finding grade reports: %w", err)
+	}
+
+	if len(reports) == 0 {
+		return GradeReport{}, nil, fmt.Errorf("no grade reports found in %s", reportsDir)
+	}
+
+	// Parse the latest report
+	report, err := parseGradeReport(reports[0])
+	if err != nil {
+		return GradeReport{}, nil, fmt.Errorf("parsing report: %w", err)
+	}
+
+	// Load trend data if enabled
+	var trends *GradeTrends
+	if enableTrends {
+		trends, err = loadGradeTrendsWithPattern(reportsDir, gradePattern)
+		if err != nil {
+			// Don't fail if trends can't be loaded, just disable them
+			warnings.Addf("Could not load trend data: %v", err)
+			trends = nil
+		}
+	}
+
+	return report, trends, nil
+}
+
+// loadMetaReportData loads meta results from consistency-log.json, applies the date range
+// from opts, and if enabled computes their trends, recording a warning when they can't be
+func loadMetaReportData(reportsDir string, enableTrends bool, opts ReportOptions, warnings *Warnings) ([]ConsistencyResult, *MetaTrends, error) {
+	metaLogPath := filepath.Join(reportsDir, "consistency-log.json")
+	results, err := loadMetaResults(metaLogPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading meta results: %w", err)
+	}
+
+	if len(results) == 0 {
+		return nil, nil, fmt.Errorf("no meta results found in %s", metaLogPath)
+	}
+
+	if !opts.Since.IsZero() || !opts.Until.IsZero() {
+		results = filterMetaResultsByDate(results, opts.Since, opts.Until)
+		if len(results) == 0 {
+			return nil, nil, fmt.Errorf("%w %s in %s", errNoResultsInRange, describeDateRange(opts.Since, opts.Until), metaLogPath)
+		}
+	}
+
+	// Load trend data if enabled
+	var metaTrends *MetaTrends
+	if enableTrends {
+		metaTrends, err = calculateMetaTrends(results)
+		if err != nil {
+			// Don't fail if trends can't be loaded, just disable them
+			warnings.Addf("Could not load trend data: %v", err)
+			metaTrends = nil
+		}
+	}
+
+	return results, metaTrends, nil
+}
+
+// loadEvalReportData loads eval results from task-eval-log.json, applies the date range, tag filter
+// and pass threshold from opts, and if enabled computes their trends, recording a warning when they can't be
+func loadEvalReportData(reportsDir string, enableTrends bool, opts ReportOptions, warnings *Warnings) ([]GradeTaskOutput, *EvalTrends, error) {
+	evalLogPath := filepath.Join(reportsDir, "task-eval-log.json")
+	results, err := loadEvalResults(evalLogPath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading eval results: %w", err)
+	}
+
+	if len(results) == 0 {
+		return nil, nil, fmt.Errorf("no eval results found in %s", evalLogPath)
+	}
+
+	if !opts.Since.IsZero() || !opts.Until.IsZero() {
+		results = filterEvalResultsByDate(results, opts.Since, opts.Until)
+		if len(results) == 0 {
+			return nil, nil, fmt.Errorf("%w %s in %s", errNoResultsInRange, describeDateRange(opts.Since, opts.Until), evalLogPath)
+		}
+	}
+
+	if opts.Tag != "" {
+		results = filterEvalResultsByTag(results, opts.Tag)
+		if len(results) == 0 {
+			return nil, nil, fmt.Errorf("no eval results tagged %q found in %s", opts.Tag, evalLogPath)
+		}
+	}
+
+	if opts.RecomputePassed {
+		results = applyPassThreshold(results, opts.PassThreshold)
+	}
+
+	// Load trend data if enabled
+	var evalTrends *EvalTrends
+	if enableTrends {
+		evalTrends, err = calculateEvalTrends(results)
+		if err != nil {
+			// Don't fail if trends can't be loaded, just disable them
+			warnings.Addf("Could not load trend data: %v", err)
+			evalTrends = nil
+		}
+	}
+
+	return results, evalTrends, nil
+}
+
+// formatAllReportsJSON combines the grade, meta and eval JSON reports into one document.
+// Sections without data are omitted; each section's trend is moved under "trends" and the
+// schema version is reported once at the top level.
+func formatAllReportsJSON(reportsDir, gradePattern string, enableTrends bool, opts ReportOptions) (string, error) {
+	output, _, err := formatAllReportsJSONWithTrends(reportsDir, gradePattern, enableTrends, opts, nil)
+	return output, err
+}
+
+// formatAllReportsJSONWithTrends is formatAllReportsJSON that also returns the trends of each
+// section, recording trends that cannot be loaded in warnings
+func formatAllReportsJSONWithTrends(reportsDir, gradePattern string, enableTrends bool, opts ReportOptions, warnings *Warnings) (string, reportTrends, error) {
+	var collected reportTrends
+	combined := make(map[string]interface{})
+	trends := make(map[string]interface{})
+
+	addSection := func(name, sectionJSON string) error {
+		var section map[string]interface{}
+		if err := json.Unmarshal([]byte(sectionJSON), &section); err != nil {
+			return fmt.Errorf("decoding %s report: %w", name, err)
+		}
+		if trend, ok := section["trend"]; ok {
+			trends[name] = trend
+			delete(section, "trend")
+		}
+		delete(section, "schema_version")
+		combined[name] = section
+		return nil
+	}
+
+	gradeReports, err := findGradeReportsWithPattern(reportsDir, gradePattern)
+	if err != nil {
+		return "", reportTrends{}, fmt.Errorf("finding grade reports: %w", err)
+	}
+	if len(gradeReports) > 0 {
+		report, gradeTrends, err := loadGradeReportData(reportsDir, gradePattern, enableTrends, warnings)
+		if err != nil {
+			return "", reportTrends{}, err
+		}
+		collected.grade = gradeTrends
+		sectionJSON, err := formatReportSummaryJSON(report, gradeTrends, enableTrends, nil)
+		if err != nil {
+			return "", reportTrends{}, fmt.Errorf("formatting as JSON: %w", err)
+		}
+		if err := addSection("grade", sectionJSON); err != nil {
+			return "", reportTrends{}, err
+		}
+	}
+
+	// Sections whose entries all fall outside the date range are omitted like empty logs
+	if hasLogEntries(filepath.Join(reportsDir, "consistency-log.json")) {
+		results, metaTrends, err := loadMetaReportData(reportsDir, enableTrends, opts, warnings)
+		if err != nil && !errors.Is(err, errNoResultsInRange) {
+			return "", reportTrends{}, err
+		}
+		if err == nil {
+			collected.meta = metaTrends
+			sectionJSON, err := formatMetaReportJSON(results, metaTrends, enableTrends, nil)
+			if err != nil {
+				return "", reportTrends{}, fmt.Errorf("formatting as JSON: %w", err)
+			}
+			if err := addSection("meta", sectionJSON); err != nil {
+				return "", reportTrends{}, err
+			}
+		}
+	}
+
+	if hasLogEntries(filepath.Join(reportsDir, "task-eval-log.json")) {
+		results, evalTrends, err := loadEvalReportData(reportsDir, enableTrends, opts, warnings)
+		if err != nil && !errors.Is(err, errNoResultsInRange) {
+			return "", reportTrends{}, err
+		}
+		if err == nil {
+			collected.eval = evalTrends
+			sectionJSON, err := formatEvalReportJSON(results, evalTrends, enableTrends, nil)
+			if err != nil {
+				return "", reportTrends{}, fmt.Errorf("formatting as JSON: %w", err)
+			}
+			if err := addSection("eval", sectionJSON); err != nil {
+				return "", reportTrends{}, err
+			}
+		}
+	}
+
+	if len(combined) == 0 {
+		return "", reportTrends{}, fmt.Errorf("no report data found in %s", reportsDir)
+	}
+	if len(trends) > 0 {
+		combined["trends"] = trends
+	}
+	combined["schema_version"] = reportSchemaVersion
+	addWarnings(combined, warnings)
+
+	jsonBytes, err := json.MarshalIndent(combined, "", "  ")
+	if err != nil {
+		return "", reportTrends{}, fmt.Errorf("marshaling to JSON: %w", err)
+	}
+
+	return string(jsonBytes), collected, nil
+}
+
+// hasLogEntries reports whether a JSON log file exists and contains at least one entry
+func hasLogEntries(logPath string) bool {
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		return false
+	}
+	var entries []json.RawMessage
+	return json.Unmarshal(data, &entries) == nil && len(entries) > 0
+}
+
 // runReportCommand executes the report CLI command
 func runReportCommand(reportType, format string, listMode bool, outputPath, reportsDir string, enableTrends bool) error {
+	return runReportCommandWithOptions(reportType, format, listMode, outputPath, reportsDir, enableTrends, ReportOptions{})
+}
+
+// ReportOptions holds optional settings for the report command
+type ReportOptions struct {
+	// GradeReportPattern is the grade report filename pattern (default: skill-clarity-{date}.md)
+	GradeReportPattern string
+	// WorstTasks adds a table of the N lowest-scoring eval tasks (0 disables it)
+	WorstTasks int
+	// History adds the average score of the last N grade reports to the grade markdown report;
+	// 2 or fewer keeps only the trend between the two newest reports
+	History int
+	// RecomputePassed re-evaluates each eval entry's pass/fail against PassThreshold
+	// instead of trusting the stored overall_passed
+	RecomputePassed bool
+	PassThreshold   float64
+	// Tag restricts eval metrics and trends to entries carrying this tag
+	Tag string
+	// Since and Until restrict eval and meta entries to timestamps in [Since, Until]; zero is unbounded
+	Since time.Time
+	Until time.Time
+	// FailOnRegression returns errRegressionDetected after writing the report when a trend
+	// regresses beyond RegressionThreshold percent (0 uses the default of 5). It is a no-op
+	// when trends are disabled or there is not enough history to compute them.
+	FailOnRegression    bool
+	RegressionThreshold float64
+}
+
+// runReportCommandWithOptions executes the report command with optional settings
+func runReportCommandWithOptions(reportType, format string, listMode bool, outputPath, reportsDir string, enableTrends bool, opts ReportOptions) error {
+	gradePattern := opts.GradeReportPattern
+	if gradePattern == "" {
+		gradePattern = defaultGradeReportPattern
+	}
+	if err := validateGradeReportPattern(gradePattern); err != nil {
+		return err
+	}
+	if opts.WorstTasks < 0 {
+		return fmt.Errorf("worst must be non-negative")
+	}
+	if opts.History < 0 {
+		return fmt.Errorf("history must be non-negative")
+	}
+	if !opts.Since.IsZero() && !opts.Until.IsZero() && opts.Until.Before(opts.Since) {
+		return fmt.Errorf("until must not be before since")
+	}
+	if opts.RecomputePassed {
+		if err := validatePassThreshold(opts.PassThreshold); err != nil {
+			return err
+		}
+	}
+	if opts.RegressionThreshold < 0 {
+		return fmt.Errorf("regression threshold must be non-negative")
+	}
+	// The markdown and HTML trend markers flag the same regressions --fail-on-regression gates on
+	regressionThreshold := opts.RegressionThreshold
+	if regressionThreshold == 0 {
+		regressionThreshold = defaultRegressionThreshold
+	}
+
 	// List mode: just list available reports
 	if listMode {
-		output := listGradeReports(reportsDir)
+		var output string
+		switch format {
+		case "json":
+			jsonOutput, err := listGradeReportsJSON(reportsDir, gradePattern)
+			if err != nil {
+				return fmt.Errorf("formatting as JSON: %w", err)
+			}
+			output = jsonOutput
+		case "markdown":
+			output = listGradeReportsWithPattern(reportsDir, gradePattern)
+		default:
+			return fmt.Errorf("unsupported format: %s (use 'markdown' or 'json')", format)
+		}
 
 		if outputPath != "" {
 			// Write to file
@@ -661,134 +1397,111 @@ func runReportCommand(reportType, format string, listMode bool, outputPath, repo
 		return nil
 	}
 
-	// Handle different report types
+	// Handle different report types; html is streamed by render instead of built into output
+	warnings := newWarnings(format)
 	var output string
+	var render func(io.Writer)
+	var collected reportTrends
 	switch reportType {
 	case "grade":
-		// Find reports
-		reports, err := findGradeReports(reportsDir)
-		if err != nil {
-			return fmt.Errorf("finding grade reports: %w", err)
-		}
-
-		if len(reports) == 0 {
-			return fmt.Errorf("no grade reports found in %s", reportsDir)
-		}
-
-		// Get the latest report
-		latestReportPath := reports[0]
-
-		// Parse the report
-		report, err := parseGradeReport(latestReportPath)
+		report, trends, err := loadGradeReportData(reportsDir, gradePattern, enableTrends, warnings)
 		if err != nil {
-			return fmt.Errorf("parsing report: %w", err)
-		}
-
-		// Load trend data if enabled
-		var trends *GradeTrends
-		if enableTrends {
-			trends, err = loadGradeTrends(reportsDir)
-			if err != nil {
-				// Don't fail if trends can't be loaded, just disable them
-				fmt.Fprintf(os.Stderr, "Warning: Could not load trend data: %v\n", err)
-				trends = nil
-			}
+			return err
 		}
+		collected.grade = trends
 
 		// Format the output
 		switch format {
 		case "json":
-			jsonOutput, err := formatReportSummaryJSON(report, trends, enableTrends)
+			jsonOutput, err := formatReportSummaryJSON(report, trends, enableTrends, warnings)
 			if err != nil {
 				return fmt.Errorf("formatting as JSON: %w", err)
 			}
 			output = jsonOutput
 		case "markdown":
-			output = formatReportSummaryMarkdown(report, trends, enableTrends)
+			output = formatReportSummaryMarkdown(report, trends, enableTrends, regressionThreshold)
+			if opts.History > 2 {
+				history, err := loadGradeHistoryWithPattern(reportsDir, gradePattern, opts.History)
+				if err != nil {
+					warnings.Addf("Could not load grade history: %v", err)
+				}
+				output += formatGradeHistoryMarkdown(history)
+			}
+		case "html":
+			render = func(w io.Writer) { writeGradeReportHTML(w, report, trends, enableTrends, regressionThreshold) }
 		default:
-			return fmt.Errorf("unsupported format: %s (use 'markdown' or 'json')", format)
+			return fmt.Errorf("unsupported format: %s (use 'markdown', 'json' or 'html')", format)
 		}
 
 	case "meta":
-		// Load meta results from consistency-log.json
-		metaLogPath := filepath.Join(reportsDir, "consistency-log.json")
-		results, err := loadMetaResults(metaLogPath)
+		results, metaTrends, err := loadMetaReportData(reportsDir, enableTrends, opts, warnings)
 		if err != nil {
-			return fmt.Errorf("loading meta results: %w", err)
-		}
-
-		if len(results) == 0 {
-			return fmt.Errorf("no meta results found in %s", metaLogPath)
-		}
-
-		// Load trend data if enabled
-		var metaTrends *MetaTrends
-		if enableTrends {
-			metaTrends, err = loadMetaTrends(metaLogPath)
-			if err != nil {
-				// Don't fail if trends can't be loaded, just disable them
-				fmt.Fprintf(os.Stderr, "Warning: Could not load trend data: %v\n", err)
-				metaTrends = nil
-			}
+			return err
 		}
+		collected.meta = metaTrends
 
 		// Format the output
 		switch format {
 		case "json":
-			jsonOutput, err := formatMetaReportJSON(results, metaTrends, enableTrends)
+			jsonOutput, err := formatMetaReportJSON(results, metaTrends, enableTrends, warnings)
 			if err != nil {
 				return fmt.Errorf("formatting as JSON: %w", err)
 			}
 			output = jsonOutput
 		case "markdown":
 			output = formatMetaReportMarkdown(results, metaTrends, enableTrends)
+		case "html":
+			render = func(w io.Writer) { writeMetaReportHTML(w, results, metaTrends, enableTrends) }
 		default:
-			return fmt.Errorf("unsupported format: %s (use 'markdown' or 'json')", format)
+			return fmt.Errorf("unsupported format: %s (use 'markdown', 'json' or 'html')", format)
 		}
 
 	case "eval":
-		// Load eval results from task-eval-log.json
-		evalLogPath := filepath.Join(reportsDir, "task-eval-log.json")
-		results, err := loadEvalResults(evalLogPath)
+		results, evalTrends, err := loadEvalReportData(reportsDir, enableTrends, opts, warnings)
 		if err != nil {
-			return fmt.Errorf("loading eval results: %w", err)
-		}
-
-		if len(results) == 0 {
-			return fmt.Errorf("no eval results found in %s", evalLogPath)
-		}
-
-		// Load trend data if enabled
-		var evalTrends *EvalTrends
-		if enableTrends {
-			evalTrends, err = loadEvalTrends(evalLogPath)
-			if err != nil {
-				// Don't fail if trends can't be loaded, just disable them
-				fmt.Fprintf(os.Stderr, "Warning: Could not load trend data: %v\n", err)
-				evalTrends = nil
-			}
+			return err
 		}
+		collected.eval = evalTrends
 
 		// Format the output
 		switch format {
 		case "json":
-			jsonOutput, err := formatEvalReportJSON(results, evalTrends, enableTrends)
+			jsonOutput, err := formatEvalReportJSON(results, evalTrends, enableTrends, warnings)
 			if err != nil {
 				return fmt.Errorf("formatting as JSON: %w", err)
 			}
 			output = jsonOutput
 		case "markdown":
-			output = formatEvalReportMarkdown(results, evalTrends, enableTrends)
+			output = formatEvalReportMarkdown(results, evalTrends, enableTrends, regressionThreshold)
+			output += formatWorstTasksMarkdown(results, opts.WorstTasks)
+		case "html":
+			render = func(w io.Writer) { writeEvalReportHTML(w, results, evalTrends, enableTrends, regressionThreshold, opts.WorstTasks) }
 		default:
-			return fmt.Errorf("unsupported format: %s (use 'markdown' or 'json')", format)
+			return fmt.Errorf("unsupported format: %s (use 'markdown', 'json' or 'html')", format)
 		}
 
+	case "all":
+		if format != "json" {
+			return fmt.Errorf("report type 'all' only supports the json format")
+		}
+
+		jsonOutput, allTrends, err := formatAllReportsJSONWithTrends(reportsDir, gradePattern, enableTrends, opts, warnings)
+		if err != nil {
+			return err
+		}
+		output = jsonOutput
+		collected = allTrends
+
 	default:
-		return fmt.Errorf("report type '%s' not supported (use 'grade', 'meta', or 'eval')", reportType)
+		return fmt.Errorf("report type '%s' not supported (use 'grade', 'meta', 'eval', or 'all')", reportType)
 	}
 
 	// Write output
-	if outputPath != "" {
+	if render != nil {
+		if err := writeReportHTMLOutput(outputPath, render); err != nil {
+			return err
+		}
+	} else if outputPath != "" {
 		// Write to file
 		if err := os.WriteFile(outputPath, []byte(output), 0644); err != nil {
 			return fmt.Errorf("writing output file: %w", err)
@@ -799,5 +1512,10 @@ func runReportCommand(reportType, format string, listMode bool, outputPath, repo
 		fmt.Print(output)
 	}
 
+	// Gate only after the full report is written
+	if opts.FailOnRegression && enableTrends {
+		return checkRegressions(collected, regressionThreshold)
+	}
+
 	return nil
 }
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	htmlpkg "html"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// reportHTMLStyle is the inline stylesheet so HTML reports need no external assets
+const reportHTMLStyle = `
+    body { font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; color: #333; background: #f5f5f5; margin: 0; padding: 20px; }
+    .container { max-width: 960px; margin: 0 auto; background: white; padding: 30px; border-radius: 8px; box-shadow: 0 2px 4px rgba(0,0,0,0.1); }
+    h1 { color: #2c3e50; margin: 0 0 10px; }
+    h2 { color: #34495e; margin-top: 30px; padding-bottom: 8px; border-bottom: 2px solid #3498db; }
+    .meta { color: #7f8c8d; font-size: 0.9em; }
+    .warning { background: #fff3cd; border-left: 4px solid #f39c12; padding: 10px 15px; }
+    table { width: 100%; border-collapse: collapse; margin-top: 10px; }
+    th, td { text-align: left; padding: 8px 12px; border-bottom: 1px solid #ecf0f1; }
+    th { background: #34495e; color: white; }
+    .improvement { color: #27ae60; font-weight: 600; }
+    .regression { color: #e74c3c; font-weight: 600; }
+    .stable { color: #7f8c8d; }
+`
+
+// reportHTMLMetric is a single row of the current metrics table
+type reportHTMLMetric struct {
+	Name  string
+	Value string
+}
+
+// reportHTMLTrend is a single row of a trend table
+type reportHTMLTrend struct {
+	Name  string
+	Trend TrendData
+	// PercentagePoints formats values as percentages and the change in pp
+	PercentagePoints bool
+	Exceeds          bool
+}
+
+// writeReportHTMLHeader writes the document head, title and report metadata
+func writeReportHTMLHeader(w io.Writer, title, reportType, generated string) {
+	fmt.Fprintf(w, `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1.0">
+  <title>%s</title>
+  <style>%s  </style>
+</head>
+<body>
+  <div class="container">
+    <h1>%s</h1>
+    <p class="meta">Report type: %s`, htmlpkg.EscapeString(title), reportHTMLStyle, htmlpkg.EscapeString(title), htmlpkg.EscapeString(reportType))
+	if generated != "" {
+		fmt.Fprintf(w, " &middot; Generated: %s", htmlpkg.EscapeString(generated))
+	}
+	fmt.Fprint(w, "</p>\n")
+}
+
+// writeReportHTMLFooter closes the document
+func writeReportHTMLFooter(w io.Writer) {
+	fmt.Fprint(w, "  </div>\n</body>\n</html>\n")
+}
+
+// writeReportHTMLTable writes a table with the given headers; rows are escaped
+func writeReportHTMLTable(w io.Writer, headers []string, rows [][]string) {
+	fmt.Fprint(w, "    <table>\n      <thead><tr>")
+	for _, header := range headers {
+		fmt.Fprintf(w, "<th>%s</th>", htmlpkg.EscapeString(header))
+	}
+	fmt.Fprint(w, "</tr></thead>\n      <tbody>\n")
+	for _, row := range rows {
+		fmt.Fprint(w, "        <tr>")
+		for _, cell := range row {
+			fmt.Fprintf(w, "<td>%s</td>", htmlpkg.EscapeString(cell))
+		}
+		fmt.Fprint(w, "</tr>\n")
+	}
+	fmt.Fprint(w, "      </tbody>\n    </table>\n")
+}
+
+// writeReportHTMLMetrics writes the current metrics table
+func writeReportHTMLMetrics(w io.Writer, metrics []reportHTMLMetric) {
+	fmt.Fprint(w, "    <h2>Current Metrics</h2>\n")
+	rows := make([][]string, 0, len(metrics))
+	for _, metric := range metrics {
+		rows = append(rows, []string{metric.Name, metric.Value})
+	}
+	writeReportHTMLTable(w, []string{"Metric", "Value"}, rows)
+}
+
+// writeReportHTMLTrends writes a trend table, coloring each status by its TrendData direction
+func writeReportHTMLTrends(w io.Writer, heading, nameHeader string, trends []reportHTMLTrend) {
+	fmt.Fprintf(w, "    <h2>%s</h2>\n", htmlpkg.EscapeString(heading))
+	fmt.Fprintf(w, "    <table>\n      <thead><tr><th>%s</th><th>Previous</th><th>Current</th><th>Change</th><th>Status</th></tr></thead>\n      <tbody>\n",
+		htmlpkg.EscapeString(nameHeader))
+	for _, row := range trends {
+		trend := row.Trend
+		deltaSign := ""
+		if trend.AbsoluteDelta > 0 {
+			deltaSign = "+"
+		}
+
+		previous := fmt.Sprintf("%.1f", trend.PreviousValue)
+		current := fmt.Sprintf("%.1f", trend.CurrentValue)
+		var change string
+		if row.PercentagePoints {
+			previous += "%"
+			current += "%"
+			change = fmt.Sprintf("%s%.1fpp", deltaSign, trend.AbsoluteDelta)
+		} else {
+			percentStr := fmt.Sprintf("%s%.2f%%", deltaSign, trend.PercentageDelta)
+			if trend.PercentageLabel != "" {
+				percentStr = trend.PercentageLabel
+			}
+			change = fmt.Sprintf("%s%.1f (%s)", deltaSign, trend.AbsoluteDelta, percentStr)
+		}
+
+		warning := ""
+		if row.Exceeds {
+			warning = " ⚠"
+		}
+
+		fmt.Fprintf(w, "        <tr><td>%s</td><td>%s</td><td>%s</td><td>%s</td><td class=\"%s\">%s %s%s</td></tr>\n",
+			htmlpkg.EscapeString(row.Name), previous, current, htmlpkg.EscapeString(change),
+			htmlpkg.EscapeString(trend.Direction), trendIndicator(trend.Direction), htmlpkg.EscapeString(trend.Direction), warning)
+	}
+	fmt.Fprint(w, "      </tbody>\n    </table>\n")
+}
+
+// writeGradeReportHTML renders a grade report as a self-contained HTML page, flagging trends that
+// regress beyond threshold percent
+func writeGradeReportHTML(w io.Writer, report GradeReport, trends *GradeTrends, enableTrends bool, threshold float64) {
+	writeReportHTMLHeader(w, "Evaluation Report Summary", "grade", report.GeneratedDate)
+	fmt.Fprintf(w, "    <p class=\"meta\">Report: %s</p>\n", htmlpkg.EscapeString(filepath.Base(report.FilePath)))
+
+	writeReportHTMLMetrics(w, []reportHTMLMetric{
+		{"Total Skills", fmt.Sprintf("%d", report.TotalSkills)},
+		{"Average Score", fmt.Sprintf("%.1f/100", report.AverageScore)},
+		{"Pass Rate", fmt.Sprintf("%.1f%%", report.PassRate)},
+		{"Passing Threshold", fmt.Sprintf("%.1f/100", report.PassingThreshold)},
+	})
+
+	if enableTrends && trends != nil {
+		writeReportHTMLTrends(w, "Trend Analysis", "Metric", []reportHTMLTrend{
+			{Name: "Average Score", Trend: trends.AverageScore, Exceeds: exceedsRegressionThreshold(trends.AverageScore, threshold)},
+			{Name: "Pass Rate", Trend: trends.PassRate, Exceeds: exceedsRegressionThreshold(trends.PassRate, threshold)},
+			{Name: "Total Skills", Trend: trends.TotalSkills, Exceeds: exceedsRegressionThreshold(trends.TotalSkills, threshold)},
+		})
+
+		var criteriaTrends []reportHTMLTrend
+		for _, name := range []string{"Clear Instructions", "Actionable Steps", "Good Examples", "Appropriate Scope"} {
+			if trend, exists := trends.PerCriteriaTrends[name]; exists {
+				criteriaTrends = append(criteriaTrends, reportHTMLTrend{Name: name, Trend: trend, Exceeds: exceedsRegressionThreshold(trend, threshold)})
+			}
+		}
+		if len(criteriaTrends) > 0 {
+			writeReportHTMLTrends(w, "Per-Criteria Trends", "Criteria", criteriaTrends)
+		}
+	}
+
+	if len(report.CriteriaScores) > 0 {
+		fmt.Fprint(w, "    <h2>Per-Category Breakdown</h2>\n")
+		rows := make([][]string, 0, len(report.CriteriaScores))
+		for _, criteria := range report.CriteriaScores {
+			rows = append(rows, []string{criteria.Name, fmt.Sprintf("%.1f", criteria.Average)})
+		}
+		writeReportHTMLTable(w, []string{"Criteria", "Average Score"}, rows)
+	}
+
+	writeReportHTMLFooter(w)
+}
+
+// writeMetaReportHTML renders meta-evaluation results as a self-contained HTML page
+func writeMetaReportHTML(w io.Writer, results []ConsistencyResult, trends *MetaTrends, enableTrends bool) {
+	generated := ""
+	if len(results) > 0 {
+		generated = results[len(results)-1].Timestamp
+	}
+	writeReportHTMLHeader(w, "Meta-Evaluation Report", "meta", generated)
+
+	agentKey := metaAgentKeyFunc(results)
+	latestByAgent := make(map[string]ConsistencyResult)
+	for _, result := range results {
+		latestByAgent[agentKey(result)] = result
+	}
+	var agents []string
+	for agent := range latestByAgent {
+		agents = append(agents, agent)
+	}
+	sort.Strings(agents)
+
+	fmt.Fprint(w, "    <h2>Current Metrics</h2>\n")
+	rows := make([][]string, 0, len(agents))
+	for _, agent := range agents {
+		result := latestByAgent[agent]
+		boundaryType := result.BoundaryType
+		if boundaryType == "" {
+			boundaryType = "-"
+		}
+		rows = append(rows, []string{result.Agent, boundaryType, fmt.Sprintf("%.1f%%", result.ConsistencyPercentage), fmt.Sprintf("%d", result.TotalCount)})
+	}
+	writeReportHTMLTable(w, []string{"Agent", "Boundary", "Consistency", "Runs"}, rows)
+
+	if enableTrends && trends != nil && len(trends.PerAgentTrends) > 0 {
+		var agentTrends []reportHTMLTrend
+		for _, agent := range agents {
+			if trend, exists := trends.PerAgentTrends[agent]; exists {
+				agentTrends = append(agentTrends, reportHTMLTrend{Name: agent, Trend: trend, PercentagePoints: true})
+			}
+		}
+		writeReportHTMLTrends(w, "Trend Analysis", "Agent", agentTrends)
+	}
+
+	writeReportHTMLFooter(w)
+}
+
+// writeEvalReportHTML renders eval results as a self-contained HTML page, flagging trends that
+// regress beyond threshold percent
+func writeEvalReportHTML(w io.Writer, results []GradeTaskOutput, trends *EvalTrends, enableTrends bool, threshold float64, worstTasks int) {
+	latestTimestamp := ""
+	for _, result := range results {
+		if result.Timestamp > latestTimestamp {
+			latestTimestamp = result.Timestamp
+		}
+	}
+	writeReportHTMLHeader(w, "Evaluation Report", "eval", latestTimestamp)
+
+	latestResults := latestEvalResults(results)
+	avgScore, passRate, passedCount := summarizeEvalResults(latestResults)
+	writeReportHTMLMetrics(w, []reportHTMLMetric{
+		{"Average Score", fmt.Sprintf("%.1f/100", avgScore)},
+		{"Pass Rate", fmt.Sprintf("%.1f%% (%d/%d tasks)", passRate, passedCount, len(latestResults))},
+	})
+
+	if enableTrends && trends != nil {
+		if len(trends.GraderVersionChanges) > 0 {
+			fmt.Fprintf(w, "    <p class=\"warning\">⚠ Grader versions differ between compared runs: %s. Changes may reflect grader logic rather than agent behavior.</p>\n",
+				htmlpkg.EscapeString(strings.Join(trends.GraderVersionChanges, ", ")))
+		}
+
+		writeReportHTMLTrends(w, "Trend Analysis", "Metric", []reportHTMLTrend{
+			{Name: "Average Score", Trend: trends.AverageScore, Exceeds: exceedsRegressionThreshold(trends.AverageScore, threshold)},
+			{Name: "Pass Rate", Trend: trends.PassRate, Exceeds: exceedsRegressionThreshold(trends.PassRate, threshold)},
+		})
+
+		if len(trends.PerTaskTrends) > 0 {
+			var taskIDs []string
+			for taskID := range trends.PerTaskTrends {
+				taskIDs = append(taskIDs, taskID)
+			}
+			sort.Strings(taskIDs)
+
+			taskTrends := make([]reportHTMLTrend, 0, len(taskIDs))
+			for _, taskID := range taskIDs {
+				trend := trends.PerTaskTrends[taskID]
+				taskTrends = append(taskTrends, reportHTMLTrend{Name: taskID, Trend: trend, Exceeds: exceedsRegressionThreshold(trend, threshold)})
+			}
+			writeReportHTMLTrends(w, "Per-Task Trends", "Task", taskTrends)
+		}
+	}
+
+	if worst := worstEvalResults(latestResults, worstTasks); len(worst) > 0 {
+		fmt.Fprint(w, "    <h2>Lowest Scoring Tasks</h2>\n")
+		rows := make([][]string, 0, len(worst))
+		for i, result := range worst {
+			status := "✓ PASS"
+			if !result.OverallPassed {
+				status = "✗ FAIL"
+			}
+			rows = append(rows, []string{fmt.Sprintf("%d", i+1), result.TaskID, fmt.Sprintf("%.1f", result.OverallScore), status})
+		}
+		writeReportHTMLTable(w, []string{"Rank", "Task", "Score", "Status"}, rows)
+	}
+
+	writeReportHTMLFooter(w)
+}
+
+// writeReportHTMLOutput streams an HTML report to outputPath, or stdout when it is empty
+func writeReportHTMLOutput(outputPath string, render func(io.Writer)) error {
+	if outputPath == "" {
+		w := bufio.NewWriter(os.Stdout)
+		render(w)
+		return w.Flush()
+	}
+
+	file, err := os.Create(outputPath)
+	if err != nil {
+		return fmt.Errorf("writing output file: %w", err)
+	}
+
+	// bufio keeps the first write error and reports it on Flush
+	w := bufio.NewWriter(file)
+	render(w)
+	if err := w.Flush(); err != nil {
+		file.Close()
+		return fmt.Errorf("writing output file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("writing output file: %w", err)
+	}
+
+	fmt.Printf("Report written to: %s\n", outputPath)
+	return nil
+}
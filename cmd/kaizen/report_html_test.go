@@ -0,0 +1,116 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRunReportCommandMetaHTML verifies the meta HTML report is self-contained and colors trends by direction
+func TestRunReportCommandMetaHTML(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportsDir := filepath.Join(tmpDir, "reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create reports dir: %v", err)
+	}
+
+	logContent := `[
+		{"timestamp": "2026-01-26T10:00:00Z", "agent": "yokay-quality-reviewer", "boundary_type": "epic", "consistency_percentage": 80.0, "consistent_count": 16, "total_count": 20},
+		{"timestamp": "2026-01-27T10:00:00Z", "agent": "yokay-quality-reviewer", "boundary_type": "epic", "consistency_percentage": 85.0, "consistent_count": 17, "total_count": 20},
+		{"timestamp": "2026-01-26T10:00:00Z", "agent": "<script>alert(1)</script>", "boundary_type": "epic", "consistency_percentage": 90.0, "consistent_count": 18, "total_count": 20},
+		{"timestamp": "2026-01-27T10:00:00Z", "agent": "<script>alert(1)</script>", "boundary_type": "epic", "consistency_percentage": 70.0, "consistent_count": 14, "total_count": 20}
+	]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "consistency-log.json"), []byte(logContent), 0644); err != nil {
+		t.Fatalf("Failed to write consistency log: %v", err)
+	}
+
+	outputPath := filepath.Join(tmpDir, "meta-report.html")
+	if err := runReportCommand("meta", "html", false, outputPath, reportsDir, true); err != nil {
+		t.Fatalf("runReportCommand with html format failed: %v", err)
+	}
+
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	output := string(content)
+
+	expected := []string{
+		"<!DOCTYPE html>",
+		"<style>",
+		"Current Metrics",
+		"Trend Analysis",
+		"+5.0pp",
+		`<td class="improvement">↑ improvement</td>`,
+		`<td class="regression">↓ regression</td>`,
+		"&lt;script&gt;alert(1)&lt;/script&gt;",
+		"</html>",
+	}
+	for _, want := range expected {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected HTML to contain %q", want)
+		}
+	}
+
+	if strings.Contains(output, "<script>") {
+		t.Error("Expected agent names to be escaped")
+	}
+	for _, external := range []string{"<link", "src=", "http://", "https://"} {
+		if strings.Contains(output, external) {
+			t.Errorf("Expected no external assets, found %q", external)
+		}
+	}
+}
+
+// TestRunReportCommandEvalHTMLNoTrends verifies the eval HTML report omits trends when disabled
+func TestRunReportCommandEvalHTMLNoTrends(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportsDir := filepath.Join(tmpDir, "reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create reports dir: %v", err)
+	}
+
+	logContent := `[
+		{"task_id": "task-1", "task_type": "feature", "timestamp": "2026-01-27T10:00:00Z", "overall_passed": true, "overall_score": 90.0, "results": []},
+		{"task_id": "task-2", "task_type": "feature", "timestamp": "2026-01-27T10:00:00Z", "overall_passed": false, "overall_score": 40.0, "results": []}
+	]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), []byte(logContent), 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	outputPath := filepath.Join(tmpDir, "eval-report.html")
+	opts := ReportOptions{WorstTasks: 1}
+	if err := runReportCommandWithOptions("eval", "html", false, outputPath, reportsDir, false, opts); err != nil {
+		t.Fatalf("runReportCommandWithOptions with html format failed: %v", err)
+	}
+
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	output := string(content)
+
+	for _, want := range []string{"65.0/100", "50.0% (1/2 tasks)", "Lowest Scoring Tasks", "<td>task-2</td>"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected HTML to contain %q", want)
+		}
+	}
+	if strings.Contains(output, "Trend Analysis") {
+		t.Error("Expected no trend section with trends disabled")
+	}
+}
+
+// TestRunReportCommandUnsupportedFormatListsHTML verifies the format error mentions html
+func TestRunReportCommandUnsupportedFormatListsHTML(t *testing.T) {
+	reportsDir := t.TempDir()
+	logContent := `[{"task_id": "task-1", "timestamp": "2026-01-27T10:00:00Z", "overall_passed": true, "overall_score": 90.0, "results": []}]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), []byte(logContent), 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	err := runReportCommand("eval", "pdf", false, "", reportsDir, false)
+	if err == nil || !strings.Contains(err.Error(), "'html'") {
+		t.Errorf("Expected unsupported format error listing html, got %v", err)
+	}
+}
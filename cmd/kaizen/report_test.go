@@ -2,10 +2,14 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 // TestFindGradeReports verifies that findGradeReports can locate skill-clarity reports
@@ -63,6 +67,85 @@ func TestFindGradeReports(t *testing.T) {
 	}
 }
 
+// TestGradeReportPattern_CustomPatternGenerationAndDiscoveryAgree verifies reports written with a custom
+// filename pattern are found by discovery with the same pattern
+func TestGradeReportPattern_CustomPatternGenerationAndDiscoveryAgree(t *testing.T) {
+	reportsDir := t.TempDir()
+	pattern := "team.grades.{date}.v1.md"
+
+	dates := []time.Time{
+		time.Date(2026, 1, 24, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 25, 0, 0, 0, 0, time.UTC),
+	}
+	for _, date := range dates {
+		filename, err := gradeReportFilename(pattern, date)
+		if err != nil {
+			t.Fatalf("gradeReportFilename failed: %v", err)
+		}
+		if err := os.WriteFile(filepath.Join(reportsDir, filename), []byte("# Test Report\n"), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+
+	// Reports that don't match the pattern must be ignored
+	for _, filename := range []string{"skill-clarity-2026-01-27.md", "teamXgrades.2026-01-27.v1.md", "team.grades.latest.v1.md"} {
+		if err := os.WriteFile(filepath.Join(reportsDir, filename), []byte("# Other\n"), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+
+	reports, err := findGradeReportsWithPattern(reportsDir, pattern)
+	if err != nil {
+		t.Fatalf("findGradeReportsWithPattern failed: %v", err)
+	}
+
+	expected := []string{
+		"team.grades.2026-01-26.v1.md",
+		"team.grades.2026-01-25.v1.md",
+		"team.grades.2026-01-24.v1.md",
+	}
+	if len(reports) != len(expected) {
+		t.Fatalf("Expected %d reports, got %d: %v", len(expected), len(reports), reports)
+	}
+	for i, name := range expected {
+		if filepath.Base(reports[i]) != name {
+			t.Errorf("Expected report %d to be %s, got %s", i, name, filepath.Base(reports[i]))
+		}
+	}
+
+	// Default pattern keeps the original naming
+	filename, err := gradeReportFilename(defaultGradeReportPattern, dates[0])
+	if err != nil {
+		t.Fatalf("gradeReportFilename failed: %v", err)
+	}
+	if filename != "skill-clarity-2026-01-24.md" {
+		t.Errorf("Expected default filename skill-clarity-2026-01-24.md, got %s", filename)
+	}
+}
+
+// TestValidateGradeReportPattern verifies patterns need exactly one {date} and no path separators
+func TestValidateGradeReportPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		wantErr bool
+	}{
+		{"skill-clarity-{date}.md", false},
+		{"{date}-grades.md", false},
+		{"", true},
+		{"skill-clarity.md", true},
+		{"{date}-{date}.md", true},
+		{"reports/{date}.md", true},
+	}
+
+	for _, tt := range tests {
+		err := validateGradeReportPattern(tt.pattern)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validateGradeReportPattern(%q) error = %v, wantErr %v", tt.pattern, err, tt.wantErr)
+		}
+	}
+}
+
 // TestParseGradeReport verifies that parseGradeReport can extract metrics from a report file
 func TestParseGradeReport(t *testing.T) {
 	// Create a temporary report file with known content
@@ -138,7 +221,7 @@ func TestFormatReportSummaryMarkdown(t *testing.T) {
 	}
 
 	// Test: Format as markdown (without trends)
-	output := formatReportSummaryMarkdown(report, nil, false)
+	output := formatReportSummaryMarkdown(report, nil, false, defaultRegressionThreshold)
 
 	// Verify: Output contains key metrics
 	expectedStrings := []string{
@@ -170,7 +253,7 @@ func TestFormatReportSummaryJSON(t *testing.T) {
 	}
 
 	// Test: Format as JSON (without trends)
-	output, err := formatReportSummaryJSON(report, nil, false)
+	output, err := formatReportSummaryJSON(report, nil, false, nil)
 	if err != nil {
 		t.Fatalf("formatReportSummaryJSON failed: %v", err)
 	}
@@ -302,6 +385,67 @@ func TestRunReportCommandListMode(t *testing.T) {
 	}
 }
 
+// TestRunReportCommand_ListJSON verifies list mode emits each report with its parsed date
+func TestRunReportCommand_ListJSON(t *testing.T) {
+	reportsDir := t.TempDir()
+
+	testFiles := []string{
+		"skill-clarity-2026-01-25.md",
+		"skill-clarity-2026-01-27.md",
+		"skill-clarity-2026-01-26.md",
+		"notes.md", // Should not be included
+	}
+	for _, filename := range testFiles {
+		if err := os.WriteFile(filepath.Join(reportsDir, filename), []byte("# Test Report\n"), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "reports.json")
+	if err := runReportCommand("grade", "json", true, outputPath, reportsDir, false); err != nil {
+		t.Fatalf("runReportCommand in list mode failed: %v", err)
+	}
+
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+
+	var result struct {
+		Reports []GradeReportListEntry `json:"reports"`
+	}
+	if err := json.Unmarshal(content, &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, content)
+	}
+
+	expected := []GradeReportListEntry{
+		{File: "skill-clarity-2026-01-27.md", Date: "2026-01-27"},
+		{File: "skill-clarity-2026-01-26.md", Date: "2026-01-26"},
+		{File: "skill-clarity-2026-01-25.md", Date: "2026-01-25"},
+	}
+	if len(result.Reports) != len(expected) {
+		t.Fatalf("Expected %d reports, got %d: %+v", len(expected), len(result.Reports), result.Reports)
+	}
+	for i, want := range expected {
+		if result.Reports[i] != want {
+			t.Errorf("Expected report %d to be %+v, got %+v", i, want, result.Reports[i])
+		}
+	}
+
+	// Custom filename patterns parse the date the same way
+	customDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(customDir, "grades-2026-02-01-team.md"), []byte("# Test Report\n"), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	output, err := listGradeReportsJSON(customDir, "grades-{date}-team.md")
+	if err != nil {
+		t.Fatalf("listGradeReportsJSON failed: %v", err)
+	}
+	if !strings.Contains(output, `"date": "2026-02-01"`) {
+		t.Errorf("Expected parsed date 2026-02-01, got:\n%s", output)
+	}
+}
+
 // TestParseGradeReportWithCriteriaScores verifies that parseGradeReport extracts per-criteria scores
 func TestParseGradeReportWithCriteriaScores(t *testing.T) {
 	// Create a temporary report file with Detailed Breakdown section
@@ -427,7 +571,7 @@ func TestFormatReportSummaryMarkdownWithCriteria(t *testing.T) {
 	}
 
 	// Test: Format as markdown (without trends)
-	output := formatReportSummaryMarkdown(report, nil, false)
+	output := formatReportSummaryMarkdown(report, nil, false, defaultRegressionThreshold)
 
 	// Verify: Output contains per-category breakdown section
 	if !strings.Contains(output, "## Per-Category Breakdown") {
@@ -476,7 +620,7 @@ func TestFormatReportSummaryJSONWithCriteria(t *testing.T) {
 	}
 
 	// Test: Format as JSON (without trends)
-	output, err := formatReportSummaryJSON(report, nil, false)
+	output, err := formatReportSummaryJSON(report, nil, false, nil)
 	if err != nil {
 		t.Fatalf("formatReportSummaryJSON failed: %v", err)
 	}
@@ -517,6 +661,51 @@ func TestFormatReportSummaryJSONWithCriteria(t *testing.T) {
 	}
 }
 
+// TestFormatReportSummaryJSONRadar verifies JSON includes every criterion's average normalized to 0-1
+func TestFormatReportSummaryJSONRadar(t *testing.T) {
+	report := GradeReport{
+		FilePath: "/path/to/skill-clarity-2026-01-26.md",
+		CriteriaScores: []CriteriaScore{
+			{Name: "Clear Instructions", Average: 70.0},
+			{Name: "Actionable Steps", Average: 66.7},
+			{Name: "Good Examples", Average: 100.0},
+			{Name: "Appropriate Scope", Average: 0.0},
+		},
+	}
+
+	output, err := formatReportSummaryJSON(report, nil, false, nil)
+	if err != nil {
+		t.Fatalf("formatReportSummaryJSON failed: %v", err)
+	}
+
+	var parsed struct {
+		Radar map[string]float64 `json:"radar"`
+	}
+	if err := json.Unmarshal([]byte(output), &parsed); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+
+	expected := map[string]float64{
+		"Clear Instructions": 0.7,
+		"Actionable Steps":   0.667,
+		"Good Examples":      1.0,
+		"Appropriate Scope":  0.0,
+	}
+	if len(parsed.Radar) != len(expected) {
+		t.Errorf("Expected %d radar criteria, got %v", len(expected), parsed.Radar)
+	}
+	for name, want := range expected {
+		got, exists := parsed.Radar[name]
+		if !exists {
+			t.Errorf("Expected radar to contain %q", name)
+			continue
+		}
+		if math.Abs(got-want) > 1e-9 {
+			t.Errorf("radar[%q] = %v, want %v", name, got, want)
+		}
+	}
+}
+
 // TestFormatReportSummaryMarkdownWithTrends verifies markdown includes trend analysis
 func TestFormatReportSummaryMarkdownWithTrends(t *testing.T) {
 	report := GradeReport{
@@ -535,7 +724,7 @@ func TestFormatReportSummaryMarkdownWithTrends(t *testing.T) {
 	}
 
 	// Test: Format as markdown with trends
-	output := formatReportSummaryMarkdown(report, trends, true)
+	output := formatReportSummaryMarkdown(report, trends, true, defaultRegressionThreshold)
 
 	// Verify: Output contains trend section
 	if !strings.Contains(output, "## Trend Analysis") {
@@ -576,7 +765,7 @@ func TestFormatReportSummaryJSONWithTrends(t *testing.T) {
 	}
 
 	// Test: Format as JSON with trends
-	output, err := formatReportSummaryJSON(report, trends, true)
+	output, err := formatReportSummaryJSON(report, trends, true, nil)
 	if err != nil {
 		t.Fatalf("formatReportSummaryJSON failed: %v", err)
 	}
@@ -925,6 +1114,58 @@ func TestRunReportCommandMetaTypeWithTrends(t *testing.T) {
 	}
 }
 
+// TestFormatMetaReportBoundaryTypes verifies one agent evaluated at two boundary levels keeps a row
+// and a trend per level instead of collapsing into one
+func TestFormatMetaReportBoundaryTypes(t *testing.T) {
+	results := []ConsistencyResult{
+		{Timestamp: "2026-01-26T10:00:00Z", Agent: "spec-reviewer", BoundaryType: "task", ConsistencyPercentage: 90.0, TotalCount: 10},
+		{Timestamp: "2026-01-26T10:00:00Z", Agent: "spec-reviewer", BoundaryType: "epic", ConsistencyPercentage: 60.0, TotalCount: 4},
+		{Timestamp: "2026-01-27T10:00:00Z", Agent: "spec-reviewer", BoundaryType: "task", ConsistencyPercentage: 95.0, TotalCount: 10},
+		{Timestamp: "2026-01-27T10:00:00Z", Agent: "spec-reviewer", BoundaryType: "epic", ConsistencyPercentage: 50.0, TotalCount: 4},
+		{Timestamp: "2026-01-27T10:00:00Z", Agent: "quality-reviewer", BoundaryType: "task", ConsistencyPercentage: 80.0, TotalCount: 10},
+	}
+
+	trends, err := calculateMetaTrends(results)
+	if err != nil {
+		t.Fatalf("calculateMetaTrends failed: %v", err)
+	}
+	if trend := trends.PerAgentTrends["spec-reviewer (epic)"]; trend.CurrentValue != 50.0 || trend.PreviousValue != 60.0 {
+		t.Errorf("Expected epic trend 60 -> 50, got %+v", trend)
+	}
+	if trend := trends.PerAgentTrends["spec-reviewer (task)"]; trend.CurrentValue != 95.0 || trend.PreviousValue != 90.0 {
+		t.Errorf("Expected task trend 90 -> 95, got %+v", trend)
+	}
+
+	output := formatMetaReportMarkdown(results, trends, true)
+	for _, want := range []string{
+		"| spec-reviewer | epic | 50.0% | 4 |",
+		"| spec-reviewer | task | 95.0% | 10 |",
+		"| quality-reviewer | task | 80.0% | 10 |",
+		"| spec-reviewer (epic) | 60.0% | 50.0% |",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected markdown to contain %q, got:\n%s", want, output)
+		}
+	}
+
+	jsonOutput, err := formatMetaReportJSON(results, nil, false, nil)
+	if err != nil {
+		t.Fatalf("formatMetaReportJSON failed: %v", err)
+	}
+	var report struct {
+		Agents []struct {
+			Agent        string `json:"agent"`
+			BoundaryType string `json:"boundary_type"`
+		} `json:"agents"`
+	}
+	if err := json.Unmarshal([]byte(jsonOutput), &report); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(report.Agents) != 3 || report.Agents[1].Agent != "spec-reviewer" || report.Agents[1].BoundaryType != "epic" {
+		t.Errorf("Expected one JSON entry per agent and boundary, got %+v", report.Agents)
+	}
+}
+
 // TestFormatMetaReportMarkdown tests markdown formatting for meta reports
 func TestFormatMetaReportMarkdown(t *testing.T) {
 	// Create test data with multiple agents
@@ -953,7 +1194,7 @@ func TestFormatMetaReportMarkdown(t *testing.T) {
 		"# Meta-Evaluation Report",
 		"**Report Type**: meta",
 		"## Current Metrics",
-		"| Agent | Consistency | Runs |",
+		"| Agent | Boundary | Consistency | Runs |",
 		"yokay-quality-reviewer",
 		"yokay-spec-reviewer",
 		"85.0%",
@@ -1047,7 +1288,7 @@ func TestFormatMetaReportJSON(t *testing.T) {
 	}
 
 	// Test: Format as JSON without trends
-	output, err := formatMetaReportJSON(results, nil, false)
+	output, err := formatMetaReportJSON(results, nil, false, nil)
 	if err != nil {
 		t.Fatalf("formatMetaReportJSON failed: %v", err)
 	}
@@ -1094,7 +1335,7 @@ func TestFormatMetaReportJSONWithTrends(t *testing.T) {
 	}
 
 	// Test: Format as JSON with trends
-	output, err := formatMetaReportJSON(results, trends, true)
+	output, err := formatMetaReportJSON(results, trends, true, nil)
 	if err != nil {
 		t.Fatalf("formatMetaReportJSON failed: %v", err)
 	}
@@ -1320,7 +1561,7 @@ func TestFormatEvalReportMarkdown(t *testing.T) {
 	}
 
 	// Test: Format as markdown without trends
-	output := formatEvalReportMarkdown(results, nil, false)
+	output := formatEvalReportMarkdown(results, nil, false, defaultRegressionThreshold)
 
 	// Verify output structure
 	expectedStrings := []string{
@@ -1340,6 +1581,206 @@ func TestFormatEvalReportMarkdown(t *testing.T) {
 	}
 }
 
+// TestFormatWorstTasksMarkdown tests the lowest scoring tasks table
+func TestFormatWorstTasksMarkdown(t *testing.T) {
+	results := []GradeTaskOutput{
+		// Older bucket: must be ignored even though it scores lowest
+		{TaskID: "task-old", Timestamp: "2026-01-26T10:00:00Z", OverallPassed: false, OverallScore: 5.0},
+		{TaskID: "task-001", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: true, OverallScore: 90.0},
+		{TaskID: "task-002", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: false, OverallScore: 40.0},
+		{TaskID: "task-003", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: true, OverallScore: 75.0},
+		{TaskID: "task-004", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: false, OverallScore: 20.0},
+	}
+
+	output := formatWorstTasksMarkdown(results, 3)
+
+	if !strings.Contains(output, "## Lowest Scoring Tasks") {
+		t.Fatalf("Expected Lowest Scoring Tasks section, got:\n%s", output)
+	}
+	if strings.Contains(output, "task-old") {
+		t.Error("Expected only tasks from the latest bucket")
+	}
+	if strings.Contains(output, "task-001") {
+		t.Error("Expected table to be limited to the 3 lowest-scoring tasks")
+	}
+
+	expectedRows := []string{
+		"| 1 | task-004 | 20.0 | ✗ FAIL |",
+		"| 2 | task-002 | 40.0 | ✗ FAIL |",
+		"| 3 | task-003 | 75.0 | ✓ PASS |",
+	}
+	lastIndex := -1
+	for _, row := range expectedRows {
+		idx := strings.Index(output, row)
+		if idx == -1 {
+			t.Fatalf("Expected row %q in output:\n%s", row, output)
+		}
+		if idx < lastIndex {
+			t.Errorf("Row %q is out of order", row)
+		}
+		lastIndex = idx
+	}
+
+	if got := formatWorstTasksMarkdown(results, 0); got != "" {
+		t.Errorf("Expected no table when N is 0, got:\n%s", got)
+	}
+}
+
+// TestSparkline tests scaling values between the lowest and highest bar
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		values []float64
+		want   string
+	}{
+		{values: []float64{60, 70, 80}, want: "▁▅█"},
+		{values: []float64{80, 60}, want: "█▁"},
+		{values: []float64{75, 75, 75}, want: "▅▅▅"},
+		{values: nil, want: ""},
+	}
+	for _, tt := range tests {
+		if got := sparkline(tt.values); got != tt.want {
+			t.Errorf("sparkline(%v) = %q, want %q", tt.values, got, tt.want)
+		}
+	}
+}
+
+// TestRunReportCommand_GradeHistory tests charting the last N grade reports in the markdown report
+func TestRunReportCommand_GradeHistory(t *testing.T) {
+	reportsDir := t.TempDir()
+	writeGradeHistoryReports(t, reportsDir, []float64{60, 65, 70, 72, 80})
+
+	outputPath := filepath.Join(t.TempDir(), "report.md")
+	if err := runReportCommandWithOptions("grade", "markdown", false, outputPath, reportsDir, true, ReportOptions{History: 4}); err != nil {
+		t.Fatalf("runReportCommandWithOptions failed: %v", err)
+	}
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	output := string(content)
+
+	for _, want := range []string{
+		"## Score History",
+		"Average score over the last 4 reports (oldest to newest): `▁▃▄█`",
+		"| skill-clarity-2026-01-22.md | 2026-01-22 10:00:00 | 65.0 | 80.0% |",
+		"| skill-clarity-2026-01-25.md | 2026-01-25 10:00:00 | 80.0 | 80.0% |",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "skill-clarity-2026-01-21.md |") {
+		t.Error("Expected the oldest report to fall outside a history of 4")
+	}
+
+	// The default keeps the two-report trend without a history section
+	if err := runReportCommandWithOptions("grade", "markdown", false, outputPath, reportsDir, true, ReportOptions{}); err != nil {
+		t.Fatalf("runReportCommandWithOptions failed: %v", err)
+	}
+	content, err = os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	if strings.Contains(string(content), "Score History") {
+		t.Error("Expected no history section by default")
+	}
+}
+
+// TestRunReportCommand_EvalPassThreshold tests recomputing the eval pass rate at a stricter threshold
+func TestRunReportCommand_EvalPassThreshold(t *testing.T) {
+	reportsDir := t.TempDir()
+
+	evalData := []GradeTaskOutput{
+		{TaskID: "task-001", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: true, OverallScore: 95.0},
+		{TaskID: "task-002", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: true, OverallScore: 85.0},
+		{TaskID: "task-003", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: true, OverallScore: 75.0},
+		{TaskID: "task-004", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: false, OverallScore: 40.0},
+	}
+	data, err := json.Marshal(evalData)
+	if err != nil {
+		t.Fatalf("Failed to marshal eval data: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), data, 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		opts     ReportOptions
+		expected string
+	}{
+		{name: "stored result", opts: ReportOptions{}, expected: "**Pass Rate**: 75.0% (3/4 tasks)"},
+		{name: "stricter threshold", opts: ReportOptions{RecomputePassed: true, PassThreshold: 80}, expected: "**Pass Rate**: 50.0% (2/4 tasks)"},
+		{name: "threshold is inclusive", opts: ReportOptions{RecomputePassed: true, PassThreshold: 95}, expected: "**Pass Rate**: 25.0% (1/4 tasks)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputPath := filepath.Join(t.TempDir(), "report.md")
+			if err := runReportCommandWithOptions("eval", "markdown", false, outputPath, reportsDir, false, tt.opts); err != nil {
+				t.Fatalf("runReportCommandWithOptions failed: %v", err)
+			}
+			content, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatalf("Failed to read report: %v", err)
+			}
+			if !strings.Contains(string(content), tt.expected) {
+				t.Errorf("Expected output to contain %q, got:\n%s", tt.expected, content)
+			}
+		})
+	}
+}
+
+// TestRunReportCommand_EvalTagFilter tests restricting eval metrics and trends to tagged entries
+func TestRunReportCommand_EvalTagFilter(t *testing.T) {
+	reportsDir := t.TempDir()
+
+	evalData := []GradeTaskOutput{
+		{TaskID: "task-001", Timestamp: "2026-01-26T10:00:00Z", OverallPassed: true, OverallScore: 60.0, Tags: []string{"nightly"}},
+		{TaskID: "task-001", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: true, OverallScore: 80.0, Tags: []string{"nightly", "pre-release"}},
+		{TaskID: "task-002", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: true, OverallScore: 100.0, Tags: []string{"nightly"}},
+		// Untagged entries must be excluded from filtered metrics
+		{TaskID: "task-003", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: false, OverallScore: 10.0},
+	}
+	data, err := json.Marshal(evalData)
+	if err != nil {
+		t.Fatalf("Failed to marshal eval data: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), data, 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "report.md")
+	err = runReportCommandWithOptions("eval", "markdown", false, outputPath, reportsDir, true, ReportOptions{Tag: "nightly"})
+	if err != nil {
+		t.Fatalf("runReportCommandWithOptions failed: %v", err)
+	}
+
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	output := string(content)
+
+	expectedStrings := []string{
+		"**Average Score**: 90.0/100", // (80 + 100) / 2, task-003 excluded
+		"**Pass Rate**: 100.0% (2/2 tasks)",
+		"| task-001 | 60.0 | 80.0 |", // trend from tagged entries only
+	}
+	for _, expected := range expectedStrings {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected output to contain %q, got:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "task-003") {
+		t.Error("Untagged task should not appear in filtered report")
+	}
+
+	err = runReportCommandWithOptions("eval", "markdown", false, outputPath, reportsDir, false, ReportOptions{Tag: "weekly"})
+	if err == nil || !strings.Contains(err.Error(), "no eval results tagged") {
+		t.Errorf("Expected error for unknown tag, got %v", err)
+	}
+}
+
 // TestFormatEvalReportMarkdownWithTrends tests markdown formatting with trends
 func TestFormatEvalReportMarkdownWithTrends(t *testing.T) {
 	// Create test eval results
@@ -1369,7 +1810,7 @@ func TestFormatEvalReportMarkdownWithTrends(t *testing.T) {
 	}
 
 	// Test: Format as markdown with trends
-	output := formatEvalReportMarkdown(results, trends, true)
+	output := formatEvalReportMarkdown(results, trends, true, defaultRegressionThreshold)
 
 	// Verify trend section exists
 	if !strings.Contains(output, "## Trend Analysis") {
@@ -1431,7 +1872,7 @@ func TestFormatEvalReportJSON(t *testing.T) {
 	}
 
 	// Test: Format as JSON without trends
-	output, err := formatEvalReportJSON(results, nil, false)
+	output, err := formatEvalReportJSON(results, nil, false, nil)
 	if err != nil {
 		t.Fatalf("formatEvalReportJSON failed: %v", err)
 	}
@@ -1478,7 +1919,7 @@ func TestFormatEvalReportJSONWithTrends(t *testing.T) {
 	}
 
 	// Test: Format as JSON with trends
-	output, err := formatEvalReportJSON(results, trends, true)
+	output, err := formatEvalReportJSON(results, trends, true, nil)
 	if err != nil {
 		t.Fatalf("formatEvalReportJSON failed: %v", err)
 	}
@@ -1566,3 +2007,469 @@ Generated: 2026-01-26 10:00:00
 		t.Fatal("Expected output file to be created")
 	}
 }
+
+// TestRunReportCommandJSONWarnsAboutMissingTrends tests that trends which can't be loaded are listed in the JSON warnings
+func TestRunReportCommandJSONWarnsAboutMissingTrends(t *testing.T) {
+	tmpDir := t.TempDir()
+	reportsDir := filepath.Join(tmpDir, "reports")
+	if err := os.MkdirAll(reportsDir, 0755); err != nil {
+		t.Fatalf("Failed to create reports dir: %v", err)
+	}
+
+	// Create only one report, so there is no history to compute trends from
+	content := `# Skill Clarity Report
+
+Generated: 2026-01-26 10:00:00
+
+## Summary
+
+- **Total Skills**: 10
+- **Average Score**: 75.5/100
+- **Pass Rate**: 80.0% (8/10)
+- **Passing Threshold**: 70.0
+`
+	if err := os.WriteFile(filepath.Join(reportsDir, "skill-clarity-2026-01-26.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test report: %v", err)
+	}
+
+	for _, tc := range []struct {
+		name         string
+		enableTrends bool
+		wantWarnings []string
+	}{
+		{name: "trends enabled", enableTrends: true, wantWarnings: []string{"Could not load trend data: insufficient data for trend analysis (need at least 2 reports)"}},
+		{name: "trends disabled", enableTrends: false, wantWarnings: []string{}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			outputPath := filepath.Join(tmpDir, strings.ReplaceAll(tc.name, " ", "-")+".json")
+			if err := runReportCommand("grade", "json", false, outputPath, reportsDir, tc.enableTrends); err != nil {
+				t.Fatalf("runReportCommand failed: %v", err)
+			}
+
+			data, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Fatalf("Failed to read output: %v", err)
+			}
+			var output struct {
+				AverageScore float64   `json:"average_score"`
+				Warnings     *[]string `json:"warnings"`
+			}
+			if err := json.Unmarshal(data, &output); err != nil {
+				t.Fatalf("Output is not valid JSON: %v\n%s", err, data)
+			}
+			if output.AverageScore != 75.5 {
+				t.Errorf("Expected average score 75.5, got %.1f", output.AverageScore)
+			}
+			if output.Warnings == nil {
+				t.Fatalf("Expected a warnings array, got:\n%s", data)
+			}
+			if strings.Join(*output.Warnings, "\n") != strings.Join(tc.wantWarnings, "\n") {
+				t.Errorf("Expected warnings %q, got %q", tc.wantWarnings, *output.Warnings)
+			}
+		})
+	}
+}
+
+// TestRunReportCommand_AllJSON verifies --type all combines every report type into one JSON document
+func TestRunReportCommand_AllJSON(t *testing.T) {
+	reportsDir := t.TempDir()
+
+	gradeReport := `# Skill Clarity Report
+
+Generated: %s 21:30:43
+
+## Summary
+
+- **Total Skills**: 10
+- **Average Score**: %s/100
+- **Pass Rate**: 80.0%% (8/10)
+- **Passing Threshold**: 70.0
+`
+	for date, score := range map[string]string{"2026-01-25": "70.0", "2026-01-26": "75.5"} {
+		content := fmt.Sprintf(gradeReport, date, score)
+		if err := os.WriteFile(filepath.Join(reportsDir, "skill-clarity-"+date+".md"), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write grade report: %v", err)
+		}
+	}
+
+	metaLog := `[
+  {"timestamp": "2026-01-26T12:00:00Z", "agent": "yokay-spec-reviewer", "boundary_type": "agent", "consistency_percentage": 80.0, "consistent_count": 8, "total_count": 10},
+  {"timestamp": "2026-01-27T12:00:00Z", "agent": "yokay-spec-reviewer", "boundary_type": "agent", "consistency_percentage": 90.0, "consistent_count": 9, "total_count": 10}
+]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "consistency-log.json"), []byte(metaLog), 0644); err != nil {
+		t.Fatalf("Failed to write meta log: %v", err)
+	}
+
+	evalLog := `[
+  {"task_id": "task-1", "timestamp": "2026-01-26T10:00:00Z", "results": [], "overall_passed": true, "overall_score": 90.0},
+  {"task_id": "task-1", "timestamp": "2026-01-27T10:00:00Z", "results": [], "overall_passed": true, "overall_score": 95.0}
+]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), []byte(evalLog), 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "all.json")
+	if err := runReportCommand("all", "json", false, outputPath, reportsDir, true); err != nil {
+		t.Fatalf("runReportCommand failed: %v", err)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal(data, &document); err != nil {
+		t.Fatalf("Output is not a single JSON object: %v\n%s", err, data)
+	}
+	if document["schema_version"] != reportSchemaVersion {
+		t.Errorf("Expected top-level schema_version %q, got %v", reportSchemaVersion, document["schema_version"])
+	}
+	combined := make(map[string]map[string]interface{})
+	for name, value := range document {
+		if section, ok := value.(map[string]interface{}); ok {
+			combined[name] = section
+		}
+	}
+
+	for _, section := range []string{"grade", "meta", "eval", "trends"} {
+		if _, ok := combined[section]; !ok {
+			t.Errorf("Expected %q section in combined report, got: %s", section, data)
+		}
+	}
+	if combined["grade"]["average_score"] != 75.5 || combined["meta"]["report_type"] != "meta" || combined["eval"]["report_type"] != "eval" {
+		t.Errorf("Expected per-type report contents, got: %s", data)
+	}
+	for _, section := range []string{"grade", "meta", "eval"} {
+		if _, ok := combined[section]["trend"]; ok {
+			t.Errorf("Expected %s trend to be moved under trends", section)
+		}
+		if _, ok := combined["trends"][section]; !ok {
+			t.Errorf("Expected trends.%s in combined report", section)
+		}
+		if _, ok := combined[section]["schema_version"]; ok {
+			t.Errorf("Expected %s schema_version to be reported only at the top level", section)
+		}
+	}
+}
+
+// TestRunReportCommand_AllJSONPartialData verifies sections without data are omitted
+func TestRunReportCommand_AllJSONPartialData(t *testing.T) {
+	reportsDir := t.TempDir()
+	evalLog := `[{"task_id": "task-1", "timestamp": "2026-01-27T10:00:00Z", "results": [], "overall_passed": true, "overall_score": 95.0}]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), []byte(evalLog), 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "all.json")
+	if err := runReportCommand("all", "json", false, outputPath, reportsDir, false); err != nil {
+		t.Fatalf("runReportCommand failed: %v", err)
+	}
+
+	data, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read output: %v", err)
+	}
+	var combined map[string]interface{}
+	if err := json.Unmarshal(data, &combined); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if len(combined) != 3 || combined["eval"] == nil || combined["schema_version"] != reportSchemaVersion || combined["warnings"] == nil {
+		t.Errorf("Expected only the eval section, schema_version and warnings, got: %s", data)
+	}
+
+	if err := runReportCommand("all", "markdown", false, "", reportsDir, false); err == nil {
+		t.Error("Expected error for --type all with markdown format")
+	}
+	if err := runReportCommand("all", "json", false, "", t.TempDir(), false); err == nil {
+		t.Error("Expected error when no report data exists")
+	}
+}
+
+// TestParseGradeReportBOMAndCRLF verifies reports saved with a UTF-8 BOM and CRLF line endings parse fully
+func TestParseGradeReportBOMAndCRLF(t *testing.T) {
+	reportPath := filepath.Join(t.TempDir(), "skill-clarity-2026-01-26.md")
+	reportContent := "\ufeff# Skill Clarity Report\r\n\r\nGenerated: 2026-01-26 21:30:43\r\n\r\n" +
+		"## Summary\r\n\r\n- **Total Skills**: 1\r\n- **Average Score**: 80.0/100\r\n- **Pass Rate**: 100.0% (1/1)\r\n- **Passing Threshold**: 70.0\r\n\r\n" +
+		"## Detailed Breakdown\r\n\r\n### skill-one\r\n\r\n" +
+		"- **Clear Instructions** (weight: 30%): 80.0/100\r\n- **Actionable Steps** (weight: 25%): 75.0/100\r\n\r\n" +
+		"## Appendix\r\n\r\n- **Good Examples** (weight: 25%): 10.0/100\r\n"
+	if err := os.WriteFile(reportPath, []byte(reportContent), 0644); err != nil {
+		t.Fatalf("Failed to create test report: %v", err)
+	}
+
+	report, err := parseGradeReport(reportPath)
+	if err != nil {
+		t.Fatalf("parseGradeReport failed: %v", err)
+	}
+
+	if report.GeneratedDate != "2026-01-26 21:30:43" {
+		t.Errorf("Expected GeneratedDate '2026-01-26 21:30:43', got %q", report.GeneratedDate)
+	}
+	if report.TotalSkills != 1 || report.AverageScore != 80.0 || report.PassRate != 100.0 || report.PassingThreshold != 70.0 {
+		t.Errorf("Unexpected summary metrics: %+v", report)
+	}
+	if len(report.CriteriaScores) != 2 {
+		t.Fatalf("Expected 2 criteria scores (Appendix excluded), got %d: %+v", len(report.CriteriaScores), report.CriteriaScores)
+	}
+	if report.CriteriaScores[0].Name != "Clear Instructions" || report.CriteriaScores[0].Average != 80.0 {
+		t.Errorf("Unexpected first criteria score: %+v", report.CriteriaScores[0])
+	}
+}
+
+// TestParseReportDateRange tests --since/--until parsing and validation
+func TestParseReportDateRange(t *testing.T) {
+	since, until, err := parseReportDateRange("2026-01-26", "2026-01-27")
+	if err != nil {
+		t.Fatalf("parseReportDateRange failed: %v", err)
+	}
+	if !since.Equal(time.Date(2026, 1, 26, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected since at start of 2026-01-26, got %v", since)
+	}
+	if !until.Equal(time.Date(2026, 1, 27, 23, 59, 59, 999999999, time.UTC)) {
+		t.Errorf("Expected until at end of 2026-01-27, got %v", until)
+	}
+
+	since, until, err = parseReportDateRange("2026-01-26T12:00:00+02:00", "")
+	if err != nil {
+		t.Fatalf("parseReportDateRange failed: %v", err)
+	}
+	if !since.Equal(time.Date(2026, 1, 26, 10, 0, 0, 0, time.UTC)) || !until.IsZero() {
+		t.Errorf("Expected RFC3339 since and open until, got %v and %v", since, until)
+	}
+
+	errorCases := map[string][2]string{
+		"--since: invalid date \"last week\"":  {"last week", ""},
+		"--until: invalid date \"01/27/2026\"": {"", "01/27/2026"},
+		"is before --since":                    {"2026-01-27", "2026-01-26"},
+	}
+	for want, args := range errorCases {
+		if _, _, err := parseReportDateRange(args[0], args[1]); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("parseReportDateRange(%q, %q): expected error containing %q, got %v", args[0], args[1], want, err)
+		}
+	}
+}
+
+// TestRunReportCommand_DateRange tests that eval and meta reports only use entries in range
+func TestRunReportCommand_DateRange(t *testing.T) {
+	reportsDir := t.TempDir()
+
+	evalData := []GradeTaskOutput{
+		{TaskID: "task-001", Timestamp: "2026-01-19T10:00:00Z", OverallPassed: false, OverallScore: 20.0},
+		{TaskID: "task-001", Timestamp: "2026-01-26T10:00:00Z", OverallPassed: true, OverallScore: 70.0},
+		{TaskID: "task-001", Timestamp: "2026-01-27T10:00:00Z", OverallPassed: true, OverallScore: 90.0},
+		{TaskID: "task-001", Timestamp: "2026-02-02T10:00:00Z", OverallPassed: true, OverallScore: 100.0},
+	}
+	metaData := []ConsistencyResult{
+		{Timestamp: "2026-01-19T10:00:00Z", Agent: "yokay-spec-reviewer", ConsistencyPercentage: 10.0, ConsistentCount: 1, TotalCount: 10},
+		{Timestamp: "2026-01-27T10:00:00Z", Agent: "yokay-spec-reviewer", ConsistencyPercentage: 90.0, ConsistentCount: 9, TotalCount: 10},
+	}
+	for name, entries := range map[string]interface{}{"task-eval-log.json": evalData, "consistency-log.json": metaData} {
+		data, err := json.Marshal(entries)
+		if err != nil {
+			t.Fatalf("Failed to marshal %s: %v", name, err)
+		}
+		if err := os.WriteFile(filepath.Join(reportsDir, name), data, 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	since, until, err := parseReportDateRange("2026-01-26", "2026-01-27")
+	if err != nil {
+		t.Fatalf("parseReportDateRange failed: %v", err)
+	}
+	opts := ReportOptions{Since: since, Until: until}
+
+	outputPath := filepath.Join(t.TempDir(), "report.md")
+	if err := runReportCommandWithOptions("eval", "markdown", false, outputPath, reportsDir, true, opts); err != nil {
+		t.Fatalf("runReportCommandWithOptions failed: %v", err)
+	}
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	// Current metrics come from the latest entry in range, trends from the two in range
+	for _, expected := range []string{"**Average Score**: 90.0/100", "| task-001 | 70.0 | 90.0 |"} {
+		if !strings.Contains(string(content), expected) {
+			t.Errorf("Expected eval report to contain %q, got:\n%s", expected, content)
+		}
+	}
+
+	metaResults, _, err := loadMetaReportData(reportsDir, false, opts, nil)
+	if err != nil {
+		t.Fatalf("loadMetaReportData failed: %v", err)
+	}
+	if len(metaResults) != 1 || metaResults[0].ConsistencyPercentage != 90.0 {
+		t.Errorf("Expected only the in-range meta result, got %+v", metaResults)
+	}
+
+	// A range with no entries is reported instead of dividing by zero
+	since, until, _ = parseReportDateRange("2025-01-01", "2025-01-31")
+	err = runReportCommandWithOptions("eval", "markdown", false, outputPath, reportsDir, true, ReportOptions{Since: since, Until: until})
+	if err == nil || !strings.Contains(err.Error(), "no results in range") {
+		t.Errorf("Expected no results in range error, got %v", err)
+	}
+}
+
+// TestJSONReportsSchemaVersion verifies every JSON report carries the current schema version
+func TestJSONReportsSchemaVersion(t *testing.T) {
+	reportsDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(reportsDir, "skill-clarity-2026-01-27.md"), []byte("# Skill Clarity Report\n"), 0644); err != nil {
+		t.Fatalf("Failed to write grade report: %v", err)
+	}
+
+	formatters := map[string]func() (string, error){
+		"grade": func() (string, error) {
+			return formatReportSummaryJSON(GradeReport{FilePath: "skill-clarity-2026-01-27.md"}, nil, false, nil)
+		},
+		"meta": func() (string, error) {
+			return formatMetaReportJSON([]ConsistencyResult{{Timestamp: "2026-01-27T10:00:00Z", Agent: "yokay-test-agent"}}, nil, false, nil)
+		},
+		"eval": func() (string, error) {
+			return formatEvalReportJSON([]GradeTaskOutput{{TaskID: "task-1", Timestamp: "2026-01-27T10:00:00Z"}}, nil, false, nil)
+		},
+		"list": func() (string, error) {
+			return listGradeReportsJSON(reportsDir, defaultGradeReportPattern)
+		},
+		"all": func() (string, error) {
+			return formatAllReportsJSON(reportsDir, defaultGradeReportPattern, false, ReportOptions{})
+		},
+		"meta run": func() (string, error) {
+			return formatMetaReportJSONResult(EvaluationResult{Agent: "yokay-test-agent"})
+		},
+		"eval run": func() (string, error) {
+			return formatEvalSummaryJSON([]EvalResult{{CaseID: "case-1", Category: "missing-tests"}}, nil), nil
+		},
+		"grade-skills": func() (string, error) {
+			reportPath := filepath.Join(t.TempDir(), "skill-clarity.json")
+			if err := generateReportJSON([]skillResult{{Name: "skill-a", Score: 80, Passed: true}}, reportPath, SkillReportOptions{}); err != nil {
+				return "", err
+			}
+			data, err := os.ReadFile(reportPath)
+			return string(data), err
+		},
+	}
+
+	for name, format := range formatters {
+		t.Run(name, func(t *testing.T) {
+			output, err := format()
+			if err != nil {
+				t.Fatalf("Formatting %s report failed: %v", name, err)
+			}
+			var document map[string]interface{}
+			if err := json.Unmarshal([]byte(output), &document); err != nil {
+				t.Fatalf("Invalid JSON: %v\n%s", err, output)
+			}
+			if document["schema_version"] != reportSchemaVersion {
+				t.Errorf("Expected schema_version %q, got %v", reportSchemaVersion, document["schema_version"])
+			}
+		})
+	}
+
+	// A meta suite is printed as an array, so each report carries the version
+	data, err := json.Marshal([]MetaReportJSON{newMetaReportJSON(EvaluationResult{Agent: "a"}), newMetaReportJSON(EvaluationResult{Agent: "b"})})
+	if err != nil {
+		t.Fatalf("Marshaling meta suite failed: %v", err)
+	}
+	var suite []map[string]interface{}
+	if err := json.Unmarshal(data, &suite); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	for _, report := range suite {
+		if report["schema_version"] != reportSchemaVersion {
+			t.Errorf("Expected schema_version %q on %v's report, got %v", reportSchemaVersion, report["agent"], report["schema_version"])
+		}
+	}
+}
+
+// TestRunReportCommand_FailOnRegression verifies --fail-on-regression fails after writing the report
+// when a trend regresses beyond the threshold, and is a no-op without trends
+func TestRunReportCommand_FailOnRegression(t *testing.T) {
+	reportsDir := t.TempDir()
+	logContent := `[
+		{"task_id": "task-001", "timestamp": "2026-01-26T10:00:00Z", "results": [], "overall_passed": true, "overall_score": 90.0},
+		{"task_id": "task-002", "timestamp": "2026-01-26T10:00:00Z", "results": [], "overall_passed": true, "overall_score": 80.0},
+		{"task_id": "task-001", "timestamp": "2026-01-27T10:00:00Z", "results": [], "overall_passed": true, "overall_score": 91.0},
+		{"task_id": "task-002", "timestamp": "2026-01-27T10:00:00Z", "results": [], "overall_passed": false, "overall_score": 60.0}
+	]`
+	if err := os.WriteFile(filepath.Join(reportsDir, "task-eval-log.json"), []byte(logContent), 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "eval-report.md")
+	err := runReportCommandWithOptions("eval", "markdown", false, outputPath, reportsDir, true, ReportOptions{FailOnRegression: true})
+	if !errors.Is(err, errRegressionDetected) {
+		t.Fatalf("Expected regression error, got %v", err)
+	}
+	// Average 85 -> 75.5 (-11.2%), pass rate 100 -> 50 (-50%), task-002 80 -> 60 (-25%)
+	for _, want := range []string{"eval average_score -11.2%", "eval pass_rate -50.0%", "eval task/task-002 -25.0%"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to list %q, got %v", want, err)
+		}
+	}
+	if strings.Contains(err.Error(), "task-001") {
+		t.Errorf("Expected improving task-001 not to be listed, got %v", err)
+	}
+	if content, readErr := os.ReadFile(outputPath); readErr != nil || !strings.Contains(string(content), "## Trend Analysis") {
+		t.Errorf("Expected full report to be written before failing, got %v", readErr)
+	}
+
+	// All report types are gated too
+	err = runReportCommandWithOptions("all", "json", false, filepath.Join(t.TempDir(), "all.json"), reportsDir, true, ReportOptions{FailOnRegression: true})
+	if !errors.Is(err, errRegressionDetected) {
+		t.Errorf("Expected regression error for --type all, got %v", err)
+	}
+
+	noGate := []struct {
+		name         string
+		enableTrends bool
+		opts         ReportOptions
+	}{
+		{"flag not set", true, ReportOptions{}},
+		{"trends disabled", false, ReportOptions{FailOnRegression: true}},
+		{"threshold above drops", true, ReportOptions{FailOnRegression: true, RegressionThreshold: 60}},
+	}
+	for _, tt := range noGate {
+		t.Run(tt.name, func(t *testing.T) {
+			err := runReportCommandWithOptions("eval", "markdown", false, filepath.Join(t.TempDir(), "eval-report.md"), reportsDir, tt.enableTrends, tt.opts)
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+		})
+	}
+
+	// The markdown trend markers flag what the gate's threshold flags
+	for _, tt := range []struct {
+		threshold float64
+		flagged   bool
+	}{{0, true}, {60, false}} {
+		markdownPath := filepath.Join(t.TempDir(), "eval-report.md")
+		if err := runReportCommandWithOptions("eval", "markdown", false, markdownPath, reportsDir, true, ReportOptions{RegressionThreshold: tt.threshold}); err != nil {
+			t.Fatalf("runReportCommandWithOptions failed: %v", err)
+		}
+		content, err := os.ReadFile(markdownPath)
+		if err != nil {
+			t.Fatalf("Failed to read report: %v", err)
+		}
+		if got := strings.Contains(string(content), "⚠"); got != tt.flagged {
+			t.Errorf("threshold %g: expected regression markers %v, got:\n%s", tt.threshold, tt.flagged, content)
+		}
+	}
+
+	// A single run has no history to regress from
+	singleRunDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(singleRunDir, "task-eval-log.json"), []byte(`[
+		{"task_id": "task-001", "timestamp": "2026-01-26T10:00:00Z", "results": [], "overall_passed": false, "overall_score": 10.0}
+	]`), 0644); err != nil {
+		t.Fatalf("Failed to write eval log: %v", err)
+	}
+	err = runReportCommandWithOptions("eval", "markdown", false, filepath.Join(t.TempDir(), "eval-report.md"), singleRunDir, true, ReportOptions{FailOnRegression: true})
+	if err != nil {
+		t.Errorf("Expected no error with insufficient history, got %v", err)
+	}
+
+	err = runReportCommandWithOptions("eval", "markdown", false, outputPath, reportsDir, true, ReportOptions{RegressionThreshold: -1})
+	if err == nil || !strings.Contains(err.Error(), "regression threshold must be non-negative") {
+		t.Errorf("Expected negative threshold error, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// SearchOptions holds the settings for the search command
+type SearchOptions struct {
+	// Query is matched as a case-insensitive substring of each failure's details
+	Query string
+	// Category restricts the results to failures in this category
+	Category string
+	// Limit caps the number of results, newest first (0 returns all)
+	Limit int
+	// Format is the output format: "text" (default) or "json"
+	Format string
+}
+
+// runSearchCommandWithConfig prints the failures whose details match the query, newest first
+func runSearchCommandWithConfig(dbPath string, opts SearchOptions) error {
+	if strings.TrimSpace(opts.Query) == "" {
+		return fmt.Errorf("--query is required")
+	}
+	if opts.Limit < 0 {
+		return fmt.Errorf("limit must be non-negative")
+	}
+	format := opts.Format
+	if format == "" {
+		format = "text"
+	}
+	if format != "text" && format != "json" {
+		return fmt.Errorf("unsupported format: %s (use 'text' or 'json')", format)
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return fmt.Errorf("opening database: %w", err)
+	}
+	defer store.Close()
+
+	found, err := store.Search(opts.Query)
+	if err != nil {
+		return fmt.Errorf("searching failures: %w", err)
+	}
+
+	records := []ExportedFailure{}
+	for _, f := range found {
+		if opts.Category != "" && f.Category != opts.Category {
+			continue
+		}
+		if opts.Limit > 0 && len(records) == opts.Limit {
+			break
+		}
+		records = append(records, newExportedFailure(f))
+	}
+
+	if format == "json" {
+		return writeFailuresJSON(os.Stdout, records)
+	}
+	writeSearchResults(os.Stdout, opts.Query, records)
+	return nil
+}
+
+// writeSearchResults writes matching failures as text, one header line per failure followed by its details
+func writeSearchResults(w io.Writer, query string, records []ExportedFailure) {
+	if len(records) == 0 {
+		fmt.Fprintf(w, "No failures matching %q\n", query)
+		return
+	}
+
+	fmt.Fprintf(w, "Found %d failures matching %q:\n", len(records), query)
+	for _, r := range records {
+		createdAt := r.CreatedAt
+		if t, err := time.Parse(time.RFC3339, r.CreatedAt); err == nil {
+			createdAt = t.Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "\n#%d %s %s (task %s, %s)\n", r.ID, createdAt, r.Category, r.TaskID, r.Source)
+		for _, line := range strings.Split(r.Details, "\n") {
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+}
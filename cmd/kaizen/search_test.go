@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// captureSearch runs the search command and returns its stdout
+func captureSearch(t *testing.T, dbPath string, opts SearchOptions) (string, error) {
+	t.Helper()
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runSearchCommandWithConfig(dbPath, opts)
+
+	w.Close()
+	os.Stdout = oldStdout
+
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+	return buf.String(), err
+}
+
+// TestRunSearchCommand verifies matches are newest first and --category and --limit narrow them
+func TestRunSearchCommand(t *testing.T) {
+	createdAt := time.Date(2026, 3, 2, 9, 30, 0, 0, time.UTC)
+	dbPath := seedExportStore(t, []failures.Failure{
+		{TaskID: "task-1", Category: "missing-tests", Details: "Timeout in auth tests", Source: "quality-review", CreatedAt: createdAt},
+		{TaskID: "task-2", Category: "scope-creep", Details: "added retry on timeout", Source: "spec-review", CreatedAt: createdAt.Add(time.Hour)},
+		{TaskID: "task-3", Category: "missing-tests", Details: "timeout handling\nnot tested", Source: "quality-review", CreatedAt: createdAt.Add(2 * time.Hour)},
+		{TaskID: "task-4", Category: "missing-tests", Details: "no tests at all", Source: "quality-review", CreatedAt: createdAt.Add(3 * time.Hour)},
+	})
+
+	output, err := captureSearch(t, dbPath, SearchOptions{Query: "TIMEOUT", Format: "json"})
+	if err != nil {
+		t.Fatalf("runSearchCommandWithConfig failed: %v", err)
+	}
+	var records []ExportedFailure
+	if err := json.Unmarshal([]byte(output), &records); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, output)
+	}
+	var taskIDs []string
+	for _, r := range records {
+		taskIDs = append(taskIDs, r.TaskID)
+	}
+	if got := strings.Join(taskIDs, ","); got != "task-3,task-2,task-1" {
+		t.Errorf("Expected task-3,task-2,task-1, got %s", got)
+	}
+
+	output, err = captureSearch(t, dbPath, SearchOptions{Query: "timeout", Category: "missing-tests", Limit: 1})
+	if err != nil {
+		t.Fatalf("runSearchCommandWithConfig failed: %v", err)
+	}
+	expected := "Found 1 failures matching \"timeout\":\n\n#3 2026-03-02 11:30 missing-tests (task task-3, quality-review)\n  timeout handling\n  not tested\n"
+	if output != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, output)
+	}
+
+	output, err = captureSearch(t, dbPath, SearchOptions{Query: "100%"})
+	if err != nil {
+		t.Fatalf("runSearchCommandWithConfig failed: %v", err)
+	}
+	if output != "No failures matching \"100%\"\n" {
+		t.Errorf("Expected no matches for a literal %%, got: %s", output)
+	}
+}
+
+// TestRunSearchCommandInvalidOptions verifies a missing query, negative limit and unknown format are rejected
+func TestRunSearchCommandInvalidOptions(t *testing.T) {
+	dbPath := seedExportStore(t, nil)
+
+	for _, opts := range []SearchOptions{
+		{Query: "  "},
+		{Query: "timeout", Limit: -1},
+		{Query: "timeout", Format: "csv"},
+	} {
+		if _, err := captureSearch(t, dbPath, opts); err == nil {
+			t.Errorf("Expected error for %+v", opts)
+		}
+	}
+}
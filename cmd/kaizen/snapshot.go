@@ -0,0 +1,159 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// snapshotVersion is the "version" of the snapshot file format, checked on restore
+const snapshotVersion = 1
+
+// SnapshotFile is the portable JSON form of a whole failures database
+type SnapshotFile struct {
+	Version       int                    `json:"version"`
+	CreatedAt     string                 `json:"created_at"`
+	Failures      []ExportedFailure      `json:"failures"`
+	CategoryStats []SnapshotCategoryStat `json:"category_stats"`
+}
+
+// SnapshotCategoryStat is a category_stats row as written to a snapshot
+type SnapshotCategoryStat struct {
+	Category        string `json:"category"`
+	OccurrenceCount int    `json:"occurrence_count"`
+	FirstSeen       string `json:"first_seen"`
+	LastSeen        string `json:"last_seen"`
+}
+
+// newSnapshotFile converts a store snapshot, formatting times as RFC3339 in UTC with
+// sub-second precision so a restore reproduces them exactly
+func newSnapshotFile(snapshot failures.Snapshot, createdAt time.Time) SnapshotFile {
+	file := SnapshotFile{
+		Version:       snapshotVersion,
+		CreatedAt:     createdAt.UTC().Format(time.RFC3339),
+		Failures:      make([]ExportedFailure, 0, len(snapshot.Failures)),
+		CategoryStats: make([]SnapshotCategoryStat, 0, len(snapshot.CategoryStats)),
+	}
+	for _, f := range snapshot.Failures {
+		record := newExportedFailure(f)
+		record.CreatedAt = f.CreatedAt.UTC().Format(time.RFC3339Nano)
+		file.Failures = append(file.Failures, record)
+	}
+	for _, stat := range snapshot.CategoryStats {
+		file.CategoryStats = append(file.CategoryStats, SnapshotCategoryStat{
+			Category:        stat.Category,
+			OccurrenceCount: stat.OccurrenceCount,
+			FirstSeen:       stat.FirstSeen.UTC().Format(time.RFC3339Nano),
+			LastSeen:        stat.LastSeen.UTC().Format(time.RFC3339Nano),
+		})
+	}
+	return file
+}
+
+// storeSnapshot converts a snapshot file back to the store's form
+func (file SnapshotFile) storeSnapshot() (failures.Snapshot, error) {
+	if file.Version != snapshotVersion {
+		return failures.Snapshot{}, fmt.Errorf("unsupported snapshot version %d (expected %d)", file.Version, snapshotVersion)
+	}
+
+	snapshot := failures.Snapshot{
+		Failures:      make([]failures.Failure, 0, len(file.Failures)),
+		CategoryStats: make([]failures.CategoryStat, 0, len(file.CategoryStats)),
+	}
+	for _, r := range file.Failures {
+		createdAt, err := time.Parse(time.RFC3339Nano, r.CreatedAt)
+		if err != nil {
+			return failures.Snapshot{}, fmt.Errorf("failure %d: invalid created_at %q", r.ID, r.CreatedAt)
+		}
+		snapshot.Failures = append(snapshot.Failures, failures.Failure{
+			ID:        r.ID,
+			TaskID:    r.TaskID,
+			Category:  r.Category,
+			Details:   r.Details,
+			Source:    r.Source,
+			CreatedAt: createdAt,
+		})
+	}
+	for _, stat := range file.CategoryStats {
+		firstSeen, err := time.Parse(time.RFC3339Nano, stat.FirstSeen)
+		if err != nil {
+			return failures.Snapshot{}, fmt.Errorf("category %q: invalid first_seen %q", stat.Category, stat.FirstSeen)
+		}
+		lastSeen, err := time.Parse(time.RFC3339Nano, stat.LastSeen)
+		if err != nil {
+			return failures.Snapshot{}, fmt.Errorf("category %q: invalid last_seen %q", stat.Category, stat.LastSeen)
+		}
+		snapshot.CategoryStats = append(snapshot.CategoryStats, failures.CategoryStat{
+			Category:        stat.Category,
+			OccurrenceCount: stat.OccurrenceCount,
+			FirstSeen:       firstSeen,
+			LastSeen:        lastSeen,
+		})
+	}
+	return snapshot, nil
+}
+
+// runSnapshotCommandWithConfig writes every failure and category stat in the database to outputPath
+func runSnapshotCommandWithConfig(dbPath, outputPath string) error {
+	if outputPath == "" {
+		return fmt.Errorf("--output is required")
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return fmt.Errorf("opening database: %w", err)
+	}
+	defer store.Close()
+
+	snapshot, err := store.Snapshot()
+	if err != nil {
+		return fmt.Errorf("reading database: %w", err)
+	}
+
+	data, err := json.MarshalIndent(newSnapshotFile(snapshot, time.Now()), "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding snapshot: %w", err)
+	}
+	if err := os.WriteFile(outputPath, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing snapshot: %w", err)
+	}
+
+	fmt.Printf("Snapshot of %d failures and %d categories written to %s\n", len(snapshot.Failures), len(snapshot.CategoryStats), outputPath)
+	return nil
+}
+
+// runRestoreCommandWithConfig loads a snapshot written by snapshot into an empty database
+func runRestoreCommandWithConfig(dbPath, inputPath string) error {
+	if inputPath == "" {
+		return fmt.Errorf("--input is required")
+	}
+
+	data, err := os.ReadFile(inputPath)
+	if err != nil {
+		return fmt.Errorf("reading snapshot: %w", err)
+	}
+	var file SnapshotFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return fmt.Errorf("parsing snapshot %s: %w", inputPath, err)
+	}
+	snapshot, err := file.storeSnapshot()
+	if err != nil {
+		return fmt.Errorf("invalid snapshot %s: %w", inputPath, err)
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return fmt.Errorf("opening database: %w", err)
+	}
+	defer store.Close()
+
+	if err := store.Restore(snapshot); err != nil {
+		return fmt.Errorf("restoring snapshot: %w", err)
+	}
+
+	fmt.Printf("Restored %d failures and %d categories from %s\n", len(snapshot.Failures), len(snapshot.CategoryStats), inputPath)
+	return nil
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// TestSnapshotRestoreRoundTrip verifies a snapshot restored into a fresh database has the same
+// failures and category stats
+func TestSnapshotRestoreRoundTrip(t *testing.T) {
+	createdAt := time.Date(2026, 3, 2, 9, 30, 0, 123456789, time.UTC)
+	sourcePath := seedExportStore(t, []failures.Failure{
+		{TaskID: "task-1", Category: "missing-tests", Details: "no tests", Source: "quality-review", CreatedAt: createdAt},
+		{TaskID: "task-2", Category: "scope-creep", Details: "extra work,\n\"quoted\"", Source: "spec-review", CreatedAt: createdAt.Add(time.Hour)},
+	})
+	source, err := failures.NewStore(sourcePath)
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	for _, category := range []string{"missing-tests", "missing-tests", "scope-creep"} {
+		if err := source.IncrementCount(category); err != nil {
+			t.Fatalf("IncrementCount failed: %v", err)
+		}
+	}
+	want, err := source.Snapshot()
+	source.Close()
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+
+	snapshotPath := filepath.Join(t.TempDir(), "failures.snapshot")
+	if err := runSnapshotCommandWithConfig(sourcePath, snapshotPath); err != nil {
+		t.Fatalf("runSnapshotCommandWithConfig failed: %v", err)
+	}
+
+	targetPath := seedExportStore(t, nil)
+	if err := runRestoreCommandWithConfig(targetPath, snapshotPath); err != nil {
+		t.Fatalf("runRestoreCommandWithConfig failed: %v", err)
+	}
+
+	target, err := failures.NewStore(targetPath)
+	if err != nil {
+		t.Fatalf("Failed to open restored store: %v", err)
+	}
+	defer target.Close()
+	got, err := target.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot of restored store failed: %v", err)
+	}
+
+	if len(got.Failures) != len(want.Failures) || len(got.CategoryStats) != len(want.CategoryStats) {
+		t.Fatalf("Restored %+v, want %+v", got, want)
+	}
+	for i := range want.Failures {
+		g, w := got.Failures[i], want.Failures[i]
+		if g.ID != w.ID || g.TaskID != w.TaskID || g.Category != w.Category || g.Details != w.Details ||
+			g.Source != w.Source || !g.CreatedAt.Equal(w.CreatedAt) {
+			t.Errorf("Failure %d = %+v, want %+v", i, g, w)
+		}
+	}
+	for i := range want.CategoryStats {
+		g, w := got.CategoryStats[i], want.CategoryStats[i]
+		if g.Category != w.Category || g.OccurrenceCount != w.OccurrenceCount ||
+			!g.FirstSeen.Equal(w.FirstSeen) || !g.LastSeen.Equal(w.LastSeen) {
+			t.Errorf("Category stat %d = %+v, want %+v", i, g, w)
+		}
+	}
+}
+
+// TestRunRestoreCommandRejects verifies restore refuses a non-empty database and an unknown snapshot version
+func TestRunRestoreCommandRejects(t *testing.T) {
+	dbPath := seedExportStore(t, []failures.Failure{
+		{TaskID: "task-1", Category: "missing-tests", Details: "no tests", Source: "quality-review"},
+	})
+	snapshotPath := filepath.Join(t.TempDir(), "failures.snapshot")
+	if err := runSnapshotCommandWithConfig(dbPath, snapshotPath); err != nil {
+		t.Fatalf("runSnapshotCommandWithConfig failed: %v", err)
+	}
+
+	err := runRestoreCommandWithConfig(dbPath, snapshotPath)
+	if err == nil || !strings.Contains(err.Error(), "not empty") {
+		t.Errorf("Expected restore into a non-empty database to fail, got %v", err)
+	}
+
+	futurePath := filepath.Join(t.TempDir(), "future.snapshot")
+	if err := os.WriteFile(futurePath, []byte(`{"version": 99, "failures": [], "category_stats": []}`), 0644); err != nil {
+		t.Fatalf("Failed to write snapshot: %v", err)
+	}
+	err = runRestoreCommandWithConfig(seedExportStore(t, nil), futurePath)
+	if err == nil || !strings.Contains(err.Error(), "unsupported snapshot version 99") {
+		t.Errorf("Expected an unsupported version error, got %v", err)
+	}
+}
@@ -0,0 +1,242 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// StatsOptions holds optional settings for the stats command
+type StatsOptions struct {
+	// Bucket is the time bucket size: "day" (default), "week" or "month"
+	Bucket string
+	// Format is the output format: "text" (default), "json", or "csv" for per-category stats
+	Format string
+	// Top, when positive, lists the N most frequent categories instead of time buckets
+	// (with csv, limits the rows to them)
+	Top int
+}
+
+// StatsBucketJSON is the failure count of a single time bucket
+type StatsBucketJSON struct {
+	Start      string         `json:"start"`
+	Count      int            `json:"count"`
+	ByCategory map[string]int `json:"by_category"`
+}
+
+// StatsJSON is the structured stats output consumed by the dashboard timeline
+type StatsJSON struct {
+	Bucket     string            `json:"bucket"`
+	Total      int               `json:"total"`
+	ByCategory map[string]int    `json:"by_category"`
+	Buckets    []StatsBucketJSON `json:"buckets"`
+}
+
+// StatsCategoryJSON is a category's share of all recorded occurrences
+type StatsCategoryJSON struct {
+	Category   string  `json:"category"`
+	Count      int     `json:"count"`
+	Percentage float64 `json:"percentage"`
+	FirstSeen  string  `json:"first_seen"`
+	LastSeen   string  `json:"last_seen"`
+}
+
+// StatsTopJSON is the structured output of stats --top
+type StatsTopJSON struct {
+	Total      int                 `json:"total"`
+	Categories []StatsCategoryJSON `json:"categories"`
+}
+
+// runStatsCommandWithConfig summarizes captured failures, bucketed by time
+func runStatsCommandWithConfig(dbPath string, opts StatsOptions) (string, error) {
+	if opts.Top < 0 {
+		return "", fmt.Errorf("top must not be negative, got: %d", opts.Top)
+	}
+
+	bucket := opts.Bucket
+	if bucket == "" {
+		bucket = "day"
+	}
+	if !failures.ValidStatsBucket(bucket) {
+		return "", fmt.Errorf("unsupported bucket: %s (use 'day', 'week' or 'month')", bucket)
+	}
+
+	format := opts.Format
+	if format == "" {
+		format = "text"
+	}
+	if format != "text" && format != "json" && format != "csv" {
+		return "", fmt.Errorf("unsupported format: %s (use 'text', 'json' or 'csv')", format)
+	}
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		return "", fmt.Errorf("opening database: %w", err)
+	}
+	defer store.Close()
+
+	if format == "csv" {
+		return formatCategoryStatsCSV(store, opts.Top)
+	}
+
+	if opts.Top > 0 {
+		return formatTopCategories(store, opts.Top, format)
+	}
+
+	stats, err := store.Stats(bucket)
+	if err != nil {
+		return "", fmt.Errorf("computing stats: %w", err)
+	}
+
+	if format == "json" {
+		result := StatsJSON{
+			Bucket:     bucket,
+			Total:      stats.Total,
+			ByCategory: stats.ByCategory,
+			Buckets:    make([]StatsBucketJSON, 0, len(stats.Buckets)),
+		}
+		for _, b := range stats.Buckets {
+			result.Buckets = append(result.Buckets, StatsBucketJSON{
+				Start:      b.Start.Format("2006-01-02"),
+				Count:      b.Count,
+				ByCategory: b.ByCategory,
+			})
+		}
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("encoding stats: %w", err)
+		}
+		return string(data) + "\n", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Failure Stats\n")
+	sb.WriteString("=============\n\n")
+	if stats.Total == 0 {
+		sb.WriteString("No failures captured.\n")
+		return sb.String(), nil
+	}
+
+	sb.WriteString(fmt.Sprintf("Total failures: %d\n\n", stats.Total))
+
+	categories := make([]string, 0, len(stats.ByCategory))
+	for category := range stats.ByCategory {
+		categories = append(categories, category)
+	}
+	sort.Slice(categories, func(i, j int) bool {
+		if stats.ByCategory[categories[i]] != stats.ByCategory[categories[j]] {
+			return stats.ByCategory[categories[i]] > stats.ByCategory[categories[j]]
+		}
+		return categories[i] < categories[j]
+	})
+	sb.WriteString(fmt.Sprintf("%-24s %s\n", "Category", "Failures"))
+	for _, category := range categories {
+		sb.WriteString(fmt.Sprintf("%-24s %d\n", category, stats.ByCategory[category]))
+	}
+
+	sb.WriteString(fmt.Sprintf("\n%-24s %s\n", "Per "+bucket, "Failures"))
+	for _, b := range stats.Buckets {
+		sb.WriteString(fmt.Sprintf("%-24s %d\n", b.Start.Format("2006-01-02"), b.Count))
+	}
+	return sb.String(), nil
+}
+
+// statsCSVHeader is the header row of stats --format csv
+var statsCSVHeader = []string{"category", "occurrence_count", "first_seen", "last_seen"}
+
+// formatCategoryStatsCSV writes one row of aggregate stats per category, most frequent first,
+// limited to the top n categories when n is positive. Fields are quoted as needed.
+func formatCategoryStatsCSV(store *failures.Store, n int) (string, error) {
+	var stats []failures.CategoryStat
+	var err error
+	if n > 0 {
+		stats, err = store.TopCategories(n)
+	} else {
+		stats, err = store.CategoryStats()
+	}
+	if err != nil {
+		return "", fmt.Errorf("listing category stats: %w", err)
+	}
+
+	var sb strings.Builder
+	writer := csv.NewWriter(&sb)
+	if err := writer.Write(statsCSVHeader); err != nil {
+		return "", fmt.Errorf("writing CSV header: %w", err)
+	}
+	for _, stat := range stats {
+		row := []string{
+			stat.Category,
+			strconv.Itoa(stat.OccurrenceCount),
+			stat.FirstSeen.UTC().Format(time.RFC3339),
+			stat.LastSeen.UTC().Format(time.RFC3339),
+		}
+		if err := writer.Write(row); err != nil {
+			return "", fmt.Errorf("writing CSV row: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", fmt.Errorf("writing CSV: %w", err)
+	}
+	return sb.String(), nil
+}
+
+// formatTopCategories lists the n most frequent categories with their share of all occurrences
+func formatTopCategories(store *failures.Store, n int, format string) (string, error) {
+	top, err := store.TopCategories(n)
+	if err != nil {
+		return "", fmt.Errorf("listing top categories: %w", err)
+	}
+	total, err := store.TotalOccurrences()
+	if err != nil {
+		return "", fmt.Errorf("counting occurrences: %w", err)
+	}
+
+	result := StatsTopJSON{
+		Total:      total,
+		Categories: make([]StatsCategoryJSON, 0, len(top)),
+	}
+	for _, stat := range top {
+		percentage := 0.0
+		if total > 0 {
+			percentage = float64(stat.OccurrenceCount) / float64(total) * 100
+		}
+		result.Categories = append(result.Categories, StatsCategoryJSON{
+			Category:   stat.Category,
+			Count:      stat.OccurrenceCount,
+			Percentage: percentage,
+			FirstSeen:  stat.FirstSeen.UTC().Format(time.RFC3339),
+			LastSeen:   stat.LastSeen.UTC().Format(time.RFC3339),
+		})
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("encoding top categories: %w", err)
+		}
+		return string(data) + "\n", nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Top Failure Categories\n")
+	sb.WriteString("======================\n\n")
+	if len(result.Categories) == 0 {
+		sb.WriteString("No categories recorded.\n")
+		return sb.String(), nil
+	}
+
+	sb.WriteString(fmt.Sprintf("Total occurrences: %d\n\n", total))
+	sb.WriteString(fmt.Sprintf("%-24s %8s %8s  %-10s  %-10s\n", "Category", "Count", "Share", "First seen", "Last seen"))
+	for _, c := range result.Categories {
+		sb.WriteString(fmt.Sprintf("%-24s %8d %7.1f%%  %-10s  %-10s\n",
+			c.Category, c.Count, c.Percentage, c.FirstSeen[:10], c.LastSeen[:10]))
+	}
+	return sb.String(), nil
+}
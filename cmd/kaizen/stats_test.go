@@ -0,0 +1,216 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/failures"
+)
+
+// seedStatsStore creates a failures database with failures spread over several days
+func seedStatsStore(t *testing.T) string {
+	t.Helper()
+	dbPath := filepath.Join(t.TempDir(), "failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	defer store.Close()
+
+	records := []struct {
+		category string
+		at       time.Time
+	}{
+		{"missing-tests", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)},
+		{"scope-creep", time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC)},
+		{"missing-tests", time.Date(2026, 3, 3, 10, 0, 0, 0, time.UTC)},
+		{"missing-tests", time.Date(2026, 3, 5, 11, 0, 0, 0, time.UTC)},
+		{"regression", time.Date(2026, 3, 5, 23, 59, 0, 0, time.UTC)},
+	}
+	for _, r := range records {
+		f := failures.Failure{TaskID: "task-1", Category: r.category, Details: "d", Source: "spec-review", CreatedAt: r.at}
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+	return dbPath
+}
+
+// TestRunStatsCommandJSONDayBuckets verifies failures are counted per day with totals
+func TestRunStatsCommandJSONDayBuckets(t *testing.T) {
+	dbPath := seedStatsStore(t)
+
+	output, err := runStatsCommandWithConfig(dbPath, StatsOptions{Bucket: "day", Format: "json"})
+	if err != nil {
+		t.Fatalf("runStatsCommandWithConfig failed: %v", err)
+	}
+
+	var result StatsJSON
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, output)
+	}
+
+	if result.Bucket != "day" || result.Total != 5 {
+		t.Errorf("Expected day buckets with 5 failures, got %s with %d", result.Bucket, result.Total)
+	}
+	if result.ByCategory["missing-tests"] != 3 || result.ByCategory["regression"] != 1 {
+		t.Errorf("Unexpected category totals: %v", result.ByCategory)
+	}
+
+	want := []StatsBucketJSON{
+		{Start: "2026-03-02", Count: 2},
+		{Start: "2026-03-03", Count: 1},
+		{Start: "2026-03-05", Count: 2},
+	}
+	if len(result.Buckets) != len(want) {
+		t.Fatalf("Expected %d buckets, got %+v", len(want), result.Buckets)
+	}
+	for i, b := range result.Buckets {
+		if b.Start != want[i].Start || b.Count != want[i].Count {
+			t.Errorf("Bucket %d: expected %s=%d, got %s=%d", i, want[i].Start, want[i].Count, b.Start, b.Count)
+		}
+	}
+	if result.Buckets[2].ByCategory["regression"] != 1 {
+		t.Errorf("Expected regression in last bucket, got %v", result.Buckets[2].ByCategory)
+	}
+}
+
+// TestRunStatsCommandText verifies the text output lists totals and buckets
+func TestRunStatsCommandText(t *testing.T) {
+	dbPath := seedStatsStore(t)
+
+	output, err := runStatsCommandWithConfig(dbPath, StatsOptions{Bucket: "week"})
+	if err != nil {
+		t.Fatalf("runStatsCommandWithConfig failed: %v", err)
+	}
+
+	for _, want := range []string{"Total failures: 5", "Per week", "2026-03-02"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected output to contain %q, got:\n%s", want, output)
+		}
+	}
+}
+
+// TestRunStatsCommandInvalidOptions verifies bad --bucket and --format values are rejected
+func TestRunStatsCommandInvalidOptions(t *testing.T) {
+	dbPath := seedStatsStore(t)
+
+	if _, err := runStatsCommandWithConfig(dbPath, StatsOptions{Bucket: "year"}); err == nil || !strings.Contains(err.Error(), "unsupported bucket") {
+		t.Errorf("Expected unsupported bucket error, got %v", err)
+	}
+	if _, err := runStatsCommandWithConfig(dbPath, StatsOptions{Format: "xml"}); err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+}
+
+// TestRunStatsCommandTop verifies --top lists the most frequent categories with their share
+func TestRunStatsCommandTop(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	first := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)
+	for category, count := range map[string]int{"missing-tests": 6, "scope-creep": 3, "regression": 3} {
+		if err := store.UpsertCategoryStats(category, count, first, last); err != nil {
+			t.Fatalf("UpsertCategoryStats failed: %v", err)
+		}
+	}
+	store.Close()
+
+	output, err := runStatsCommandWithConfig(dbPath, StatsOptions{Top: 2, Format: "json"})
+	if err != nil {
+		t.Fatalf("runStatsCommandWithConfig failed: %v", err)
+	}
+	var result StatsTopJSON
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, output)
+	}
+	if result.Total != 12 || len(result.Categories) != 2 {
+		t.Fatalf("Expected total 12 and 2 categories, got %+v", result)
+	}
+	want := StatsCategoryJSON{Category: "missing-tests", Count: 6, Percentage: 50, FirstSeen: "2026-03-01T09:00:00Z", LastSeen: "2026-03-08T09:00:00Z"}
+	if result.Categories[0] != want {
+		t.Errorf("Expected %+v, got %+v", want, result.Categories[0])
+	}
+	// Ties are broken by category name
+	if result.Categories[1].Category != "regression" || result.Categories[1].Percentage != 25 {
+		t.Errorf("Expected regression at 25%%, got %+v", result.Categories[1])
+	}
+
+	output, err = runStatsCommandWithConfig(dbPath, StatsOptions{Top: 10})
+	if err != nil {
+		t.Fatalf("runStatsCommandWithConfig failed: %v", err)
+	}
+	for _, line := range []string{"Total occurrences: 12", "scope-creep", "50.0%", "2026-03-01"} {
+		if !strings.Contains(output, line) {
+			t.Errorf("Expected text output to contain %q, got:\n%s", line, output)
+		}
+	}
+
+	if _, err := runStatsCommandWithConfig(dbPath, StatsOptions{Top: -1}); err == nil {
+		t.Error("Expected error for negative top")
+	}
+}
+
+// TestRunStatsCommandCSV verifies --format csv writes one quoted row of stored stats per category
+func TestRunStatsCommandCSV(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "failures.db")
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	seed := []failures.CategoryStat{
+		{Category: "missing-tests", OccurrenceCount: 6, FirstSeen: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC), LastSeen: time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)},
+		{Category: "scope, creep", OccurrenceCount: 3, FirstSeen: time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC), LastSeen: time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)},
+		{Category: `the "regression"`, OccurrenceCount: 1, FirstSeen: time.Date(2026, 3, 5, 11, 30, 0, 0, time.UTC), LastSeen: time.Date(2026, 3, 5, 11, 30, 0, 0, time.UTC)},
+	}
+	for _, stat := range seed {
+		if err := store.UpsertCategoryStats(stat.Category, stat.OccurrenceCount, stat.FirstSeen, stat.LastSeen); err != nil {
+			t.Fatalf("UpsertCategoryStats failed: %v", err)
+		}
+	}
+	stored, err := store.CategoryStats()
+	store.Close()
+	if err != nil {
+		t.Fatalf("CategoryStats failed: %v", err)
+	}
+
+	output, err := runStatsCommandWithConfig(dbPath, StatsOptions{Format: "csv"})
+	if err != nil {
+		t.Fatalf("runStatsCommandWithConfig failed: %v", err)
+	}
+	rows, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+	if err != nil {
+		t.Fatalf("Output is not valid CSV: %v\n%s", err, output)
+	}
+	if len(rows) != len(stored)+1 {
+		t.Fatalf("Expected a header and %d rows, got:\n%s", len(stored), output)
+	}
+	if strings.Join(rows[0], ",") != "category,occurrence_count,first_seen,last_seen" {
+		t.Errorf("Unexpected header %v", rows[0])
+	}
+	for i, stat := range stored {
+		want := []string{stat.Category, strconv.Itoa(stat.OccurrenceCount), stat.FirstSeen.UTC().Format(time.RFC3339), stat.LastSeen.UTC().Format(time.RFC3339)}
+		if strings.Join(rows[i+1], "|") != strings.Join(want, "|") {
+			t.Errorf("Row %d = %v, want %v", i+1, rows[i+1], want)
+		}
+	}
+	if !strings.Contains(output, `"scope, creep"`) || !strings.Contains(output, `"the ""regression"""`) {
+		t.Errorf("Expected commas and quotes to be quoted, got:\n%s", output)
+	}
+
+	output, err = runStatsCommandWithConfig(dbPath, StatsOptions{Format: "csv", Top: 1})
+	if err != nil {
+		t.Fatalf("runStatsCommandWithConfig failed: %v", err)
+	}
+	if want := "category,occurrence_count,first_seen,last_seen\nmissing-tests,6,2026-03-01T09:00:00Z,2026-03-08T09:00:00Z\n"; output != want {
+		t.Errorf("Expected --top 1 to keep only the most frequent category, got:\n%s", output)
+	}
+}
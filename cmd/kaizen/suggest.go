@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/srstomp/kaizen/internal/failures"
 )
@@ -26,22 +27,42 @@ type SuggestFixTask struct {
 	EstimateHours float64 `json:"estimate_hours"`
 }
 
+// SuggestTopEntry is one ranked category in the `suggest --top` JSON output
+type SuggestTopEntry struct {
+	Rank            int    `json:"rank"`
+	Category        string `json:"category"`
+	OccurrenceCount int    `json:"occurrence_count"`
+	// Band is the confidence level for the occurrence count: low, medium or high
+	Band   string `json:"band"`
+	Action string `json:"action"`
+	// Suggestion is the fix task rendered from the category's template, or null without a template
+	Suggestion *SuggestFixTask `json:"suggestion"`
+}
+
 // runSuggestCommand executes the suggest CLI command with default config paths
 func runSuggestCommand(taskID, category string) error {
-	// Get home directory and build config path
-	homeDir, err := os.UserHomeDir()
+	dbPath, templatesDir, err := suggestPaths()
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
+		return err
 	}
 
-	configDir := filepath.Join(homeDir, ".config", "kaizen")
-	dbPath := filepath.Join(configDir, "failures.db")
+	output, err := runSuggestCommandWithConfig(taskID, category, dbPath, templatesDir)
+	if err != nil {
+		return err
+	}
 
-	// Use templates from failures/templates directory in the project
-	// In production, this would be configurable
-	templatesDir := filepath.Join("failures", "templates")
+	fmt.Println(output)
+	return nil
+}
 
-	output, err := runSuggestCommandWithConfig(taskID, category, dbPath, templatesDir)
+// runSuggestTopCommand executes `suggest --top` with default config paths
+func runSuggestTopCommand(n int, taskID, format string) error {
+	dbPath, templatesDir, err := suggestPaths()
+	if err != nil {
+		return err
+	}
+
+	output, err := runSuggestTopCommandWithConfig(n, taskID, format, dbPath, templatesDir)
 	if err != nil {
 		return err
 	}
@@ -50,6 +71,24 @@ func runSuggestCommand(taskID, category string) error {
 	return nil
 }
 
+// suggestPaths returns the default failures database and fix task templates directory
+func suggestPaths() (dbPath, templatesDir string, err error) {
+	// Get home directory and build config path
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	configDir := filepath.Join(homeDir, ".config", "kaizen")
+	dbPath = filepath.Join(configDir, "failures.db")
+
+	// Use templates from failures/templates directory in the project
+	// In production, this would be configurable
+	templatesDir = filepath.Join("failures", "templates")
+
+	return dbPath, templatesDir, nil
+}
+
 // runSuggestCommandWithConfig executes the suggest command with explicit config paths
 // This is separated for testing purposes
 func runSuggestCommandWithConfig(taskID, category, dbPath, templatesDir string) (string, error) {
@@ -79,38 +118,97 @@ func runSuggestCommandWithConfig(taskID, category, dbPath, templatesDir string)
 	}
 
 	// Try to load template and render fix task
-	loader := failures.NewTemplateLoader(templatesDir)
+	output.FixTask = renderFixTask(failures.NewTemplateLoader(templatesDir), category, taskID)
+
+	// Encode to JSON with pretty printing
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("encoding JSON output: %w", err)
+	}
+
+	return string(jsonBytes), nil
+}
+
+// renderFixTask renders the fix task from the category's template, or returns nil when no
+// template exists for the category
+func renderFixTask(loader *failures.TemplateLoader, category, taskID string) *SuggestFixTask {
 	template, err := loader.LoadTemplate(category)
 	if err != nil {
-		// Template not found - return output with null fix_task
-		// This is not an error, just means no template exists for this category
-	} else {
-		// Render title and description with variables
-		// Support both new and legacy variable names
-		vars := map[string]string{
-			"task_id":          taskID,
-			"original_task_id": taskID, // Legacy template compatibility
-			"category":         category,
-			"files":            "", // Placeholder for template compatibility
-			"details":          "", // Placeholder for template compatibility
-		}
+		// Template not found - this is not an error, just means no template exists for this category
+		return nil
+	}
 
-		title := template.RenderTitle(vars)
-		description := template.RenderDescription(vars)
+	// Render title and description with variables
+	// Support both new and legacy variable names
+	vars := map[string]string{
+		"task_id":          taskID,
+		"original_task_id": taskID, // Legacy template compatibility
+		"category":         category,
+		"files":            "", // Placeholder for template compatibility
+		"details":          "", // Placeholder for template compatibility
+	}
 
-		output.FixTask = &SuggestFixTask{
-			Title:         title,
-			Type:          template.FixTask.Type,
-			Description:   description,
-			EstimateHours: template.FixTask.EstimateHours,
-		}
+	return &SuggestFixTask{
+		Title:         template.RenderTitle(vars),
+		Type:          template.FixTask.Type,
+		Description:   template.RenderDescription(vars),
+		EstimateHours: template.FixTask.EstimateHours,
+	}
+}
+
+// runSuggestTopCommandWithConfig suggests fixes for the n most frequent failure categories,
+// ordered by rank. The JSON format is an array of SuggestTopEntry.
+func runSuggestTopCommandWithConfig(n int, taskID, format, dbPath, templatesDir string) (string, error) {
+	if format != "json" && format != "text" {
+		return "", fmt.Errorf("unsupported format: %s (use 'text' or 'json')", format)
 	}
 
-	// Encode to JSON with pretty printing
-	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	store, err := failures.NewStore(dbPath)
 	if err != nil {
-		return "", fmt.Errorf("encoding JSON output: %w", err)
+		return "", fmt.Errorf("opening database: %w", err)
 	}
+	defer store.Close()
 
-	return string(jsonBytes), nil
+	stats, err := store.TopCategories(n)
+	if err != nil {
+		return "", fmt.Errorf("getting top categories: %w", err)
+	}
+
+	loader := failures.NewTemplateLoader(templatesDir)
+	entries := make([]SuggestTopEntry, 0, len(stats))
+	for i, stat := range stats {
+		confidence := failures.CalculateConfidence(stat.OccurrenceCount)
+		entries = append(entries, SuggestTopEntry{
+			Rank:            i + 1,
+			Category:        stat.Category,
+			OccurrenceCount: stat.OccurrenceCount,
+			Band:            string(confidence.Level),
+			Action:          string(confidence.Action),
+			Suggestion:      renderFixTask(loader, stat.Category, taskID),
+		})
+	}
+
+	if format == "json" {
+		jsonBytes, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("encoding JSON output: %w", err)
+		}
+		return string(jsonBytes), nil
+	}
+
+	if len(entries) == 0 {
+		return "No failure categories recorded", nil
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Top %d failure categories:\n", len(entries)))
+	for _, entry := range entries {
+		sb.WriteString(fmt.Sprintf("\n%d. %s - %d occurrences (%s confidence, %s)\n",
+			entry.Rank, entry.Category, entry.OccurrenceCount, entry.Band, entry.Action))
+		if entry.Suggestion != nil {
+			sb.WriteString(fmt.Sprintf("   Fix task: %s\n", entry.Suggestion.Title))
+		} else {
+			sb.WriteString("   No fix task template\n")
+		}
+	}
+	return strings.TrimRight(sb.String(), "\n"), nil
 }
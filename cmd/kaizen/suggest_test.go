@@ -257,3 +257,89 @@ func TestSuggestCommandDatabaseNotExist(t *testing.T) {
 		t.Errorf("Expected database error, got: %v", err)
 	}
 }
+
+func TestSuggestTopCommand(t *testing.T) {
+	tmpDir := t.TempDir()
+	dbPath := filepath.Join(tmpDir, "test-failures.db")
+
+	store, err := failures.NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("Failed to create test store: %v", err)
+	}
+	now := time.Now()
+	for category, count := range map[string]int{"scope-creep": 3, "missing-tests": 7, "wrong-product": 1, "flaky": 3} {
+		if err := store.UpsertCategoryStats(category, count, now.Add(-24*time.Hour), now); err != nil {
+			t.Fatalf("Failed to upsert category stats: %v", err)
+		}
+	}
+	store.Close()
+
+	templatesDir := filepath.Join(tmpDir, "templates")
+	if err := os.MkdirAll(templatesDir, 0755); err != nil {
+		t.Fatalf("Failed to create templates directory: %v", err)
+	}
+	templateYAML := `category: missing-tests
+prefix: MT
+fix_task:
+  title_template: "Add missing tests ({category})"
+  type: test
+  description_template: "Category {category} recurs"
+  estimate_hours: 1.5
+`
+	if err := os.WriteFile(filepath.Join(templatesDir, "missing-tests.yaml"), []byte(templateYAML), 0644); err != nil {
+		t.Fatalf("Failed to write template file: %v", err)
+	}
+
+	output, err := runSuggestTopCommandWithConfig(3, "", "json", dbPath, templatesDir)
+	if err != nil {
+		t.Fatalf("runSuggestTopCommandWithConfig failed: %v", err)
+	}
+
+	var entries []SuggestTopEntry
+	if err := json.Unmarshal([]byte(output), &entries); err != nil {
+		t.Fatalf("Failed to parse JSON output: %v\nOutput: %s", err, output)
+	}
+
+	// Ties on count are ordered by category name
+	want := []struct {
+		category string
+		count    int
+		band     string
+	}{
+		{"missing-tests", 7, "high"},
+		{"flaky", 3, "medium"},
+		{"scope-creep", 3, "medium"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("Expected %d entries, got %d: %s", len(want), len(entries), output)
+	}
+	for i, w := range want {
+		entry := entries[i]
+		if entry.Rank != i+1 || entry.Category != w.category || entry.OccurrenceCount != w.count || entry.Band != w.band {
+			t.Errorf("Entry %d = %+v, want rank %d %s (%d, %s)", i, entry, i+1, w.category, w.count, w.band)
+		}
+	}
+	if entries[0].Suggestion == nil || entries[0].Suggestion.Title != "Add missing tests (missing-tests)" {
+		t.Errorf("Expected missing-tests fix task suggestion, got %+v", entries[0].Suggestion)
+	}
+	if entries[1].Suggestion != nil {
+		t.Errorf("Expected null suggestion without a template, got %+v", entries[1].Suggestion)
+	}
+	for _, field := range []string{`"occurrence_count"`, `"suggestion"`, `"band"`} {
+		if !strings.Contains(output, field) {
+			t.Errorf("Expected JSON field %s, got:\n%s", field, output)
+		}
+	}
+
+	text, err := runSuggestTopCommandWithConfig(3, "", "text", dbPath, templatesDir)
+	if err != nil {
+		t.Fatalf("runSuggestTopCommandWithConfig text failed: %v", err)
+	}
+	if !strings.Contains(text, "1. missing-tests - 7 occurrences (high confidence, auto-create)") {
+		t.Errorf("Expected ranked text output, got:\n%s", text)
+	}
+
+	if _, err := runSuggestTopCommandWithConfig(3, "", "xml", dbPath, templatesDir); err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TaskSpec is a task read by grade-task-quality --task-file
+type TaskSpec struct {
+	ID                 string   `yaml:"id"`
+	Title              string   `yaml:"title"`
+	Type               string   `yaml:"type"`
+	Description        string   `yaml:"description"`
+	AcceptanceCriteria []string `yaml:"acceptance_criteria"`
+}
+
+// taskListItemPattern matches a markdown list marker, e.g. "- ", "* ", "1. " or "- [ ] "
+var taskListItemPattern = regexp.MustCompile(`^(?:[-*+]|\d+[.)])\s+(?:\[[ xX]\]\s+)?`)
+
+// loadTaskFile reads a task spec from a .yaml/.yml file, or from a markdown file with optional
+// YAML front matter. Front matter fields take precedence over the markdown sections.
+func loadTaskFile(path string) (TaskSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return TaskSpec{}, fmt.Errorf("reading task file: %w", err)
+	}
+
+	var spec TaskSpec
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(data, &spec); err != nil {
+			return TaskSpec{}, fmt.Errorf("parsing task file %s: %w", path, err)
+		}
+	default:
+		spec, err = parseTaskMarkdown(string(data))
+		if err != nil {
+			return TaskSpec{}, fmt.Errorf("parsing task file %s: %w", path, err)
+		}
+	}
+
+	if spec.Title == "" && spec.Description == "" && len(spec.AcceptanceCriteria) == 0 {
+		return TaskSpec{}, fmt.Errorf("task file %s has no title, description or acceptance criteria", path)
+	}
+	return spec, nil
+}
+
+// parseTaskMarkdown parses a markdown task: the first "# " heading is the title, and the
+// "## Type", "## Description" and "## Acceptance Criteria" sections fill the other fields.
+// Text between the title and the first section is the description when there is no
+// Description section. A leading "---" block is YAML front matter.
+func parseTaskMarkdown(content string) (TaskSpec, error) {
+	var spec TaskSpec
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		end := -1
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == "---" {
+				end = i
+				break
+			}
+		}
+		if end < 0 {
+			return TaskSpec{}, fmt.Errorf("front matter is not closed with ---")
+		}
+		if err := yaml.Unmarshal([]byte(strings.Join(lines[1:end], "\n")), &spec); err != nil {
+			return TaskSpec{}, fmt.Errorf("parsing front matter: %w", err)
+		}
+		lines = lines[end+1:]
+	}
+
+	var title, taskType string
+	var preamble, description []string
+	var criteria []string
+	section := ""
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "## "):
+			section = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(trimmed, "## ")))
+			continue
+		case strings.HasPrefix(trimmed, "# ") && title == "" && section == "":
+			title = strings.TrimSpace(strings.TrimPrefix(trimmed, "# "))
+			continue
+		}
+
+		switch section {
+		case "":
+			preamble = append(preamble, line)
+		case "type":
+			if taskType == "" && trimmed != "" {
+				taskType = strings.ToLower(trimmed)
+			}
+		case "description":
+			description = append(description, line)
+		case "acceptance criteria":
+			if item := strings.TrimSpace(taskListItemPattern.ReplaceAllString(trimmed, "")); item != "" {
+				criteria = append(criteria, item)
+			}
+		}
+	}
+
+	if spec.Title == "" {
+		spec.Title = title
+	}
+	if spec.Type == "" {
+		spec.Type = taskType
+	}
+	if spec.Description == "" {
+		spec.Description = strings.TrimSpace(strings.Join(description, "\n"))
+		if spec.Description == "" {
+			spec.Description = strings.TrimSpace(strings.Join(preamble, "\n"))
+		}
+	}
+	if len(spec.AcceptanceCriteria) == 0 {
+		spec.AcceptanceCriteria = criteria
+	}
+	return spec, nil
+}
+
+// acceptanceCriteriaFlag returns the criteria as the JSON list --acceptance-criteria accepts
+func (spec TaskSpec) acceptanceCriteriaFlag() string {
+	if len(spec.AcceptanceCriteria) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(spec.AcceptanceCriteria) // a []string always marshals
+	return string(data)
+}
+
+// taskQualityInput is the task grade-task-quality grades
+type taskQualityInput struct {
+	ID                 string
+	Title              string
+	Type               string
+	Description        string
+	AcceptanceCriteria string
+}
+
+// withTaskFile fills the fields not given by flags from the task file at path; explicit flags win
+func (in taskQualityInput) withTaskFile(path string) (taskQualityInput, error) {
+	spec, err := loadTaskFile(path)
+	if err != nil {
+		return in, err
+	}
+
+	fill := func(field *string, value string) {
+		if *field == "" {
+			*field = value
+		}
+	}
+	fill(&in.ID, spec.ID)
+	fill(&in.Title, spec.Title)
+	fill(&in.Type, spec.Type)
+	fill(&in.Description, spec.Description)
+	fill(&in.AcceptanceCriteria, spec.acceptanceCriteriaFlag())
+	return in, nil
+}
@@ -0,0 +1,171 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTaskFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write task file: %v", err)
+	}
+	return path
+}
+
+// TestLoadTaskFile verifies the markdown, front matter and YAML task formats
+func TestLoadTaskFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    TaskSpec
+	}{
+		{
+			name: "markdown sections",
+			file: "task.md",
+			content: `# Add rate limiting
+
+## Type
+Feature
+
+## Description
+Limit each API key to 100 requests per minute.
+Return 429 when the limit is exceeded.
+
+## Acceptance Criteria
+- [ ] Requests over the limit get 429
+- Limit is configurable
+1. Limits reset every minute
+
+## Notes
+Not graded.
+`,
+			want: TaskSpec{
+				Title:              "Add rate limiting",
+				Type:               "feature",
+				Description:        "Limit each API key to 100 requests per minute.\nReturn 429 when the limit is exceeded.",
+				AcceptanceCriteria: []string{"Requests over the limit get 429", "Limit is configurable", "Limits reset every minute"},
+			},
+		},
+		{
+			name: "markdown without description section",
+			file: "task.md",
+			content: `# Fix login redirect
+
+Users land on a blank page after logging in.
+`,
+			want: TaskSpec{Title: "Fix login redirect", Description: "Users land on a blank page after logging in."},
+		},
+		{
+			name: "front matter",
+			file: "task.md",
+			content: `---
+id: TASK-42
+title: Front matter title
+type: bug
+---
+# Heading title
+
+## Description
+Crash when the config file is empty.
+
+## Acceptance Criteria
+- Empty config loads defaults
+`,
+			want: TaskSpec{
+				ID:                 "TASK-42",
+				Title:              "Front matter title",
+				Type:               "bug",
+				Description:        "Crash when the config file is empty.",
+				AcceptanceCriteria: []string{"Empty config loads defaults"},
+			},
+		},
+		{
+			name: "yaml",
+			file: "task.yaml",
+			content: `title: Add export
+type: feature
+description: Export failures as CSV
+acceptance_criteria:
+  - CSV has a header row
+`,
+			want: TaskSpec{
+				Title:              "Add export",
+				Type:               "feature",
+				Description:        "Export failures as CSV",
+				AcceptanceCriteria: []string{"CSV has a header row"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := loadTaskFile(writeTaskFile(t, tt.file, tt.content))
+			if err != nil {
+				t.Fatalf("loadTaskFile failed: %v", err)
+			}
+			if got.ID != tt.want.ID || got.Title != tt.want.Title || got.Type != tt.want.Type || got.Description != tt.want.Description ||
+				strings.Join(got.AcceptanceCriteria, "|") != strings.Join(tt.want.AcceptanceCriteria, "|") {
+				t.Errorf("loadTaskFile = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLoadTaskFileErrors verifies unreadable, malformed and empty task files are rejected
+func TestLoadTaskFileErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		wantErr string
+	}{
+		{name: "missing", path: filepath.Join(t.TempDir(), "missing.md"), wantErr: "reading task file"},
+		{name: "unclosed front matter", path: writeTaskFile(t, "task.md", "---\ntitle: x\n"), wantErr: "not closed"},
+		{name: "invalid yaml", path: writeTaskFile(t, "task.yml", "title: [unclosed\n"), wantErr: "parsing task file"},
+		{name: "empty", path: writeTaskFile(t, "task.md", "\n## Notes\nnothing\n"), wantErr: "has no title"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := loadTaskFile(tt.path)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestTaskQualityInputWithTaskFile verifies explicit flags override the task file's values
+func TestTaskQualityInputWithTaskFile(t *testing.T) {
+	path := writeTaskFile(t, "task.md", `---
+id: TASK-7
+type: spike
+---
+# File title
+
+## Description
+File description.
+
+## Acceptance Criteria
+- Write up findings
+`)
+
+	got, err := taskQualityInput{Title: "Flag title", Type: "feature"}.withTaskFile(path)
+	if err != nil {
+		t.Fatalf("withTaskFile failed: %v", err)
+	}
+	want := taskQualityInput{
+		ID:                 "TASK-7",
+		Title:              "Flag title",
+		Type:               "feature",
+		Description:        "File description.",
+		AcceptanceCriteria: `["Write up findings"]`,
+	}
+	if got != want {
+		t.Errorf("withTaskFile = %+v, want %+v", got, want)
+	}
+}
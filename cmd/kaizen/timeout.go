@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// errOverallTimeout is the cancellation cause of the root context once --timeout expires
+var errOverallTimeout = errors.New("overall timeout exceeded")
+
+// overallTimeoutGrace is how long the watchdog waits after --timeout expires so that commands
+// threading the root context can stop cleanly and report their partial results themselves
+var overallTimeoutGrace = 2 * time.Second
+
+// exitOnOverallTimeout flushes stdout, reports err and exits non-zero; tests replace it
+var exitOnOverallTimeout = func(err error) {
+	os.Stdout.Sync()
+	fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+	os.Exit(1)
+}
+
+// newRootContext returns the context for the whole CLI invocation.
+// A positive timeout cancels it with errOverallTimeout as the cause; zero means no limit.
+func newRootContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(context.Background())
+	}
+	return context.WithTimeoutCause(context.Background(), timeout, errOverallTimeout)
+}
+
+// watchOverallTimeout aborts the process if ctx hits the overall timeout and the running command
+// has not exited within overallTimeoutGrace, covering paths that don't take a context
+func watchOverallTimeout(ctx context.Context, timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+	go func() {
+		<-ctx.Done()
+		if !errors.Is(context.Cause(ctx), errOverallTimeout) {
+			return
+		}
+		time.Sleep(overallTimeoutGrace)
+		exitOnOverallTimeout(fmt.Errorf("%w (--timeout %v)", errOverallTimeout, timeout))
+	}()
+}
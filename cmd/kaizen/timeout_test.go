@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/llm"
+)
+
+// TestRunMetaCommandOverallTimeout verifies a slow agent is aborted once the root context times out
+func TestRunMetaCommandOverallTimeout(t *testing.T) {
+	tmpDir := t.TempDir()
+	metaDir := filepath.Join(tmpDir, "meta")
+	writeMetaAgentEval(t, metaDir)
+
+	oldRunAgent := runAgent
+	defer func() { runAgent = oldRunAgent }()
+	runAgent = func(ctx context.Context, agentName string, input TaskInput) (string, error) {
+		select {
+		case <-time.After(10 * time.Second):
+			return "PASS", nil
+		case <-ctx.Done():
+			return "ERROR", ctx.Err()
+		}
+	}
+
+	ctx, cancel := newRootContext(50 * time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := runMetaCommandWithContext(ctx, "", "test-agent", 0, metaDir, true, MetaOptions{Parallel: 1, NoLog: true, Format: "json"})
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected the command to abort at the timeout, took %v", elapsed)
+	}
+	if err == nil || !strings.Contains(err.Error(), "overall timeout exceeded") {
+		t.Errorf("Expected overall timeout error, got %v", err)
+	}
+}
+
+// slowSkillLLMClient answers the first request and blocks on later ones until ctx is done
+type slowSkillLLMClient struct {
+	calls atomic.Int32
+}
+
+func (c *slowSkillLLMClient) Complete(ctx context.Context, prompt string, options ...llm.CompletionOption) (string, error) {
+	if c.calls.Add(1) == 1 {
+		return stubSkillLLMClient{}.Complete(ctx, prompt, options...)
+	}
+	<-ctx.Done()
+	return "", ctx.Err()
+}
+
+// TestGradeSkillsOverallTimeoutWritesPartialReport verifies skills graded before the timeout are still reported
+func TestGradeSkillsOverallTimeoutWritesPartialReport(t *testing.T) {
+	tmpDir := t.TempDir()
+	skillsDir := filepath.Join(tmpDir, "skills")
+	for _, skill := range []string{"alpha", "beta", "gamma"} {
+		skillDir := filepath.Join(skillsDir, skill)
+		if err := os.MkdirAll(skillDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(skillDir, "SKILL.md"), []byte("# "+skill), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, cancel := newRootContext(100 * time.Millisecond)
+	defer cancel()
+
+	reportPath := filepath.Join(tmpDir, "skill-clarity.json")
+	opts := GradeSkillsOptions{Format: "json", LLMClient: &slowSkillLLMClient{}}
+	err := gradeSkillsWithContext(ctx, skillsDir, reportPath, opts)
+
+	if err == nil || !strings.Contains(err.Error(), "overall timeout exceeded") || !strings.Contains(err.Error(), "1/3 skills") {
+		t.Errorf("Expected overall timeout error after 1/3 skills, got %v", err)
+	}
+	content, readErr := os.ReadFile(reportPath)
+	if readErr != nil {
+		t.Fatalf("Expected partial report to be written: %v", readErr)
+	}
+	if !strings.Contains(string(content), "alpha") || strings.Contains(string(content), "gamma") {
+		t.Errorf("Expected partial report with only alpha, got:\n%s", content)
+	}
+}
+
+// TestWatchOverallTimeout verifies the watchdog aborts commands that ignore the root context
+func TestWatchOverallTimeout(t *testing.T) {
+	oldExit, oldGrace := exitOnOverallTimeout, overallTimeoutGrace
+	defer func() { exitOnOverallTimeout, overallTimeoutGrace = oldExit, oldGrace }()
+
+	aborted := make(chan error, 1)
+	exitOnOverallTimeout = func(err error) { aborted <- err }
+	overallTimeoutGrace = 0
+
+	ctx, cancel := newRootContext(10 * time.Millisecond)
+	defer cancel()
+	watchOverallTimeout(ctx, 10*time.Millisecond)
+
+	select {
+	case err := <-aborted:
+		if !strings.Contains(err.Error(), "overall timeout exceeded") {
+			t.Errorf("Expected overall timeout error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the watchdog to abort after the timeout")
+	}
+
+	// Cancelling without a timeout is a normal exit and must not abort
+	ctx, cancel = newRootContext(0)
+	watchOverallTimeout(ctx, 0)
+	cancel()
+	select {
+	case err := <-aborted:
+		t.Errorf("Expected no abort on normal cancellation, got %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
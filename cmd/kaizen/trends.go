@@ -1,30 +1,48 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"path/filepath"
 	"sort"
+	"strings"
 )
 
+// defaultRegressionThreshold is the percentage drop beyond which a regression is flagged
+const defaultRegressionThreshold = 5.0
+
+// errRegressionDetected is returned by report --fail-on-regression, after the report is written,
+// when a trend regresses beyond the threshold
+var errRegressionDetected = errors.New("regression detected")
+
 // TrendData represents comparison data between previous and current metrics
 type TrendData struct {
 	PreviousValue   float64 `json:"previous_value"`
 	CurrentValue    float64 `json:"current_value"`
 	AbsoluteDelta   float64 `json:"absolute_delta"`
 	PercentageDelta float64 `json:"percentage_delta"`
-	Direction       string  `json:"direction"` // "improvement", "regression", "stable"
+	// PercentageLabel replaces the percentage when it is undefined (previous value 0):
+	// "new" when the value appeared, "n/a" when both values are 0
+	PercentageLabel string `json:"percentage_label,omitempty"`
+	Direction       string `json:"direction"` // "improvement", "regression", "stable"
 }
 
 // calculateDelta calculates the delta between previous and current values
 func calculateDelta(previous, current float64) TrendData {
 	absoluteDelta := current - previous
 
-	// Calculate percentage change
+	// Calculate percentage change; it is undefined when the previous value is 0
 	var percentageDelta float64
+	var percentageLabel string
 	if previous != 0 {
 		percentageDelta = (absoluteDelta / previous) * 100
 		// Round to 2 decimal places
 		percentageDelta = math.Round(percentageDelta*100) / 100
+	} else if current != 0 {
+		percentageLabel = "new"
+	} else {
+		percentageLabel = "n/a"
 	}
 
 	// Determine direction
@@ -40,19 +58,26 @@ func calculateDelta(previous, current float64) TrendData {
 		CurrentValue:    current,
 		AbsoluteDelta:   absoluteDelta,
 		PercentageDelta: percentageDelta,
+		PercentageLabel: percentageLabel,
 		Direction:       direction,
 	}
 }
 
+// trendIndicator returns the arrow for a TrendData direction
+func trendIndicator(direction string) string {
+	switch direction {
+	case "improvement":
+		return "↑"
+	case "regression":
+		return "↓"
+	}
+	return "→"
+}
+
 // formatTrendMarkdown formats a trend comparison as a markdown table row
 func formatTrendMarkdown(metricName string, trend TrendData, exceedsThreshold bool) string {
 	// Format the direction indicator
-	indicator := "→"
-	if trend.Direction == "improvement" {
-		indicator = "↑"
-	} else if trend.Direction == "regression" {
-		indicator = "↓"
-	}
+	indicator := trendIndicator(trend.Direction)
 
 	// Format the delta values
 	deltaSign := ""
@@ -66,8 +91,11 @@ func formatTrendMarkdown(metricName string, trend TrendData, exceedsThreshold bo
 		warning = " ⚠"
 	}
 
-	// Format percentage delta
+	// Format percentage delta, or its label when the percentage is undefined
 	percentStr := fmt.Sprintf("%s%.2f%%", deltaSign, trend.PercentageDelta)
+	if trend.PercentageLabel != "" {
+		percentStr = trend.PercentageLabel
+	}
 
 	// Return formatted row
 	return fmt.Sprintf("| %s | %.1f | %.1f | %s%.1f (%s) | %s %s%s |",
@@ -93,6 +121,8 @@ type EvalTrends struct {
 	AverageScore TrendData
 	PassRate     TrendData
 	PerTaskTrends map[string]TrendData // task_id -> trend
+	// GraderVersionChanges lists graders whose version differs between the compared runs
+	GraderVersionChanges []string
 }
 
 // MetaTrends represents trend data for meta report
@@ -117,6 +147,11 @@ func loadEvalTrends(logPath string) (*EvalTrends, error) {
 		return nil, fmt.Errorf("loading eval results: %w", err)
 	}
 
+	return calculateEvalTrends(results)
+}
+
+// calculateEvalTrends computes trend data between the two most recent eval timestamps
+func calculateEvalTrends(results []GradeTaskOutput) (*EvalTrends, error) {
 	if len(results) < 2 {
 		return nil, fmt.Errorf("insufficient data for trend analysis (need at least 2 entries)")
 	}
@@ -154,6 +189,10 @@ func loadEvalTrends(logPath string) (*EvalTrends, error) {
 		PerTaskTrends: make(map[string]TrendData),
 	}
 
+	// Grader versions seen in each run, to flag comparisons across grader changes
+	prevVersions := make(map[string]string)
+	currVersions := make(map[string]string)
+
 	// Process each task
 	for taskID, taskResults := range taskGroups {
 		var prevResult, currResult *GradeTaskOutput
@@ -175,6 +214,7 @@ func loadEvalTrends(logPath string) (*EvalTrends, error) {
 
 		// Aggregate for overall metrics
 		if prevResult != nil {
+			collectGraderVersions(*prevResult, prevVersions)
 			prevScores = append(prevScores, prevResult.OverallScore)
 			prevTotal++
 			if prevResult.OverallPassed {
@@ -182,6 +222,7 @@ func loadEvalTrends(logPath string) (*EvalTrends, error) {
 			}
 		}
 		if currResult != nil {
+			collectGraderVersions(*currResult, currVersions)
 			currScores = append(currScores, currResult.OverallScore)
 			currTotal++
 			if currResult.OverallPassed {
@@ -223,10 +264,54 @@ func loadEvalTrends(logPath string) (*EvalTrends, error) {
 	}
 
 	trends.PassRate = calculateDelta(prevPassRate, currPassRate)
+	trends.GraderVersionChanges = compareGraderVersions(prevVersions, currVersions)
 
 	return trends, nil
 }
 
+// collectGraderVersions records the version of each grader in a result.
+// Results logged before grader versioning are recorded as "unversioned". When the tasks of one run
+// were graded by several versions of a grader, all of them are recorded, sorted and comma-separated,
+// so the outcome does not depend on the order the tasks are visited in.
+func collectGraderVersions(result GradeTaskOutput, versions map[string]string) {
+	for _, graderResult := range result.Results {
+		version := graderResult.GraderVersion
+		if version == "" {
+			version = "unversioned"
+		}
+		versions[graderResult.GraderName] = mergeGraderVersions(versions[graderResult.GraderName], version)
+	}
+}
+
+// mergeGraderVersions adds version to a comma-separated, sorted list of versions unless already present
+func mergeGraderVersions(existing, version string) string {
+	if existing == "" {
+		return version
+	}
+	seen := strings.Split(existing, ", ")
+	for _, v := range seen {
+		if v == version {
+			return existing
+		}
+	}
+	seen = append(seen, version)
+	sort.Strings(seen)
+	return strings.Join(seen, ", ")
+}
+
+// compareGraderVersions returns "name (old → new)" for each grader present in both runs with a different version
+func compareGraderVersions(prev, curr map[string]string) []string {
+	var changes []string
+	for name, currVersion := range curr {
+		prevVersion, ok := prev[name]
+		if ok && prevVersion != currVersion {
+			changes = append(changes, fmt.Sprintf("%s (%s → %s)", name, prevVersion, currVersion))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
+
 // loadMetaTrends loads trend data from consistency-log.json
 func loadMetaTrends(logPath string) (*MetaTrends, error) {
 	results, err := loadMetaResults(logPath)
@@ -234,6 +319,11 @@ func loadMetaTrends(logPath string) (*MetaTrends, error) {
 		return nil, fmt.Errorf("loading meta results: %w", err)
 	}
 
+	return calculateMetaTrends(results)
+}
+
+// calculateMetaTrends computes trend data between the two most recent meta results
+func calculateMetaTrends(results []ConsistencyResult) (*MetaTrends, error) {
 	if len(results) < 2 {
 		return nil, fmt.Errorf("insufficient data for trend analysis (need at least 2 entries)")
 	}
@@ -248,10 +338,11 @@ func loadMetaTrends(logPath string) (*MetaTrends, error) {
 		PerAgentTrends: make(map[string]TrendData),
 	}
 
-	// Group results by agent and calculate per-agent trends
+	// Group results by agent (and boundary level) and calculate per-agent trends
+	agentKey := metaAgentKeyFunc(results)
 	agentResults := make(map[string][]ConsistencyResult)
 	for _, result := range results {
-		agentResults[result.Agent] = append(agentResults[result.Agent], result)
+		agentResults[agentKey(result)] = append(agentResults[agentKey(result)], result)
 	}
 
 	// Calculate trend for each agent (compare last two entries)
@@ -275,8 +366,13 @@ func loadMetaTrends(logPath string) (*MetaTrends, error) {
 
 // loadGradeTrends loads trend data from skill-clarity reports
 func loadGradeTrends(reportsDir string) (*GradeTrends, error) {
+	return loadGradeTrendsWithPattern(reportsDir, defaultGradeReportPattern)
+}
+
+// loadGradeTrendsWithPattern loads trend data from reports matching the filename pattern
+func loadGradeTrendsWithPattern(reportsDir, filenamePattern string) (*GradeTrends, error) {
 	// Find all grade reports
-	reports, err := findGradeReports(reportsDir)
+	reports, err := findGradeReportsWithPattern(reportsDir, filenamePattern)
 	if err != nil {
 		return nil, fmt.Errorf("finding grade reports: %w", err)
 	}
@@ -323,6 +419,39 @@ func loadGradeTrends(reportsDir string) (*GradeTrends, error) {
 	return trends, nil
 }
 
+// loadGradeHistory returns up to n of the most recent skill-clarity reports, oldest first
+func loadGradeHistory(reportsDir string, n int) ([]GradeReport, error) {
+	return loadGradeHistoryWithPattern(reportsDir, defaultGradeReportPattern, n)
+}
+
+// loadGradeHistoryWithPattern returns up to n of the most recent reports matching the filename
+// pattern, oldest first
+func loadGradeHistoryWithPattern(reportsDir, filenamePattern string, n int) ([]GradeReport, error) {
+	if n < 1 {
+		return nil, fmt.Errorf("history must be at least 1")
+	}
+
+	// Reports are sorted newest first
+	reports, err := findGradeReportsWithPattern(reportsDir, filenamePattern)
+	if err != nil {
+		return nil, fmt.Errorf("finding grade reports: %w", err)
+	}
+	if len(reports) > n {
+		reports = reports[:n]
+	}
+
+	history := make([]GradeReport, 0, len(reports))
+	for i := len(reports) - 1; i >= 0; i-- {
+		report, err := parseGradeReport(reports[i])
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", filepath.Base(reports[i]), err)
+		}
+		history = append(history, report)
+	}
+
+	return history, nil
+}
+
 // loadThresholdConfig loads regression threshold from config
 type ThresholdConfig struct {
 	Regression struct {
@@ -333,7 +462,7 @@ type ThresholdConfig struct {
 func loadThresholdConfig(configPath string) (float64, error) {
 	// For now, return default threshold
 	// TODO: Implement YAML parsing when needed
-	return 5.0, nil
+	return defaultRegressionThreshold, nil
 }
 
 // exceedsRegressionThreshold checks if a regression exceeds the threshold
@@ -344,3 +473,53 @@ func exceedsRegressionThreshold(trend TrendData, threshold float64) bool {
 	// Check if absolute percentage drop exceeds threshold
 	return math.Abs(trend.PercentageDelta) > threshold
 }
+
+// reportTrends holds the trends computed for one report run; nil trends were disabled or lacked data
+type reportTrends struct {
+	grade *GradeTrends
+	meta  *MetaTrends
+	eval  *EvalTrends
+}
+
+// regressions returns "section metric -x.x%" for each aggregate and per-criteria, per-agent or
+// per-task trend whose regression exceeds threshold, in a stable order
+func (t reportTrends) regressions(threshold float64) []string {
+	var regressed []string
+	check := func(section, metric string, trend TrendData) {
+		if exceedsRegressionThreshold(trend, threshold) {
+			regressed = append(regressed, fmt.Sprintf("%s %s %.1f%%", section, metric, trend.PercentageDelta))
+		}
+	}
+	checkEach := func(section, kind string, trends map[string]TrendData) {
+		for _, name := range sortedTrendNames(trends) {
+			check(section, kind+"/"+name, trends[name])
+		}
+	}
+
+	if t.grade != nil {
+		check("grade", "average_score", t.grade.AverageScore)
+		check("grade", "pass_rate", t.grade.PassRate)
+		check("grade", "total_skills", t.grade.TotalSkills)
+		checkEach("grade", "criteria", t.grade.PerCriteriaTrends)
+	}
+	if t.meta != nil {
+		check("meta", "consistency_percentage", t.meta.ConsistencyPercentage)
+		checkEach("meta", "agent", t.meta.PerAgentTrends)
+	}
+	if t.eval != nil {
+		check("eval", "average_score", t.eval.AverageScore)
+		check("eval", "pass_rate", t.eval.PassRate)
+		checkEach("eval", "task", t.eval.PerTaskTrends)
+	}
+
+	return regressed
+}
+
+// checkRegressions returns an errRegressionDetected error listing the trends that regress beyond threshold
+func checkRegressions(trends reportTrends, threshold float64) error {
+	regressed := trends.regressions(threshold)
+	if len(regressed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w beyond %g%% threshold in %d metrics: %s", errRegressionDetected, threshold, len(regressed), strings.Join(regressed, ", "))
+}
@@ -1,9 +1,17 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/graders/codebased"
 )
 
 // TestCalculateDelta verifies delta calculation for trend analysis
@@ -352,6 +360,39 @@ func TestFormatTrendMarkdown(t *testing.T) {
 	}
 }
 
+// TestFormatTrendMarkdownZeroPrevious verifies a 0 -> 50 jump keeps the absolute delta
+// and labels the undefined percentage instead of showing +0.00%
+func TestFormatTrendMarkdownZeroPrevious(t *testing.T) {
+	trend := calculateDelta(0.0, 50.0)
+
+	if trend.PercentageLabel != "new" {
+		t.Errorf("Expected percentage label 'new', got %q", trend.PercentageLabel)
+	}
+
+	output := formatTrendMarkdown("Pass Rate", trend, false)
+	if !contains(output, "+50.0 (new)") {
+		t.Errorf("Expected absolute delta with 'new' label, got: %s", output)
+	}
+	if contains(output, "0.00%") {
+		t.Errorf("Expected no misleading percentage, got: %s", output)
+	}
+
+	data, err := json.Marshal(trend)
+	if err != nil {
+		t.Fatalf("Failed to marshal trend: %v", err)
+	}
+	if !contains(string(data), `"absolute_delta":50`) || !contains(string(data), `"percentage_label":"new"`) {
+		t.Errorf("Expected JSON to carry absolute delta and percentage label, got: %s", data)
+	}
+
+	if label := calculateDelta(0.0, 0.0).PercentageLabel; label != "n/a" {
+		t.Errorf("Expected percentage label 'n/a' for 0 -> 0, got %q", label)
+	}
+	if label := calculateDelta(80.0, 90.0).PercentageLabel; label != "" {
+		t.Errorf("Expected no percentage label when previous is non-zero, got %q", label)
+	}
+}
+
 // TestFormatTrendMarkdownRegression verifies regression formatting
 func TestFormatTrendMarkdownRegression(t *testing.T) {
 	trend := TrendData{
@@ -649,3 +690,171 @@ Generated: 2026-01-25 10:00:00
 		t.Fatal("Expected nil trends when insufficient data")
 	}
 }
+
+// TestEvalTrendsGraderVersionMismatch verifies the report warns when compared runs used different grader versions
+func TestEvalTrendsGraderVersionMismatch(t *testing.T) {
+	tmpDir := t.TempDir()
+	logPath := tmpDir + "/task-eval-log.json"
+
+	logContent := `[
+		{
+			"task_id": "task-001",
+			"timestamp": "2026-01-26T10:00:00Z",
+			"results": [
+				{"grader_name": "file-exists", "grader_version": "1.0.0", "passed": true, "score": 100},
+				{"grader_name": "test-exists", "grader_version": "1.0.0", "passed": true, "score": 100}
+			],
+			"overall_passed": true,
+			"overall_score": 100.0
+		},
+		{
+			"task_id": "task-001",
+			"timestamp": "2026-01-27T10:00:00Z",
+			"results": [
+				{"grader_name": "file-exists", "grader_version": "1.0.0", "passed": true, "score": 100},
+				{"grader_name": "test-exists", "grader_version": "1.1.0", "passed": false, "score": 50}
+			],
+			"overall_passed": false,
+			"overall_score": 75.0
+		}
+	]`
+
+	if err := writeFile(logPath, logContent); err != nil {
+		t.Fatalf("Failed to write test log: %v", err)
+	}
+
+	results, err := loadEvalResults(logPath)
+	if err != nil {
+		t.Fatalf("loadEvalResults failed: %v", err)
+	}
+	if results[1].Results[1].GraderVersion != "1.1.0" {
+		t.Errorf("Expected grader version 1.1.0 in loaded results, got %q", results[1].Results[1].GraderVersion)
+	}
+
+	trends, err := calculateEvalTrends(results)
+	if err != nil {
+		t.Fatalf("calculateEvalTrends failed: %v", err)
+	}
+	if len(trends.GraderVersionChanges) != 1 || trends.GraderVersionChanges[0] != "test-exists (1.0.0 → 1.1.0)" {
+		t.Fatalf("Expected only test-exists version change, got %v", trends.GraderVersionChanges)
+	}
+
+	markdown := formatEvalReportMarkdown(results, trends, true, defaultRegressionThreshold)
+	if !strings.Contains(markdown, "Grader versions differ between compared runs: test-exists (1.0.0 → 1.1.0)") {
+		t.Errorf("Expected grader version warning in report, got:\n%s", markdown)
+	}
+
+	// Matching versions produce no warning
+	sameVersion := []GradeTaskOutput{results[0], results[0]}
+	sameVersion[1].Timestamp = "2026-01-27T10:00:00Z"
+	trends, err = calculateEvalTrends(sameVersion)
+	if err != nil {
+		t.Fatalf("calculateEvalTrends failed: %v", err)
+	}
+	if strings.Contains(formatEvalReportMarkdown(sameVersion, trends, true, defaultRegressionThreshold), "Grader versions differ") {
+		t.Error("Expected no grader version warning when versions match")
+	}
+}
+
+// TestCollectGraderVersionsMixedRun verifies a run graded by several versions of a grader records all of them,
+// independent of the order its tasks are visited in
+func TestCollectGraderVersionsMixedRun(t *testing.T) {
+	older := GradeTaskOutput{Results: []codebased.GradeResult{{GraderName: "test-exists", GraderVersion: "1.0.0"}}}
+	newer := GradeTaskOutput{Results: []codebased.GradeResult{{GraderName: "test-exists", GraderVersion: "1.1.0"}}}
+	legacy := GradeTaskOutput{Results: []codebased.GradeResult{{GraderName: "test-exists"}}}
+
+	for _, order := range [][]GradeTaskOutput{{older, newer, legacy, newer}, {legacy, newer, older}} {
+		versions := make(map[string]string)
+		for _, result := range order {
+			collectGraderVersions(result, versions)
+		}
+		if got, want := versions["test-exists"], "1.0.0, 1.1.0, unversioned"; got != want {
+			t.Errorf("Expected versions %q, got %q", want, got)
+		}
+	}
+}
+
+// TestReportTrendsRegressions verifies only regressions beyond the threshold are listed, in a stable order
+func TestReportTrendsRegressions(t *testing.T) {
+	trends := reportTrends{
+		grade: &GradeTrends{
+			AverageScore: calculateDelta(80, 72),
+			PassRate:     calculateDelta(90, 88),
+			TotalSkills:  calculateDelta(10, 10),
+			PerCriteriaTrends: map[string]TrendData{
+				"Good Examples":      calculateDelta(70, 60),
+				"Clear Instructions": calculateDelta(80, 90),
+			},
+		},
+		eval: &EvalTrends{
+			AverageScore:  calculateDelta(70, 75),
+			PassRate:      calculateDelta(50, 50),
+			PerTaskTrends: map[string]TrendData{"task-002": calculateDelta(80, 40)},
+		},
+	}
+
+	got := trends.regressions(defaultRegressionThreshold)
+	want := []string{
+		"grade average_score -10.0%",
+		"grade criteria/Good Examples -14.3%",
+		"eval task/task-002 -50.0%",
+	}
+	if strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("Expected regressions %v, got %v", want, got)
+	}
+
+	// A higher threshold tolerates the smaller drops
+	if got := trends.regressions(20); len(got) != 1 || got[0] != "eval task/task-002 -50.0%" {
+		t.Errorf("Expected only task-002 beyond 20%%, got %v", got)
+	}
+
+	err := checkRegressions(trends, defaultRegressionThreshold)
+	if !errors.Is(err, errRegressionDetected) || !strings.Contains(err.Error(), "beyond 5% threshold in 3 metrics") {
+		t.Errorf("Expected regression error listing 3 metrics, got %v", err)
+	}
+	if err := checkRegressions(reportTrends{}, defaultRegressionThreshold); err != nil {
+		t.Errorf("Expected no error without trends, got %v", err)
+	}
+}
+
+// writeGradeHistoryReports writes one skill-clarity report per average score, a day apart from 2026-01-21
+func writeGradeHistoryReports(t *testing.T, dir string, scores []float64) {
+	t.Helper()
+	for i, score := range scores {
+		date := time.Date(2026, 1, 21+i, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+		content := fmt.Sprintf("# Skill Clarity Report\n\nGenerated: %s 10:00:00\n\n## Summary\n\n- **Total Skills**: 10\n- **Average Score**: %.1f/100\n- **Pass Rate**: 80.0%% (8/10)\n- **Passing Threshold**: 70.0\n", date, score)
+		if err := writeFile(filepath.Join(dir, "skill-clarity-"+date+".md"), content); err != nil {
+			t.Fatalf("Failed to write report: %v", err)
+		}
+	}
+}
+
+// TestLoadGradeHistory verifies up to N of the newest reports are returned oldest first
+func TestLoadGradeHistory(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeGradeHistoryReports(t, tmpDir, []float64{60, 65, 70, 72, 80})
+
+	history, err := loadGradeHistory(tmpDir, 3)
+	if err != nil {
+		t.Fatalf("loadGradeHistory failed: %v", err)
+	}
+	var scores []string
+	for _, report := range history {
+		scores = append(scores, fmt.Sprintf("%.0f", report.AverageScore))
+	}
+	if got := strings.Join(scores, ","); got != "70,72,80" {
+		t.Errorf("Expected scores 70,72,80, got %s", got)
+	}
+
+	history, err = loadGradeHistory(tmpDir, 10)
+	if err != nil {
+		t.Fatalf("loadGradeHistory failed: %v", err)
+	}
+	if len(history) != 5 || filepath.Base(history[0].FilePath) != "skill-clarity-2026-01-21.md" {
+		t.Errorf("Expected all 5 reports starting with the oldest, got %d", len(history))
+	}
+
+	if _, err := loadGradeHistory(tmpDir, 0); err == nil {
+		t.Error("Expected error for a history of 0 reports")
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ValidateOptions holds optional settings for the validate command
+type ValidateOptions struct {
+	// Format is the output format: "text" (default) or "json"
+	Format string
+}
+
+// EvalFileValidation is the validation outcome of a single eval file
+type EvalFileValidation struct {
+	Path   string   `json:"path"`
+	Valid  bool     `json:"valid"`
+	Errors []string `json:"errors"`
+}
+
+// ValidateJSONResult is the structured validate output for CI logging
+type ValidateJSONResult struct {
+	Valid bool                 `json:"valid"`
+	Files []EvalFileValidation `json:"files"`
+}
+
+// validateEvalFile loads and validates a single eval.yaml file
+func validateEvalFile(path string) EvalFileValidation {
+	validation := EvalFileValidation{Path: path, Valid: true, Errors: []string{}}
+	if _, err := loadEvalYAML(path); err != nil {
+		validation.Valid = false
+		validation.Errors = append(validation.Errors, err.Error())
+	}
+	return validation
+}
+
+// runValidateCommand validates every eval.yaml under the agents and skills suites of metaDir
+func runValidateCommand(metaDir string, opts ValidateOptions) error {
+	format := opts.Format
+	if format == "" {
+		format = "text"
+	}
+	if format != "text" && format != "json" {
+		return fmt.Errorf("unsupported format: %s (use 'text' or 'json')", format)
+	}
+
+	if _, err := os.Stat(metaDir); os.IsNotExist(err) {
+		return fmt.Errorf("meta directory not found: %s", metaDir)
+	}
+
+	var evalFiles []string
+	for _, suite := range []string{"agents", "skills"} {
+		suiteDir := filepath.Join(metaDir, suite)
+		if _, err := os.Stat(suiteDir); os.IsNotExist(err) {
+			continue
+		}
+		files, err := findEvalFiles(suiteDir)
+		if err != nil {
+			return fmt.Errorf("finding eval files: %w", err)
+		}
+		evalFiles = append(evalFiles, files...)
+	}
+
+	if len(evalFiles) == 0 {
+		return fmt.Errorf("no eval.yaml files found in %s", metaDir)
+	}
+
+	result := ValidateJSONResult{Valid: true, Files: make([]EvalFileValidation, 0, len(evalFiles))}
+	invalidCount := 0
+	for _, path := range evalFiles {
+		validation := validateEvalFile(path)
+		if !validation.Valid {
+			result.Valid = false
+			invalidCount++
+		}
+		result.Files = append(result.Files, validation)
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding validate result: %w", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		for _, validation := range result.Files {
+			if validation.Valid {
+				fmt.Printf("[✓] %s\n", validation.Path)
+				continue
+			}
+			for _, msg := range validation.Errors {
+				fmt.Printf("[✗] %s: %s\n", validation.Path, msg)
+			}
+		}
+		fmt.Printf("\n%d/%d eval files valid\n", len(evalFiles)-invalidCount, len(evalFiles))
+	}
+
+	if !result.Valid {
+		return fmt.Errorf("%d of %d eval files are invalid", invalidCount, len(evalFiles))
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeValidateEval writes an eval.yaml for the named agent under metaDir/agents
+func writeValidateEval(t *testing.T, metaDir, agentDir, content string) string {
+	t.Helper()
+	dir := filepath.Join(metaDir, "agents", agentDir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("Failed to create agent dir: %v", err)
+	}
+	path := filepath.Join(dir, "eval.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write eval.yaml: %v", err)
+	}
+	return path
+}
+
+// TestRunValidateCommandJSON verifies the JSON output lists each file and fails on invalid ones
+func TestRunValidateCommandJSON(t *testing.T) {
+	metaDir := filepath.Join(t.TempDir(), "meta")
+	validPath := writeValidateEval(t, metaDir, "valid-agent", `agent: yokay-spec-reviewer
+consistency_threshold: 0.95
+test_cases:
+  - id: SR-001
+    name: "Valid case"
+    input:
+      task_title: "Add login"
+      task_description: "Implement login"
+    expected: PASS
+    rationale: "Complete"
+`)
+	invalidPath := writeValidateEval(t, metaDir, "invalid-agent", `agent: yokay-spec-reviewer
+consistency_threshold: 0.95
+test_cases:
+  - id: bad-id
+    name: "Invalid case"
+    input:
+      task_title: "Add login"
+      task_description: "Implement login"
+    expected: PASS
+    rationale: "Bad ID"
+`)
+
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	err := runValidateCommand(metaDir, ValidateOptions{Format: "json"})
+
+	w.Close()
+	os.Stdout = oldStdout
+	var buf bytes.Buffer
+	buf.ReadFrom(r)
+
+	// A returned error makes main exit non-zero
+	if err == nil || !strings.Contains(err.Error(), "1 of 2 eval files are invalid") {
+		t.Errorf("Expected invalid eval files error, got %v", err)
+	}
+
+	var result ValidateJSONResult
+	if err := json.Unmarshal(buf.Bytes(), &result); err != nil {
+		t.Fatalf("Output is not valid JSON: %v\n%s", err, buf.String())
+	}
+	if result.Valid {
+		t.Error("Expected overall valid to be false")
+	}
+
+	files := make(map[string]EvalFileValidation)
+	for _, file := range result.Files {
+		files[file.Path] = file
+	}
+	if len(files) != 2 {
+		t.Fatalf("Expected 2 files, got %d", len(result.Files))
+	}
+	if valid := files[validPath]; !valid.Valid || len(valid.Errors) != 0 {
+		t.Errorf("Expected %s to be valid, got %+v", validPath, valid)
+	}
+	invalid := files[invalidPath]
+	if invalid.Valid || len(invalid.Errors) != 1 || !strings.Contains(invalid.Errors[0], "bad-id") {
+		t.Errorf("Expected %s to be invalid with an ID error, got %+v", invalidPath, invalid)
+	}
+}
+
+// TestRunValidateCommandAllValid verifies no error is returned when every file is valid
+func TestRunValidateCommandAllValid(t *testing.T) {
+	metaDir := filepath.Join(t.TempDir(), "meta")
+	writeValidateEval(t, metaDir, "valid-agent", `agent: yokay-spec-reviewer
+test_cases:
+  - id: SR-001
+    name: "Valid case"
+    input:
+      task_title: "Add login"
+      task_description: "Implement login"
+    expected: PASS
+    rationale: "Complete"
+`)
+
+	if err := runValidateCommand(metaDir, ValidateOptions{}); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+// TestRunValidateCommandInvalidFormat verifies unknown formats are rejected
+func TestRunValidateCommandInvalidFormat(t *testing.T) {
+	err := runValidateCommand(t.TempDir(), ValidateOptions{Format: "xml"})
+	if err == nil || !strings.Contains(err.Error(), "unsupported format") {
+		t.Errorf("Expected format error, got %v", err)
+	}
+}
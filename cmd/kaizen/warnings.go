@@ -0,0 +1,49 @@
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// Warnings collects the non-fatal problems a command runs into, such as unreadable files,
+// skills that could not be graded or trend data that could not be loaded, so JSON output
+// can list them in a "warnings" array instead of losing them on stderr
+type Warnings struct {
+	messages []string
+	// echo prints each warning to stderr as it is added, for text output
+	echo bool
+}
+
+// newWarnings returns a collector for a command writing the given output format.
+// Warnings are printed to stderr unless the format is json.
+func newWarnings(format string) *Warnings {
+	return &Warnings{echo: format != "json"}
+}
+
+// Addf records a warning. A nil collector only prints it to stderr.
+func (w *Warnings) Addf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	if w == nil || w.echo {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", message)
+	}
+	if w != nil {
+		w.messages = append(w.messages, message)
+	}
+}
+
+// List returns the warnings in the order they were added; it is never nil so JSON
+// output always has a warnings array
+func (w *Warnings) List() []string {
+	if w == nil || len(w.messages) == 0 {
+		return []string{}
+	}
+	return w.messages
+}
+
+// addWarnings sets the "warnings" array of a JSON output object. A nil collector adds nothing,
+// for output that is embedded in another report.
+func addWarnings(data map[string]interface{}, warnings *Warnings) {
+	if warnings != nil {
+		data["warnings"] = warnings.List()
+	}
+}
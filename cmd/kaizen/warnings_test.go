@@ -0,0 +1,53 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestWarningsList verifies warnings are kept in order and an empty or nil collector lists none
+func TestWarningsList(t *testing.T) {
+	var missing *Warnings
+	if list := missing.List(); list == nil || len(list) != 0 {
+		t.Errorf("Expected an empty, non-nil list from a nil collector, got %#v", list)
+	}
+
+	warnings := newWarnings("json")
+	if list := warnings.List(); list == nil || len(list) != 0 {
+		t.Errorf("Expected an empty, non-nil list, got %#v", list)
+	}
+
+	warnings.Addf("Failed to read %s", "a/SKILL.md")
+	warnings.Addf("unknown category %q", "typo")
+	want := []string{"Failed to read a/SKILL.md", `unknown category "typo"`}
+	if got := warnings.List(); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected warnings %q, got %q", want, got)
+	}
+}
+
+// TestAddWarnings verifies a collector always adds a warnings array and a nil collector adds none
+func TestAddWarnings(t *testing.T) {
+	section := map[string]interface{}{"report_type": "eval"}
+	addWarnings(section, nil)
+	if _, ok := section["warnings"]; ok {
+		t.Errorf("Expected no warnings key for a nil collector, got %v", section)
+	}
+
+	warnings := newWarnings("json")
+	data := map[string]interface{}{"report_type": "eval"}
+	addWarnings(data, warnings)
+	output, err := json.Marshal(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(output) != `{"report_type":"eval","warnings":[]}` {
+		t.Errorf("Expected an empty warnings array, got %s", output)
+	}
+
+	warnings.Addf("Could not load trend data: %s", "insufficient data")
+	addWarnings(data, warnings)
+	if list, ok := data["warnings"].([]string); !ok || len(list) != 1 {
+		t.Errorf("Expected one warning, got %v", data["warnings"])
+	}
+}
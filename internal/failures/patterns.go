@@ -1,6 +1,9 @@
 package failures
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+)
 
 // ConfidenceLevel represents the confidence level based on occurrence count
 type ConfidenceLevel string
@@ -102,6 +105,35 @@ func DetectCategory(text string) (Category, bool) {
 	return "", false
 }
 
+// DetectCategoryWithConfidence detects the first matching category along with a confidence
+// score between 0 and 1: the share of all matched patterns that belong to that category.
+// Text matching patterns from several categories is ambiguous and scores lower.
+// Returns empty string, 0 and false if no match is found.
+func DetectCategoryWithConfidence(text string) (Category, float64, bool) {
+	lowerText := strings.ToLower(text)
+
+	var primary Category
+	primaryHits, totalHits := 0, 0
+	for _, cp := range categoryPatterns {
+		hits := 0
+		for _, pattern := range cp.Patterns {
+			if strings.Contains(lowerText, pattern) {
+				hits++
+			}
+		}
+		if hits > 0 && primary == "" {
+			primary = cp.Category
+			primaryHits = hits
+		}
+		totalHits += hits
+	}
+
+	if totalHits == 0 {
+		return "", 0, false
+	}
+	return primary, float64(primaryHits) / float64(totalHits), true
+}
+
 // DetectAllCategories detects all matching categories from the given text.
 // It performs case-insensitive matching against predefined patterns.
 // Returns a slice of all matched categories. Returns an empty slice if no matches are found.
@@ -124,3 +156,30 @@ func DetectAllCategories(text string) []Category {
 
 	return categories
 }
+
+var (
+	// timestampPattern matches ISO-8601 dates with optional time and zone, and bare clock times
+	timestampPattern = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}(?:[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?)?\b|\b\d{2}:\d{2}:\d{2}(?:[.,]\d+)?\b`)
+	// uuidPattern matches UUIDs
+	uuidPattern = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	// hexPattern matches 0x-prefixed hex values and long hex strings such as hashes and addresses
+	hexPattern = regexp.MustCompile(`(?i)\b0x[0-9a-f]+\b|\b[0-9a-f]{8,}\b`)
+	// filePathPattern matches Unix and Windows file paths with optional :line:col suffixes. Only
+	// path-shaped words count: a leading /, ./, ../, ~/ or drive letter, or a file extension, so
+	// phrases like "and/or" or "tests/coverage" are kept. Group 1 is the preceding delimiter.
+	filePathPattern = regexp.MustCompile(`(^|[\s"'(\[=])(?:(?:[A-Za-z]:|\.{1,2}|~)?[/\\][\w.~-]+(?:[/\\][\w.~-]+)*|[\w.~-]+(?:[/\\][\w.~-]+)*[/\\][\w.-]+\.[A-Za-z]\w*)(?::\d+)*`)
+	// whitespacePattern matches runs of whitespace
+	whitespacePattern = regexp.MustCompile(`\s+`)
+)
+
+// NormalizeDetails strips noise from raw failure details before category detection.
+// It removes timestamps, UUIDs, hex values and file paths, then collapses whitespace,
+// so that patterns split across log lines still match and paths can't cause false matches.
+func NormalizeDetails(text string) string {
+	normalized := timestampPattern.ReplaceAllString(text, " ")
+	normalized = uuidPattern.ReplaceAllString(normalized, " ")
+	normalized = filePathPattern.ReplaceAllString(normalized, "${1} ")
+	normalized = hexPattern.ReplaceAllString(normalized, " ")
+	normalized = whitespacePattern.ReplaceAllString(normalized, " ")
+	return strings.TrimSpace(normalized)
+}
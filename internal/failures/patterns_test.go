@@ -241,3 +241,95 @@ func TestDetectAllCategories(t *testing.T) {
 		})
 	}
 }
+
+// TestDetectCategoryWithConfidence verifies ambiguous text lowers the confidence of the top match
+func TestDetectCategoryWithConfidence(t *testing.T) {
+	tests := []struct {
+		name           string
+		text           string
+		wantCat        Category
+		wantConfidence float64
+		wantFound      bool
+	}{
+		{
+			name:           "single category",
+			text:           "This function is untested and has no test",
+			wantCat:        CategoryMissingTests,
+			wantConfidence: 1.0,
+			wantFound:      true,
+		},
+		{
+			name:           "two categories split evenly",
+			text:           "Untested change that is out of scope",
+			wantCat:        CategoryMissingTests,
+			wantConfidence: 0.5,
+			wantFound:      true,
+		},
+		{
+			name:           "no match",
+			text:           "Everything went fine",
+			wantCat:        "",
+			wantConfidence: 0,
+			wantFound:      false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCat, gotConfidence, gotFound := DetectCategoryWithConfidence(tt.text)
+			if gotCat != tt.wantCat || gotFound != tt.wantFound {
+				t.Errorf("DetectCategoryWithConfidence() = (%v, %v), want (%v, %v)", gotCat, gotFound, tt.wantCat, tt.wantFound)
+			}
+			if gotConfidence != tt.wantConfidence {
+				t.Errorf("confidence = %v, want %v", gotConfidence, tt.wantConfidence)
+			}
+		})
+	}
+}
+
+func TestNormalizeDetails(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "strips timestamps",
+			text: "2026-01-27T10:15:30.123Z ERROR missing test at 10:15:31",
+			want: "ERROR missing test at",
+		},
+		{
+			name: "strips file paths with line numbers",
+			text: "panic in /repo/pkg/untested/handler.go:42:7 and C:\\src\\app.js",
+			want: "panic in and",
+		},
+		{
+			name: "strips relative and home paths",
+			text: "see ./cmd/main.go, ../lib/util.py and (~/notes/todo) plus src/app.test.ts:10",
+			want: "see , and ( ) plus",
+		},
+		{
+			name: "keeps slash-joined words that are not paths",
+			text: "missing tests/coverage for the and/or branch, 1/2 passed",
+			want: "missing tests/coverage for the and/or branch, 1/2 passed",
+		},
+		{
+			name: "strips uuids and hex values",
+			text: "request 3f2504e0-4f89-11d3-9a0c-0305e82c3301 at 0xc000123abc commit deadbeef12",
+			want: "request at commit",
+		},
+		{
+			name: "collapses whitespace across lines",
+			text: "wrong\n\t  file\r\nedited",
+			want: "wrong file edited",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeDetails(tt.text); got != tt.want {
+				t.Errorf("NormalizeDetails(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
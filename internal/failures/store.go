@@ -3,17 +3,23 @@ package failures
 import (
 	"database/sql"
 	"fmt"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
+// busyTimeoutMillis is how long a connection waits for another process's write lock before
+// SQLite reports "database is locked"
+const busyTimeoutMillis = 5000
+
 // Store handles persistence of failure data using SQLite
 type Store struct {
 	db *sql.DB
 }
 
-// Failure represents a single failure record
+// Failure represents a single failure record.
+// CreatedAt is when the failure was captured, in UTC.
 type Failure struct {
 	ID        int
 	TaskID    string
@@ -26,11 +32,16 @@ type Failure struct {
 // NewStore creates a new Store with the specified database path.
 // It opens the SQLite database, creates tables if they don't exist,
 // and returns the store instance.
+//
+// A Store is safe for concurrent use: its queries share a single connection, so goroutines
+// never contend for SQLite's file lock, and other processes writing to the same database are
+// waited on for up to busyTimeoutMillis.
 func NewStore(dbPath string) (*Store, error) {
-	db, err := sql.Open("sqlite3", dbPath)
+	db, err := sql.Open("sqlite3", sqliteDSN(dbPath))
 	if err != nil {
 		return nil, fmt.Errorf("opening database: %w", err)
 	}
+	db.SetMaxOpenConns(1)
 
 	store := &Store{db: db}
 
@@ -42,6 +53,15 @@ func NewStore(dbPath string) (*Store, error) {
 	return store, nil
 }
 
+// sqliteDSN adds the busy timeout to dbPath, keeping any query parameters it already has
+func sqliteDSN(dbPath string) string {
+	separator := "?"
+	if strings.Contains(dbPath, "?") {
+		separator = "&"
+	}
+	return fmt.Sprintf("%s%s_busy_timeout=%d", dbPath, separator, busyTimeoutMillis)
+}
+
 // Close closes the database connection
 func (s *Store) Close() error {
 	if s.db != nil {
@@ -90,12 +110,103 @@ func (s *Store) initSchema() error {
 		return fmt.Errorf("creating category index: %w", err)
 	}
 
+	// Create index on created_at column for time-windowed range scans
+	_, err = s.db.Exec(`
+		CREATE INDEX IF NOT EXISTS idx_failures_created_at
+		ON failures(created_at)
+	`)
+	if err != nil {
+		return fmt.Errorf("creating created_at index: %w", err)
+	}
+
+	if err := s.normalizeTimestamps(); err != nil {
+		return fmt.Errorf("normalizing timestamps to UTC: %w", err)
+	}
+
+	return nil
+}
+
+// utcSuffix ends every timestamp the sqlite3 driver writes for a UTC time.Time
+const utcSuffix = "+00:00"
+
+// normalizeTimestamps rewrites timestamps stored with a local offset, as earlier versions
+// wrote them, in UTC. Timestamps are compared as text, so mixed offsets order wrongly.
+// Rows already in UTC are left untouched, so this is a no-op once a database is migrated.
+func (s *Store) normalizeTimestamps() error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning migration transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	type failureTime struct {
+		id        int
+		createdAt time.Time
+	}
+	rows, err := tx.Query(`SELECT id, created_at FROM failures WHERE created_at NOT LIKE '%' || ?`, utcSuffix)
+	if err != nil {
+		return fmt.Errorf("querying local failure timestamps: %w", err)
+	}
+	var failureTimes []failureTime
+	for rows.Next() {
+		var ft failureTime
+		if err := rows.Scan(&ft.id, &ft.createdAt); err != nil {
+			rows.Close()
+			return fmt.Errorf("scanning failure timestamp: %w", err)
+		}
+		failureTimes = append(failureTimes, ft)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterating failure timestamps: %w", err)
+	}
+	for _, ft := range failureTimes {
+		if _, err := tx.Exec(`UPDATE failures SET created_at = ? WHERE id = ?`, ft.createdAt.UTC(), ft.id); err != nil {
+			return fmt.Errorf("updating failure %d timestamp: %w", ft.id, err)
+		}
+	}
+
+	type statTimes struct {
+		category            string
+		firstSeen, lastSeen time.Time
+	}
+	rows, err = tx.Query(`
+		SELECT category, first_seen, last_seen FROM category_stats
+		WHERE first_seen NOT LIKE '%' || ?1 OR last_seen NOT LIKE '%' || ?1
+	`, utcSuffix)
+	if err != nil {
+		return fmt.Errorf("querying local category stats timestamps: %w", err)
+	}
+	var stats []statTimes
+	for rows.Next() {
+		var st statTimes
+		if err := rows.Scan(&st.category, &st.firstSeen, &st.lastSeen); err != nil {
+			rows.Close()
+			return fmt.Errorf("scanning category stats timestamps: %w", err)
+		}
+		stats = append(stats, st)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("iterating category stats timestamps: %w", err)
+	}
+	for _, st := range stats {
+		if _, err := tx.Exec(`
+			UPDATE category_stats SET first_seen = ?, last_seen = ? WHERE category = ?
+		`, st.firstSeen.UTC(), st.lastSeen.UTC(), st.category); err != nil {
+			return fmt.Errorf("updating category %q timestamps: %w", st.category, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing migration transaction: %w", err)
+	}
 	return nil
 }
 
 // UpsertCategoryStats inserts or updates category statistics in the database.
 // If the category already exists, it updates the occurrence count and timestamps.
-// If the category doesn't exist, it creates a new record.
+// If the category doesn't exist, it creates a new record. Timestamps are stored in UTC.
 func (s *Store) UpsertCategoryStats(category string, count int, firstSeen, lastSeen time.Time) error {
 	_, err := s.db.Exec(`
 		INSERT INTO category_stats (category, occurrence_count, first_seen, last_seen)
@@ -104,7 +215,7 @@ func (s *Store) UpsertCategoryStats(category string, count int, firstSeen, lastS
 			occurrence_count = excluded.occurrence_count,
 			first_seen = excluded.first_seen,
 			last_seen = excluded.last_seen
-	`, category, count, firstSeen, lastSeen)
+	`, category, count, firstSeen.UTC(), lastSeen.UTC())
 
 	if err != nil {
 		return fmt.Errorf("upserting category stats for %q: %w", category, err)
@@ -115,11 +226,13 @@ func (s *Store) UpsertCategoryStats(category string, count int, firstSeen, lastS
 
 // Insert inserts a new failure record into the failures table.
 // The created_at timestamp is automatically set to the current time if not provided.
+// Timestamps are stored in UTC so time-windowed queries compare consistently.
 func (s *Store) Insert(failure Failure) error {
 	createdAt := failure.CreatedAt
 	if createdAt.IsZero() {
 		createdAt = time.Now()
 	}
+	createdAt = createdAt.UTC()
 
 	_, err := s.db.Exec(`
 		INSERT INTO failures (task_id, category, details, source, created_at)
@@ -133,6 +246,21 @@ func (s *Store) Insert(failure Failure) error {
 	return nil
 }
 
+// GetAll retrieves every failure record, oldest first.
+// Returns an empty slice (not nil) if no failures are found.
+func (s *Store) GetAll() ([]Failure, error) {
+	rows, err := s.db.Query(`
+		SELECT id, task_id, category, details, source, created_at
+		FROM failures
+		ORDER BY created_at, id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("querying all failures: %w", err)
+	}
+
+	return scanFailures(rows)
+}
+
 // GetByCategory retrieves all failure records for the specified category.
 // Returns an empty slice (not nil) if no failures are found.
 func (s *Store) GetByCategory(category string) ([]Failure, error) {
@@ -145,6 +273,70 @@ func (s *Store) GetByCategory(category string) ([]Failure, error) {
 	if err != nil {
 		return nil, fmt.Errorf("querying failures for category %q: %w", category, err)
 	}
+
+	return scanFailures(rows)
+}
+
+// GetByCategorySince retrieves the failure records for the specified category
+// created at or after since, newest first.
+// Returns an empty slice (not nil) if no failures are found.
+func (s *Store) GetByCategorySince(category string, since time.Time) ([]Failure, error) {
+	rows, err := s.db.Query(`
+		SELECT id, task_id, category, details, source, created_at
+		FROM failures
+		WHERE category = ? AND created_at >= ?
+		ORDER BY created_at DESC
+	`, category, since.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("querying failures for category %q since %s: %w", category, since.Format(time.RFC3339), err)
+	}
+
+	return scanFailures(rows)
+}
+
+// GetRecent retrieves all failure records created at or after since, newest first.
+// Returns an empty slice (not nil) if no failures are found.
+func (s *Store) GetRecent(since time.Time) ([]Failure, error) {
+	rows, err := s.db.Query(`
+		SELECT id, task_id, category, details, source, created_at
+		FROM failures
+		WHERE created_at >= ?
+		ORDER BY created_at DESC
+	`, since.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("querying failures since %s: %w", since.Format(time.RFC3339), err)
+	}
+
+	return scanFailures(rows)
+}
+
+// likeEscaper escapes the LIKE wildcards % and _ and the escape character itself
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// Search retrieves the failure records whose details contain query, newest first.
+// Matching is a substring match that ignores ASCII case; % and _ in query match literally.
+// Returns an empty slice (not nil) if no failures match.
+func (s *Store) Search(query string) ([]Failure, error) {
+	if query == "" {
+		return nil, fmt.Errorf("search query must not be empty")
+	}
+
+	rows, err := s.db.Query(`
+		SELECT id, task_id, category, details, source, created_at
+		FROM failures
+		WHERE details LIKE ? ESCAPE '\'
+		ORDER BY created_at DESC, id DESC
+	`, "%"+likeEscaper.Replace(query)+"%")
+	if err != nil {
+		return nil, fmt.Errorf("searching failures for %q: %w", query, err)
+	}
+
+	return scanFailures(rows)
+}
+
+// scanFailures reads failure rows and closes them.
+// Returns an empty slice (not nil) if there are no rows.
+func scanFailures(rows *sql.Rows) ([]Failure, error) {
 	defer rows.Close()
 
 	var failures []Failure
@@ -189,44 +381,434 @@ func (s *Store) GetOccurrenceCount(category string) (int, error) {
 	return count, nil
 }
 
-// IncrementCount increments the occurrence count for the specified category.
-// If the category doesn't exist, it creates a new record with count=1.
-// Updates last_seen to the current time.
-func (s *Store) IncrementCount(category string) error {
-	now := time.Now()
+// ListCategories returns the categories recorded in category_stats, most frequent first.
+func (s *Store) ListCategories() ([]string, error) {
+	return s.queryStrings(`
+		SELECT category
+		FROM category_stats
+		ORDER BY occurrence_count DESC, category
+	`, "listing categories")
+}
+
+// CategoryStat is the occurrence summary of a category from category_stats
+type CategoryStat struct {
+	Category        string
+	OccurrenceCount int
+	FirstSeen       time.Time
+	LastSeen        time.Time
+}
+
+// TopCategories returns up to n categories from category_stats, most frequent first.
+// Ties are ordered by category name; an n above the number of categories returns all of them.
+func (s *Store) TopCategories(n int) ([]CategoryStat, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("n must be positive, got %d", n)
+	}
+
+	rows, err := s.db.Query(`
+		SELECT category, occurrence_count, first_seen, last_seen
+		FROM category_stats
+		ORDER BY occurrence_count DESC, category
+		LIMIT ?
+	`, n)
+	if err != nil {
+		return nil, fmt.Errorf("querying top categories: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []CategoryStat
+	for rows.Next() {
+		var stat CategoryStat
+		if err := rows.Scan(&stat.Category, &stat.OccurrenceCount, &stat.FirstSeen, &stat.LastSeen); err != nil {
+			return nil, fmt.Errorf("scanning category stats: %w", err)
+		}
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating category stats: %w", err)
+	}
+
+	return stats, nil
+}
+
+// CategoryStats returns every category from category_stats, most frequent first, with ties
+// ordered by category name
+func (s *Store) CategoryStats() ([]CategoryStat, error) {
+	rows, err := s.db.Query(`
+		SELECT category, occurrence_count, first_seen, last_seen
+		FROM category_stats
+		ORDER BY occurrence_count DESC, category
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("querying category stats: %w", err)
+	}
+	defer rows.Close()
+
+	var stats []CategoryStat
+	for rows.Next() {
+		var stat CategoryStat
+		if err := rows.Scan(&stat.Category, &stat.OccurrenceCount, &stat.FirstSeen, &stat.LastSeen); err != nil {
+			return nil, fmt.Errorf("scanning category stats: %w", err)
+		}
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating category stats: %w", err)
+	}
 
-	// Try to get existing stats
-	var existingCount int
-	var firstSeen time.Time
+	return stats, nil
+}
+
+// TotalOccurrences returns the sum of occurrence counts across all categories in category_stats
+func (s *Store) TotalOccurrences() (int, error) {
+	var total int
 	err := s.db.QueryRow(`
-		SELECT occurrence_count, first_seen
+		SELECT COALESCE(SUM(occurrence_count), 0)
 		FROM category_stats
-		WHERE category = ?
-	`, category).Scan(&existingCount, &firstSeen)
+	`).Scan(&total)
+	if err != nil {
+		return 0, fmt.Errorf("summing occurrence counts: %w", err)
+	}
+	return total, nil
+}
 
-	if err == sql.ErrNoRows {
-		// Category doesn't exist, insert with count=1
-		_, err = s.db.Exec(`
+// ListSources returns the distinct sources of recorded failures, most frequent first.
+func (s *Store) ListSources() ([]string, error) {
+	return s.queryStrings(`
+		SELECT source
+		FROM failures
+		GROUP BY source
+		ORDER BY COUNT(*) DESC, source
+	`, "listing sources")
+}
+
+// CoOccurrences counts, for each pair of categories, how many distinct task_ids
+// have failures in both. The map is symmetric: counts[a][b] == counts[b][a].
+// Categories that never share a task with another category are absent.
+func (s *Store) CoOccurrences() (map[string]map[string]int, error) {
+	rows, err := s.db.Query(`
+		SELECT a.category, b.category, COUNT(DISTINCT a.task_id)
+		FROM failures a
+		JOIN failures b ON a.task_id = b.task_id AND a.category < b.category
+		GROUP BY a.category, b.category
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("querying category co-occurrences: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]map[string]int)
+	add := func(from, to string, count int) {
+		if counts[from] == nil {
+			counts[from] = make(map[string]int)
+		}
+		counts[from][to] = count
+	}
+	for rows.Next() {
+		var first, second string
+		var count int
+		if err := rows.Scan(&first, &second, &count); err != nil {
+			return nil, fmt.Errorf("scanning co-occurrence row: %w", err)
+		}
+		add(first, second, count)
+		add(second, first, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating co-occurrence rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+// Stats is a summary of recorded failures, with counts bucketed by time.
+type Stats struct {
+	Total      int
+	ByCategory map[string]int
+	Buckets    []BucketCount
+}
+
+// BucketCount is the number of failures whose created_at falls in the bucket starting at Start (UTC).
+type BucketCount struct {
+	Start      time.Time
+	Count      int
+	ByCategory map[string]int
+}
+
+// ValidStatsBucket reports whether bucket is a supported Stats bucket size.
+func ValidStatsBucket(bucket string) bool {
+	return bucket == "day" || bucket == "week" || bucket == "month"
+}
+
+// bucketStart truncates t (in UTC) to the start of its day, ISO week (Monday) or month.
+func bucketStart(t time.Time, bucket string) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch bucket {
+	case "week":
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day
+}
+
+// Stats returns failure totals and per-bucket counts, oldest bucket first.
+// bucket is "day", "week" or "month"; buckets without failures are omitted.
+func (s *Store) Stats(bucket string) (Stats, error) {
+	if !ValidStatsBucket(bucket) {
+		return Stats{}, fmt.Errorf("unsupported bucket: %s (use 'day', 'week' or 'month')", bucket)
+	}
+
+	rows, err := s.db.Query(`
+		SELECT category, created_at
+		FROM failures
+		ORDER BY created_at
+	`)
+	if err != nil {
+		return Stats{}, fmt.Errorf("querying failure stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := Stats{ByCategory: make(map[string]int), Buckets: []BucketCount{}}
+	for rows.Next() {
+		var category string
+		var createdAt time.Time
+		if err := rows.Scan(&category, &createdAt); err != nil {
+			return Stats{}, fmt.Errorf("scanning failure stats row: %w", err)
+		}
+
+		stats.Total++
+		stats.ByCategory[category]++
+
+		start := bucketStart(createdAt, bucket)
+		if n := len(stats.Buckets); n == 0 || !stats.Buckets[n-1].Start.Equal(start) {
+			stats.Buckets = append(stats.Buckets, BucketCount{Start: start, ByCategory: make(map[string]int)})
+		}
+		current := &stats.Buckets[len(stats.Buckets)-1]
+		current.Count++
+		current.ByCategory[category]++
+	}
+	if err := rows.Err(); err != nil {
+		return Stats{}, fmt.Errorf("iterating failure stats rows: %w", err)
+	}
+
+	return stats, nil
+}
+
+// CountOlderThan counts the failures created before cutoff, per category.
+func (s *Store) CountOlderThan(cutoff time.Time) (map[string]int, error) {
+	rows, err := s.db.Query(`
+		SELECT category, COUNT(*)
+		FROM failures
+		WHERE created_at < ?
+		GROUP BY category
+	`, cutoff.UTC())
+	if err != nil {
+		return nil, fmt.Errorf("counting failures before %s: %w", cutoff.Format(time.RFC3339), err)
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var category string
+		var count int
+		if err := rows.Scan(&category, &count); err != nil {
+			return nil, fmt.Errorf("scanning failure count row: %w", err)
+		}
+		counts[category] = count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating failure count rows: %w", err)
+	}
+
+	return counts, nil
+}
+
+// DeleteOlderThan deletes the failures created before cutoff and returns how many were deleted.
+// category_stats is recomputed from the remaining failures in the same transaction, so
+// categories without remaining failures are removed from it.
+func (s *Store) DeleteOlderThan(cutoff time.Time) (int, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return 0, fmt.Errorf("beginning prune transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	result, err := tx.Exec(`DELETE FROM failures WHERE created_at < ?`, cutoff.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("deleting failures before %s: %w", cutoff.Format(time.RFC3339), err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("counting deleted failures: %w", err)
+	}
+
+	if _, err := tx.Exec(`
+		DELETE FROM category_stats
+		WHERE category NOT IN (SELECT DISTINCT category FROM failures)
+	`); err != nil {
+		return 0, fmt.Errorf("removing stale category stats: %w", err)
+	}
+
+	// WHERE true disambiguates the upsert clause from the SELECT's join syntax
+	if _, err := tx.Exec(`
+		INSERT INTO category_stats (category, occurrence_count, first_seen, last_seen)
+		SELECT category, COUNT(*), MIN(created_at), MAX(created_at)
+		FROM failures
+		WHERE true
+		GROUP BY category
+		ON CONFLICT(category) DO UPDATE SET
+			occurrence_count = excluded.occurrence_count,
+			first_seen = excluded.first_seen,
+			last_seen = excluded.last_seen
+	`); err != nil {
+		return 0, fmt.Errorf("recomputing category stats: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("committing prune transaction: %w", err)
+	}
+
+	return int(deleted), nil
+}
+
+// Snapshot is the full contents of a failures database: every failure and every category's stats
+type Snapshot struct {
+	Failures      []Failure
+	CategoryStats []CategoryStat
+}
+
+// Snapshot reads both tables in a single transaction, so the failures and category stats are
+// consistent with each other even while other processes capture failures.
+// Failures are ordered oldest first and category stats by category name.
+func (s *Store) Snapshot() (Snapshot, error) {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("beginning snapshot transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.Query(`
+		SELECT id, task_id, category, details, source, created_at
+		FROM failures
+		ORDER BY created_at, id
+	`)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("querying failures: %w", err)
+	}
+	all, err := scanFailures(rows)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	rows, err = tx.Query(`
+		SELECT category, occurrence_count, first_seen, last_seen
+		FROM category_stats
+		ORDER BY category
+	`)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("querying category stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := []CategoryStat{}
+	for rows.Next() {
+		var stat CategoryStat
+		if err := rows.Scan(&stat.Category, &stat.OccurrenceCount, &stat.FirstSeen, &stat.LastSeen); err != nil {
+			return Snapshot{}, fmt.Errorf("scanning category stats: %w", err)
+		}
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return Snapshot{}, fmt.Errorf("iterating category stats: %w", err)
+	}
+
+	return Snapshot{Failures: all, CategoryStats: stats}, nil
+}
+
+// Restore loads a snapshot into an empty database in a single transaction, keeping failure IDs,
+// so either the whole snapshot is restored or nothing is. It fails if the database already
+// holds failures or category stats.
+func (s *Store) Restore(snapshot Snapshot) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning restore transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var existing int
+	if err := tx.QueryRow(`
+		SELECT (SELECT COUNT(*) FROM failures) + (SELECT COUNT(*) FROM category_stats)
+	`).Scan(&existing); err != nil {
+		return fmt.Errorf("checking database is empty: %w", err)
+	}
+	if existing > 0 {
+		return fmt.Errorf("database is not empty; restore needs a fresh database")
+	}
+
+	for _, failure := range snapshot.Failures {
+		if _, err := tx.Exec(`
+			INSERT INTO failures (id, task_id, category, details, source, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, failure.ID, failure.TaskID, failure.Category, failure.Details, failure.Source, failure.CreatedAt.UTC()); err != nil {
+			return fmt.Errorf("restoring failure %d: %w", failure.ID, err)
+		}
+	}
+
+	for _, stat := range snapshot.CategoryStats {
+		if _, err := tx.Exec(`
 			INSERT INTO category_stats (category, occurrence_count, first_seen, last_seen)
-			VALUES (?, 1, ?, ?)
-		`, category, now, now)
-		if err != nil {
-			return fmt.Errorf("inserting new category stats for %q: %w", category, err)
+			VALUES (?, ?, ?, ?)
+		`, stat.Category, stat.OccurrenceCount, stat.FirstSeen, stat.LastSeen); err != nil {
+			return fmt.Errorf("restoring category stats for %q: %w", stat.Category, err)
 		}
-		return nil
 	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing restore transaction: %w", err)
+	}
+
+	return nil
+}
+
+// queryStrings runs a query returning a single text column
+func (s *Store) queryStrings(query, action string) ([]string, error) {
+	rows, err := s.db.Query(query)
 	if err != nil {
-		return fmt.Errorf("checking existing category stats for %q: %w", category, err)
+		return nil, fmt.Errorf("%s: %w", action, err)
 	}
+	defer rows.Close()
 
-	// Category exists, increment count and update last_seen
-	_, err = s.db.Exec(`
-		UPDATE category_stats
-		SET occurrence_count = ?, last_seen = ?
-		WHERE category = ?
-	`, existingCount+1, now, category)
+	var values []string
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, fmt.Errorf("%s: scanning row: %w", action, err)
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", action, err)
+	}
+
+	return values, nil
+}
 
+// IncrementCount increments the occurrence count for the specified category.
+// If the category doesn't exist, it creates a new record with count=1.
+// Updates last_seen to the current time, in UTC like every other stored timestamp. The increment is a single statement, so
+// concurrent calls never lose updates.
+func (s *Store) IncrementCount(category string) error {
+	now := time.Now().UTC()
+
+	_, err := s.db.Exec(`
+		INSERT INTO category_stats (category, occurrence_count, first_seen, last_seen)
+		VALUES (?, 1, ?, ?)
+		ON CONFLICT(category) DO UPDATE SET
+			occurrence_count = occurrence_count + 1,
+			last_seen = excluded.last_seen
+	`, category, now, now)
 	if err != nil {
 		return fmt.Errorf("incrementing count for category %q: %w", category, err)
 	}
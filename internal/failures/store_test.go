@@ -2,8 +2,11 @@ package failures
 
 import (
 	"database/sql"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -803,6 +806,83 @@ func TestIncrementCountNew(t *testing.T) {
 	}
 }
 
+func TestIncrementCountStoresUTC(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	if err := store.IncrementCount("utc-category"); err != nil {
+		t.Fatalf("IncrementCount failed: %v", err)
+	}
+
+	var firstSeen, lastSeen string
+	err := store.db.QueryRow(`
+		SELECT CAST(first_seen AS TEXT), CAST(last_seen AS TEXT) FROM category_stats WHERE category = ?
+	`, "utc-category").Scan(&firstSeen, &lastSeen)
+	if err != nil {
+		t.Fatalf("failed to query timestamps: %v", err)
+	}
+	if !strings.HasSuffix(firstSeen, utcSuffix) || !strings.HasSuffix(lastSeen, utcSuffix) {
+		t.Errorf("expected UTC timestamps, got first_seen %q, last_seen %q", firstSeen, lastSeen)
+	}
+}
+
+func TestNewStoreNormalizesLocalTimestamps(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	store, err := NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("NewStore failed: %v", err)
+	}
+
+	// Rows written by earlier versions kept the local offset
+	zone := time.FixedZone("UTC+5", 5*60*60)
+	earlier := time.Date(2025, 3, 1, 2, 30, 0, 0, zone)
+	later := earlier.Add(3 * time.Hour).UTC()
+	if _, err := store.db.Exec(`
+		INSERT INTO failures (task_id, category, details, source, created_at) VALUES ('t1', 'missing-tests', 'd', 's', ?)
+	`, earlier); err != nil {
+		t.Fatalf("inserting local failure: %v", err)
+	}
+	if _, err := store.db.Exec(`
+		INSERT INTO category_stats (category, occurrence_count, first_seen, last_seen) VALUES ('missing-tests', 1, ?, ?)
+	`, earlier, later); err != nil {
+		t.Fatalf("inserting local category stats: %v", err)
+	}
+	store.Close()
+
+	store, err = NewStore(dbPath)
+	if err != nil {
+		t.Fatalf("reopening store failed: %v", err)
+	}
+	defer store.Close()
+
+	var createdAt, firstSeen, lastSeen string
+	if err := store.db.QueryRow(`SELECT CAST(created_at AS TEXT) FROM failures`).Scan(&createdAt); err != nil {
+		t.Fatalf("querying created_at: %v", err)
+	}
+	if err := store.db.QueryRow(`
+		SELECT CAST(first_seen AS TEXT), CAST(last_seen AS TEXT) FROM category_stats
+	`).Scan(&firstSeen, &lastSeen); err != nil {
+		t.Fatalf("querying category stats: %v", err)
+	}
+	want := earlier.UTC().Format("2006-01-02 15:04:05")
+	for name, value := range map[string]string{"created_at": createdAt, "first_seen": firstSeen} {
+		if !strings.HasPrefix(value, want) || !strings.HasSuffix(value, utcSuffix) {
+			t.Errorf("expected %s %s in UTC, got %q", name, want, value)
+		}
+	}
+	if !strings.HasSuffix(lastSeen, utcSuffix) {
+		t.Errorf("expected last_seen to stay in UTC, got %q", lastSeen)
+	}
+
+	failures, err := store.GetAll()
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if len(failures) != 1 || !failures[0].CreatedAt.Equal(earlier) {
+		t.Errorf("expected one failure at %v, got %+v", earlier, failures)
+	}
+}
+
 func TestIncrementCountExisting(t *testing.T) {
 	store := createTestStore(t)
 	defer store.Close()
@@ -875,3 +955,600 @@ func TestIncrementCountMultipleTimes(t *testing.T) {
 		t.Errorf("expected count 5, got %d", count)
 	}
 }
+
+func TestIncrementCountConcurrent(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	const goroutines, increments = 10, 25
+	errs := make(chan error, goroutines*increments)
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < increments; j++ {
+				if err := store.IncrementCount("concurrent-category"); err != nil {
+					errs <- err
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("IncrementCount failed: %v", err)
+	}
+
+	count, err := store.GetOccurrenceCount("concurrent-category")
+	if err != nil {
+		t.Fatalf("GetOccurrenceCount failed: %v", err)
+	}
+	if count != goroutines*increments {
+		t.Errorf("expected count %d, got %d", goroutines*increments, count)
+	}
+}
+
+// TestIncrementCountConcurrentStores covers separate processes sharing the database,
+// which contend for SQLite's file lock and rely on the busy timeout
+func TestIncrementCountConcurrentStores(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "test.db")
+	stores := make([]*Store, 3)
+	for i := range stores {
+		store, err := NewStore(dbPath)
+		if err != nil {
+			t.Fatalf("failed to create store %d: %v", i, err)
+		}
+		defer store.Close()
+		stores[i] = store
+	}
+
+	const increments = 25
+	errs := make(chan error, len(stores)*increments)
+	var wg sync.WaitGroup
+	for _, store := range stores {
+		wg.Add(1)
+		go func(store *Store) {
+			defer wg.Done()
+			for j := 0; j < increments; j++ {
+				if err := store.IncrementCount("shared-category"); err != nil {
+					errs <- err
+				}
+			}
+		}(store)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Errorf("IncrementCount failed: %v", err)
+	}
+
+	count, err := stores[0].GetOccurrenceCount("shared-category")
+	if err != nil {
+		t.Fatalf("GetOccurrenceCount failed: %v", err)
+	}
+	if count != len(stores)*increments {
+		t.Errorf("expected count %d, got %d", len(stores)*increments, count)
+	}
+}
+
+func TestSQLiteDSN(t *testing.T) {
+	tests := []struct {
+		dbPath string
+		want   string
+	}{
+		{"/tmp/failures.db", "/tmp/failures.db?_busy_timeout=5000"},
+		{"file:failures.db?cache=shared", "file:failures.db?cache=shared&_busy_timeout=5000"},
+	}
+	for _, tt := range tests {
+		if got := sqliteDSN(tt.dbPath); got != tt.want {
+			t.Errorf("sqliteDSN(%q) = %q, want %q", tt.dbPath, got, tt.want)
+		}
+	}
+}
+
+func TestListCategoriesAndSources(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	captures := []Failure{
+		{TaskID: "t1", Category: "missing-tests", Details: "d", Source: "quality-review"},
+		{TaskID: "t2", Category: "scope-creep", Details: "d", Source: "spec-review"},
+		{TaskID: "t3", Category: "missing-tests", Details: "d", Source: "quality-review"},
+	}
+	for _, f := range captures {
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+		if err := store.IncrementCount(f.Category); err != nil {
+			t.Fatalf("IncrementCount failed: %v", err)
+		}
+	}
+
+	categories, err := store.ListCategories()
+	if err != nil {
+		t.Fatalf("ListCategories failed: %v", err)
+	}
+	if len(categories) != 2 || categories[0] != "missing-tests" || categories[1] != "scope-creep" {
+		t.Errorf("expected [missing-tests scope-creep], got %v", categories)
+	}
+
+	sources, err := store.ListSources()
+	if err != nil {
+		t.Fatalf("ListSources failed: %v", err)
+	}
+	if len(sources) != 2 || sources[0] != "quality-review" || sources[1] != "spec-review" {
+		t.Errorf("expected [quality-review spec-review], got %v", sources)
+	}
+}
+
+func TestNewStoreCreatesCreatedAtIndex(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	var indexName string
+	err := store.db.QueryRow(`
+		SELECT name FROM sqlite_master
+		WHERE type='index' AND tbl_name='failures' AND name='idx_failures_created_at'
+	`).Scan(&indexName)
+	if err != nil {
+		t.Fatalf("index idx_failures_created_at does not exist: %v", err)
+	}
+}
+
+func TestGetRecentAndGetByCategorySince(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	now := time.Now()
+	// A non-UTC zone verifies timestamps compare by instant, not by local clock text
+	zone := time.FixedZone("UTC+5", 5*60*60)
+	records := []Failure{
+		{TaskID: "old", Category: "missing-tests", Details: "d", Source: "s", CreatedAt: now.Add(-30 * 24 * time.Hour)},
+		{TaskID: "recent-tests", Category: "missing-tests", Details: "d", Source: "s", CreatedAt: now.Add(-2 * time.Hour).In(zone)},
+		{TaskID: "recent-scope", Category: "scope-creep", Details: "d", Source: "s", CreatedAt: now.Add(-1 * time.Hour)},
+	}
+	for _, f := range records {
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+
+	since := now.Add(-7 * 24 * time.Hour)
+
+	recent, err := store.GetRecent(since)
+	if err != nil {
+		t.Fatalf("GetRecent failed: %v", err)
+	}
+	if len(recent) != 2 || recent[0].TaskID != "recent-scope" || recent[1].TaskID != "recent-tests" {
+		t.Errorf("expected [recent-scope recent-tests], got %+v", recent)
+	}
+	if !recent[1].CreatedAt.Equal(records[1].CreatedAt) {
+		t.Errorf("expected CreatedAt %v, got %v", records[1].CreatedAt, recent[1].CreatedAt)
+	}
+
+	byCategory, err := store.GetByCategorySince("missing-tests", since)
+	if err != nil {
+		t.Fatalf("GetByCategorySince failed: %v", err)
+	}
+	if len(byCategory) != 1 || byCategory[0].TaskID != "recent-tests" {
+		t.Errorf("expected [recent-tests], got %+v", byCategory)
+	}
+
+	none, err := store.GetRecent(now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("GetRecent failed: %v", err)
+	}
+	if none == nil || len(none) != 0 {
+		t.Errorf("expected empty non-nil slice, got %#v", none)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	now := time.Now()
+	records := []Failure{
+		{TaskID: "old", Category: "missing-tests", Details: "Missing TEST for parser", Source: "s", CreatedAt: now.Add(-2 * time.Hour)},
+		{TaskID: "new", Category: "scope-creep", Details: "added a test helper nobody asked for", Source: "s", CreatedAt: now.Add(-1 * time.Hour)},
+		{TaskID: "percent", Category: "missing-tests", Details: "coverage dropped to 50% in pkg", Source: "s", CreatedAt: now.Add(-3 * time.Hour)},
+		{TaskID: "underscore", Category: "missing-tests", Details: "no test for user_id lookup", Source: "s", CreatedAt: now.Add(-4 * time.Hour)},
+		{TaskID: "backslash", Category: "wrong-product", Details: `path C:\tmp\x`, Source: "s", CreatedAt: now.Add(-5 * time.Hour)},
+	}
+	for _, f := range records {
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{query: "test", want: []string{"new", "old", "underscore"}},
+		{query: "50%", want: []string{"percent"}},
+		{query: "%", want: []string{"percent"}},
+		{query: "user_id", want: []string{"underscore"}},
+		{query: "_", want: []string{"underscore"}},
+		{query: `C:\tmp`, want: []string{"backslash"}},
+		{query: "no match", want: []string{}},
+	}
+	for _, tt := range tests {
+		found, err := store.Search(tt.query)
+		if err != nil {
+			t.Fatalf("Search(%q) failed: %v", tt.query, err)
+		}
+		got := make([]string, 0, len(found))
+		for _, f := range found {
+			got = append(got, f.TaskID)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("Search(%q): expected %v, got %v", tt.query, tt.want, got)
+		}
+		if found == nil {
+			t.Errorf("Search(%q): expected non-nil slice", tt.query)
+		}
+	}
+
+	if _, err := store.Search(""); err == nil {
+		t.Error("expected error for empty query")
+	}
+}
+
+func TestCoOccurrences(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	now := time.Now()
+	records := []struct{ taskID, category string }{
+		{"task-1", "missing-tests"},
+		{"task-1", "scope-creep"},
+		{"task-2", "missing-tests"},
+		{"task-2", "scope-creep"},
+		{"task-2", "scope-creep"}, // repeated failure on the same task counts once
+		{"task-2", "regression"},
+		{"task-3", "missing-tests"},
+		{"task-4", "regression"},
+	}
+	for _, r := range records {
+		f := Failure{TaskID: r.taskID, Category: r.category, Details: "d", Source: "s", CreatedAt: now}
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+
+	counts, err := store.CoOccurrences()
+	if err != nil {
+		t.Fatalf("CoOccurrences failed: %v", err)
+	}
+
+	expected := map[string]map[string]int{
+		"missing-tests": {"scope-creep": 2, "regression": 1},
+		"scope-creep":   {"missing-tests": 2, "regression": 1},
+		"regression":    {"missing-tests": 1, "scope-creep": 1},
+	}
+	if len(counts) != len(expected) {
+		t.Errorf("expected %d categories, got %d: %v", len(expected), len(counts), counts)
+	}
+	for category, pairs := range expected {
+		for other, want := range pairs {
+			if got := counts[category][other]; got != want {
+				t.Errorf("expected %s+%s = %d, got %d", category, other, want, got)
+			}
+		}
+		if len(counts[category]) != len(pairs) {
+			t.Errorf("expected %d pairs for %s, got %v", len(pairs), category, counts[category])
+		}
+	}
+}
+
+func TestStatsBuckets(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	records := []struct {
+		category string
+		at       time.Time
+	}{
+		{"missing-tests", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)}, // Monday
+		{"scope-creep", time.Date(2026, 3, 2, 17, 30, 0, 0, time.UTC)},
+		{"missing-tests", time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)},
+		{"missing-tests", time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC)}, // next Monday
+		{"regression", time.Date(2026, 4, 1, 8, 0, 0, 0, time.UTC)},
+	}
+	for i, r := range records {
+		f := Failure{TaskID: fmt.Sprintf("task-%d", i), Category: r.category, Details: "d", Source: "s", CreatedAt: r.at}
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		bucket string
+		starts []string
+		counts []int
+	}{
+		{"day", []string{"2026-03-02", "2026-03-04", "2026-03-09", "2026-04-01"}, []int{2, 1, 1, 1}},
+		{"week", []string{"2026-03-02", "2026-03-09", "2026-03-30"}, []int{3, 1, 1}},
+		{"month", []string{"2026-03-01", "2026-04-01"}, []int{4, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.bucket, func(t *testing.T) {
+			stats, err := store.Stats(tt.bucket)
+			if err != nil {
+				t.Fatalf("Stats failed: %v", err)
+			}
+			if stats.Total != 5 || stats.ByCategory["missing-tests"] != 3 {
+				t.Errorf("unexpected totals: %d total, by category %v", stats.Total, stats.ByCategory)
+			}
+			if len(stats.Buckets) != len(tt.starts) {
+				t.Fatalf("expected %d buckets, got %d: %+v", len(tt.starts), len(stats.Buckets), stats.Buckets)
+			}
+			for i, b := range stats.Buckets {
+				if got := b.Start.Format("2006-01-02"); got != tt.starts[i] || b.Count != tt.counts[i] {
+					t.Errorf("bucket %d: expected %s=%d, got %s=%d", i, tt.starts[i], tt.counts[i], got, b.Count)
+				}
+			}
+		})
+	}
+
+	if _, err := store.Stats("year"); err == nil {
+		t.Error("expected error for unsupported bucket")
+	}
+}
+
+func TestDeleteOlderThan(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	now := time.Now().UTC()
+	records := []struct {
+		category string
+		age      time.Duration
+	}{
+		{"missing-tests", 120 * 24 * time.Hour},
+		{"missing-tests", 100 * 24 * time.Hour},
+		{"missing-tests", 10 * 24 * time.Hour},
+		{"scope-creep", 95 * 24 * time.Hour},
+		{"regression", 1 * time.Hour},
+	}
+	for i, r := range records {
+		f := Failure{TaskID: fmt.Sprintf("task-%d", i), Category: r.category, Details: "d", Source: "s", CreatedAt: now.Add(-r.age)}
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+		if err := store.IncrementCount(r.category); err != nil {
+			t.Fatalf("IncrementCount failed: %v", err)
+		}
+	}
+
+	cutoff := now.Add(-90 * 24 * time.Hour)
+	counts, err := store.CountOlderThan(cutoff)
+	if err != nil {
+		t.Fatalf("CountOlderThan failed: %v", err)
+	}
+	if len(counts) != 2 || counts["missing-tests"] != 2 || counts["scope-creep"] != 1 {
+		t.Errorf("unexpected counts before cutoff: %v", counts)
+	}
+
+	deleted, err := store.DeleteOlderThan(cutoff)
+	if err != nil {
+		t.Fatalf("DeleteOlderThan failed: %v", err)
+	}
+	if deleted != 3 {
+		t.Errorf("expected 3 deleted, got %d", deleted)
+	}
+
+	remaining, err := store.GetRecent(time.Time{})
+	if err != nil {
+		t.Fatalf("GetRecent failed: %v", err)
+	}
+	if len(remaining) != 2 {
+		t.Errorf("expected 2 remaining failures, got %d", len(remaining))
+	}
+
+	// category_stats follows the remaining rows
+	for category, want := range map[string]int{"missing-tests": 1, "scope-creep": 0, "regression": 1} {
+		got, err := store.GetOccurrenceCount(category)
+		if err != nil {
+			t.Fatalf("GetOccurrenceCount failed: %v", err)
+		}
+		if got != want {
+			t.Errorf("expected %s count %d after prune, got %d", category, want, got)
+		}
+	}
+	categories, err := store.ListCategories()
+	if err != nil {
+		t.Fatalf("ListCategories failed: %v", err)
+	}
+	if len(categories) != 2 {
+		t.Errorf("expected pruned category to be removed from stats, got %v", categories)
+	}
+
+	// Recomputed timestamps remain readable by later updates
+	if err := store.IncrementCount("missing-tests"); err != nil {
+		t.Fatalf("IncrementCount after prune failed: %v", err)
+	}
+
+	deleted, err = store.DeleteOlderThan(cutoff)
+	if err != nil || deleted != 0 {
+		t.Errorf("expected second prune to delete nothing, got %d, %v", deleted, err)
+	}
+}
+
+func TestGetAll(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	all, err := store.GetAll()
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if all == nil || len(all) != 0 {
+		t.Errorf("expected empty non-nil slice for empty store, got %v", all)
+	}
+
+	now := time.Now().UTC()
+	for i, category := range []string{"missing-tests", "scope-creep", "regression"} {
+		f := Failure{TaskID: fmt.Sprintf("task-%d", i), Category: category, Details: "d", Source: "s", CreatedAt: now.Add(-time.Duration(i) * time.Hour)}
+		if err := store.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+	}
+
+	all, err = store.GetAll()
+	if err != nil {
+		t.Fatalf("GetAll failed: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("expected 3 failures, got %d", len(all))
+	}
+	if all[0].Category != "regression" || all[2].Category != "missing-tests" {
+		t.Errorf("expected failures oldest first, got %s, %s, %s", all[0].Category, all[1].Category, all[2].Category)
+	}
+}
+
+func TestTopCategories(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	first := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)
+	seed := map[string]int{"missing-tests": 5, "scope-creep": 2, "regression": 2, "unknown": 1}
+	for category, count := range seed {
+		if err := store.UpsertCategoryStats(category, count, first, last); err != nil {
+			t.Fatalf("UpsertCategoryStats failed: %v", err)
+		}
+	}
+
+	top, err := store.TopCategories(3)
+	if err != nil {
+		t.Fatalf("TopCategories failed: %v", err)
+	}
+	var got []string
+	for _, stat := range top {
+		got = append(got, fmt.Sprintf("%s=%d", stat.Category, stat.OccurrenceCount))
+	}
+	// Ties are broken by category name
+	if want := "missing-tests=5,regression=2,scope-creep=2"; strings.Join(got, ",") != want {
+		t.Errorf("expected %s, got %s", want, strings.Join(got, ","))
+	}
+	if !top[0].FirstSeen.Equal(first) || !top[0].LastSeen.Equal(last) {
+		t.Errorf("expected first/last seen %v/%v, got %v/%v", first, last, top[0].FirstSeen, top[0].LastSeen)
+	}
+
+	all, err := store.TopCategories(100)
+	if err != nil {
+		t.Fatalf("TopCategories failed: %v", err)
+	}
+	if len(all) != 4 {
+		t.Errorf("expected all 4 categories when n exceeds them, got %d", len(all))
+	}
+
+	total, err := store.TotalOccurrences()
+	if err != nil || total != 10 {
+		t.Errorf("expected total 10, got %d (err: %v)", total, err)
+	}
+
+	if _, err := store.TopCategories(0); err == nil {
+		t.Error("expected error for non-positive n")
+	}
+}
+
+// TestSnapshotRestore verifies a snapshot restored into a fresh database reproduces both tables
+
+func TestCategoryStats(t *testing.T) {
+	store := createTestStore(t)
+	defer store.Close()
+
+	stats, err := store.CategoryStats()
+	if err != nil || len(stats) != 0 {
+		t.Fatalf("expected no category stats in an empty store, got %v (err: %v)", stats, err)
+	}
+
+	first := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 3, 8, 9, 0, 0, 0, time.UTC)
+	for category, count := range map[string]int{"missing-tests": 5, "scope-creep": 2, "regression": 2} {
+		if err := store.UpsertCategoryStats(category, count, first, last); err != nil {
+			t.Fatalf("UpsertCategoryStats failed: %v", err)
+		}
+	}
+
+	stats, err = store.CategoryStats()
+	if err != nil {
+		t.Fatalf("CategoryStats failed: %v", err)
+	}
+	var got []string
+	for _, stat := range stats {
+		got = append(got, fmt.Sprintf("%s=%d", stat.Category, stat.OccurrenceCount))
+	}
+	if want := "missing-tests=5,regression=2,scope-creep=2"; strings.Join(got, ",") != want {
+		t.Errorf("expected %s, got %s", want, strings.Join(got, ","))
+	}
+}
+func TestSnapshotRestore(t *testing.T) {
+	source := createTestStore(t)
+	defer source.Close()
+
+	now := time.Now()
+	for _, f := range []Failure{
+		{TaskID: "task-1", Category: "missing-tests", Details: "no tests", Source: "quality-review", CreatedAt: now.Add(-2 * time.Hour)},
+		{TaskID: "task-2", Category: "scope-creep", Details: "extra work", Source: "spec-review", CreatedAt: now.Add(-time.Hour)},
+	} {
+		if err := source.Insert(f); err != nil {
+			t.Fatalf("Insert failed: %v", err)
+		}
+		if err := source.IncrementCount(f.Category); err != nil {
+			t.Fatalf("IncrementCount failed: %v", err)
+		}
+	}
+	// Counts need not match the failures, e.g. after failures were pruned elsewhere
+	if err := source.UpsertCategoryStats("wrong-product", 4, now.Add(-48*time.Hour), now.Add(-24*time.Hour)); err != nil {
+		t.Fatalf("UpsertCategoryStats failed: %v", err)
+	}
+
+	snapshot, err := source.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+	if len(snapshot.Failures) != 2 || len(snapshot.CategoryStats) != 3 {
+		t.Fatalf("Expected 2 failures and 3 category stats, got %+v", snapshot)
+	}
+
+	target := createTestStore(t)
+	defer target.Close()
+	if err := target.Restore(snapshot); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+
+	restored, err := target.Snapshot()
+	if err != nil {
+		t.Fatalf("Snapshot of restored store failed: %v", err)
+	}
+	if len(restored.Failures) != len(snapshot.Failures) || len(restored.CategoryStats) != len(snapshot.CategoryStats) {
+		t.Fatalf("Restored %d failures and %d category stats, want %d and %d",
+			len(restored.Failures), len(restored.CategoryStats), len(snapshot.Failures), len(snapshot.CategoryStats))
+	}
+	for i, want := range snapshot.Failures {
+		got := restored.Failures[i]
+		if got.ID != want.ID || got.TaskID != want.TaskID || got.Category != want.Category ||
+			got.Details != want.Details || got.Source != want.Source || !got.CreatedAt.Equal(want.CreatedAt) {
+			t.Errorf("Failure %d = %+v, want %+v", i, got, want)
+		}
+	}
+	for i, want := range snapshot.CategoryStats {
+		got := restored.CategoryStats[i]
+		if got.Category != want.Category || got.OccurrenceCount != want.OccurrenceCount ||
+			!got.FirstSeen.Equal(want.FirstSeen) || !got.LastSeen.Equal(want.LastSeen) {
+			t.Errorf("Category stat %d = %+v, want %+v", i, got, want)
+		}
+	}
+
+	// A second restore is rejected rather than merged
+	if err := target.Restore(snapshot); err == nil || !strings.Contains(err.Error(), "not empty") {
+		t.Errorf("Expected restore into a non-empty database to fail, got %v", err)
+	}
+}
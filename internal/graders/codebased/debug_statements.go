@@ -0,0 +1,203 @@
+package codebased
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// debugAllowMarker exempts a line from debug statement detection
+const debugAllowMarker = "kaizen:allow-debug"
+
+// debugPatterns maps file extensions to debug-print patterns for that language
+var debugPatterns = map[string]*regexp.Regexp{
+	".go":  regexp.MustCompile(`\bfmt\.Print(ln|f)?\s*\(|\bspew\.Dump\s*\(|(^|[^.\w])print(ln)?\s*\(`),
+	".py":  regexp.MustCompile(`(^|[^.\w])print\s*\(|\bbreakpoint\s*\(|\bpdb\.set_trace\s*\(`),
+	".js":  regexp.MustCompile(`\bconsole\.(log|debug|trace|dir)\s*\(|\bdebugger\b`),
+	".jsx": regexp.MustCompile(`\bconsole\.(log|debug|trace|dir)\s*\(|\bdebugger\b`),
+	".ts":  regexp.MustCompile(`\bconsole\.(log|debug|trace|dir)\s*\(|\bdebugger\b`),
+	".tsx": regexp.MustCompile(`\bconsole\.(log|debug|trace|dir)\s*\(|\bdebugger\b`),
+}
+
+// DebugStatementGrader checks changed source files for leftover debug print statements
+type DebugStatementGrader struct {
+	warnOnly bool
+}
+
+func init() {
+	Register("debug-statements", func() CodeGrader { return NewDebugStatementGrader() })
+}
+
+// NewDebugStatementGrader creates a new DebugStatementGrader that fails on debug statements
+func NewDebugStatementGrader() *DebugStatementGrader {
+	return &DebugStatementGrader{}
+}
+
+// WithWarnOnly makes the grader pass and report debug statements as a warning instead of failing
+func (g *DebugStatementGrader) WithWarnOnly(warnOnly bool) *DebugStatementGrader {
+	g.warnOnly = warnOnly
+	return g
+}
+
+// Name returns the grader name
+func (g *DebugStatementGrader) Name() string {
+	return "debug-statements"
+}
+
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *DebugStatementGrader) Version() string {
+	return "1.2.0"
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *DebugStatementGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Flags leftover debug output such as fmt.Println, console.log, print or breakpoints",
+		TaskTypes:   []string{"feature", "bug"},
+		Files:       "Non-test .go, .py, .js, .jsx, .ts and .tsx files",
+	}
+}
+
+// IsApplicable returns true for feature/bug tasks that changed supported source files
+func (g *DebugStatementGrader) IsApplicable(input GradeInput) bool {
+	if !input.AnyTaskType && input.TaskType != "feature" && input.TaskType != "bug" {
+		return false
+	}
+
+	for _, file := range input.ChangedFiles {
+		if g.isSourceFile(file) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Grade scans changed source files for debug statements and lists them as file:line
+func (g *DebugStatementGrader) Grade(input GradeInput) GradeResult {
+	// Skip if not applicable
+	if !g.IsApplicable(input) {
+		skipReason := "No source files to check"
+		if !input.AnyTaskType && input.TaskType != "feature" && input.TaskType != "bug" {
+			skipReason = fmt.Sprintf("Not applicable for %s tasks", input.TaskType)
+		}
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    skipReason,
+		}
+	}
+
+	totalFiles := 0
+	filesWithDebug := 0
+	var findings []string
+
+	for _, file := range input.ChangedFiles {
+		if !g.isSourceFile(file) {
+			continue
+		}
+		totalFiles++
+
+		// Resolve path relative to WorkDir if not absolute
+		filePath := file
+		if !filepath.IsAbs(file) {
+			filePath = filepath.Join(input.WorkDir, file)
+		}
+
+		lines, err := g.findDebugLines(filePath)
+		if err != nil {
+			// Missing files are reported by file-exists
+			continue
+		}
+		if len(lines) > 0 {
+			filesWithDebug++
+		}
+		for _, line := range lines {
+			findings = append(findings, fmt.Sprintf("%s:%d", file, line))
+		}
+	}
+
+	if len(findings) == 0 {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        true,
+			Score:         100,
+			Details:       fmt.Sprintf("No debug statements in %d source files", totalFiles),
+			Skipped:       false,
+			SkipReason:    "",
+		}
+	}
+
+	details := fmt.Sprintf("%d debug statements found: %s", len(findings), strings.Join(findings, ", "))
+	if g.warnOnly {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        true,
+			Score:         100,
+			Details:       "Warning: " + details,
+			Skipped:       false,
+			SkipReason:    "",
+		}
+	}
+
+	score := float64(totalFiles-filesWithDebug) / float64(totalFiles) * 100
+	return GradeResult{
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        false,
+		Score:         score,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
+	}
+}
+
+// isSourceFile checks if a file is a non-test source file in a supported language
+func (g *DebugStatementGrader) isSourceFile(file string) bool {
+	if NewTestExistsGrader().isTestFile(file) {
+		return false
+	}
+	_, ok := debugPatterns[strings.ToLower(filepath.Ext(file))]
+	return ok
+}
+
+// findDebugLines returns the 1-based line numbers matching the debug pattern of the file's language.
+// Comment lines and lines carrying the allow marker are ignored.
+func (g *DebugStatementGrader) findDebugLines(filePath string) ([]int, error) {
+	content, err := readNormalizedFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern := debugPatterns[strings.ToLower(filepath.Ext(filePath))]
+
+	var lines []int
+	lineNum := 0
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "*") {
+			continue
+		}
+		if strings.Contains(line, debugAllowMarker) {
+			continue
+		}
+		if pattern.MatchString(line) {
+			lines = append(lines, lineNum)
+		}
+	}
+
+	return lines, scanner.Err()
+}
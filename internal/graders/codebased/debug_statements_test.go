@@ -0,0 +1,223 @@
+package codebased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDebugStatementGraderInterface verifies DebugStatementGrader implements CodeGrader
+func TestDebugStatementGraderInterface(t *testing.T) {
+	var _ CodeGrader = (*DebugStatementGrader)(nil)
+}
+
+// TestDebugStatementGraderName verifies the grader name
+func TestDebugStatementGraderName(t *testing.T) {
+	grader := NewDebugStatementGrader()
+	if grader.Name() != "debug-statements" {
+		t.Errorf("Expected name 'debug-statements', got %s", grader.Name())
+	}
+}
+
+// TestDebugStatementGraderIsApplicable verifies applicability logic
+func TestDebugStatementGraderIsApplicable(t *testing.T) {
+	grader := NewDebugStatementGrader()
+
+	tests := []struct {
+		name     string
+		input    GradeInput
+		expected bool
+	}{
+		{
+			name:     "applicable for feature task with Go files",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"main.go"}},
+			expected: true,
+		},
+		{
+			name:     "applicable for bug task with JS files",
+			input:    GradeInput{TaskType: "bug", ChangedFiles: []string{"app.js"}},
+			expected: true,
+		},
+		{
+			name:     "not applicable for test tasks",
+			input:    GradeInput{TaskType: "test", ChangedFiles: []string{"main.go"}},
+			expected: false,
+		},
+		{
+			name:     "not applicable when only test files changed",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"main_test.go", "app.test.js"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grader.IsApplicable(tt.input); got != tt.expected {
+				t.Errorf("IsApplicable() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestDebugStatementGraderGo verifies Go debug prints are reported as file:line
+func TestDebugStatementGraderGo(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `package handler
+
+import "fmt"
+
+func Handle(id string) string {
+	fmt.Println("debug id", id)
+	// fmt.Println("commented out")
+	msg := fmt.Sprintf("handled %s", id)
+	println(msg)
+	return msg
+}
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "handler.go"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader := NewDebugStatementGrader()
+	result := grader.Grade(GradeInput{
+		TaskID:       "task-123",
+		TaskType:     "feature",
+		ChangedFiles: []string{"handler.go"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Passed {
+		t.Error("Expected grader to fail on debug statements")
+	}
+	if !strings.Contains(result.Details, "handler.go:6") || !strings.Contains(result.Details, "handler.go:9") {
+		t.Errorf("Expected handler.go:6 and handler.go:9 in details, got: %s", result.Details)
+	}
+	if strings.Contains(result.Details, "handler.go:7") || strings.Contains(result.Details, "handler.go:8") {
+		t.Errorf("Comments and Sprintf should not be reported: %s", result.Details)
+	}
+}
+
+// TestDebugStatementGraderGoMain verifies package main gets no exemption: fmt.Print* is reported
+// there like in any other package
+func TestDebugStatementGraderGoMain(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("Usage: tool <command>")
+	fmt.Printf("Processed %d files\n", 3)
+	fmt.Print("done\n")
+	println("debug")
+	spew.Dump(os.Args)
+}
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewDebugStatementGrader().Grade(GradeInput{
+		TaskID:       "task-123",
+		TaskType:     "feature",
+		ChangedFiles: []string{"main.go"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Passed {
+		t.Errorf("Expected fail for debug statements in package main, got pass: %s", result.Details)
+	}
+	for _, line := range []string{"main.go:6", "main.go:7", "main.go:8", "main.go:9", "main.go:10"} {
+		if !strings.Contains(result.Details, line) {
+			t.Errorf("Expected %s in details, got: %s", line, result.Details)
+		}
+	}
+}
+
+// TestDebugStatementGraderJS verifies console.log and debugger are reported
+func TestDebugStatementGraderJS(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `export function total(items) {
+  console.log('items', items);
+  debugger;
+  return items.reduce((sum, item) => sum + item.price, 0);
+}
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "cart.js"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader := NewDebugStatementGrader()
+	result := grader.Grade(GradeInput{
+		TaskType:     "bug",
+		ChangedFiles: []string{"cart.js"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Passed {
+		t.Error("Expected grader to fail on debug statements")
+	}
+	if result.Score != 0 {
+		t.Errorf("Expected score 0 for the only file having debug statements, got %f", result.Score)
+	}
+	if !strings.Contains(result.Details, "cart.js:2") || !strings.Contains(result.Details, "cart.js:3") {
+		t.Errorf("Expected cart.js:2 and cart.js:3 in details, got: %s", result.Details)
+	}
+}
+
+// TestDebugStatementGraderAllowlist verifies allowlisted lines pass
+func TestDebugStatementGraderAllowlist(t *testing.T) {
+	tmpDir := t.TempDir()
+	goContent := `package main
+
+import "fmt"
+
+func main() {
+	fmt.Println("usage: tool [options]") // kaizen:allow-debug
+}
+`
+	jsContent := "console.log('server started'); // kaizen:allow-debug\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "main.go"), []byte(goContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "server.js"), []byte(jsContent), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader := NewDebugStatementGrader()
+	result := grader.Grade(GradeInput{
+		TaskType:     "feature",
+		ChangedFiles: []string{"main.go", "server.js"},
+		WorkDir:      tmpDir,
+	})
+
+	if !result.Passed {
+		t.Errorf("Expected allowlisted lines to pass, got: %s", result.Details)
+	}
+	if result.Score != 100 {
+		t.Errorf("Expected score 100, got %f", result.Score)
+	}
+}
+
+// TestDebugStatementGraderWarnOnly verifies warn mode passes but lists findings
+func TestDebugStatementGraderWarnOnly(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "app.ts"), []byte("console.debug(state);\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader := NewDebugStatementGrader().WithWarnOnly(true)
+	result := grader.Grade(GradeInput{
+		TaskType:     "feature",
+		ChangedFiles: []string{"app.ts"},
+		WorkDir:      tmpDir,
+	})
+
+	if !result.Passed {
+		t.Error("Expected warn-only grader to pass")
+	}
+	if !strings.HasPrefix(result.Details, "Warning:") || !strings.Contains(result.Details, "app.ts:1") {
+		t.Errorf("Expected warning listing app.ts:1, got: %s", result.Details)
+	}
+}
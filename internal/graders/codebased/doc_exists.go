@@ -0,0 +1,245 @@
+package codebased
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// docPathSegments are directory names whose files document a public API
+var docPathSegments = map[string]bool{
+	"api":  true,
+	"docs": true,
+}
+
+// DocExistsGrader checks that exported functions, methods and types declared in changed Go files
+// have doc comments. A change to a file under an api/ or docs/ directory counts as documentation.
+type DocExistsGrader struct{}
+
+func init() {
+	Register("doc-exists", func() CodeGrader { return NewDocExistsGrader() })
+}
+
+// NewDocExistsGrader creates a new DocExistsGrader
+func NewDocExistsGrader() *DocExistsGrader {
+	return &DocExistsGrader{}
+}
+
+// Name returns the grader name
+func (g *DocExistsGrader) Name() string {
+	return "doc-exists"
+}
+
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *DocExistsGrader) Version() string {
+	return "1.0.0"
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *DocExistsGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Fails on exported Go functions, methods and types without doc comments, unless API docs changed",
+		TaskTypes:   []string{"feature"},
+		Files:       "Non-test .go files, and files under api/ or docs/",
+	}
+}
+
+// IsApplicable returns true for feature tasks that change non-test Go files or API documentation
+func (g *DocExistsGrader) IsApplicable(input GradeInput) bool {
+	if !input.AnyTaskType && input.TaskType != "feature" {
+		return false
+	}
+
+	return len(g.goFiles(input.ChangedFiles)) > 0 || len(g.docFiles(input.ChangedFiles)) > 0
+}
+
+// Grade parses changed Go files and lists exported declarations without doc comments as file:line
+func (g *DocExistsGrader) Grade(input GradeInput) GradeResult {
+	if !input.AnyTaskType && input.TaskType != "feature" {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    fmt.Sprintf("Not applicable for %s tasks", input.TaskType),
+		}
+	}
+
+	docFiles := g.docFiles(input.ChangedFiles)
+	total := 0
+	var undocumented []string
+	for _, file := range g.goFiles(input.ChangedFiles) {
+		// Resolve path relative to WorkDir if not absolute
+		filePath := file
+		if !filepath.IsAbs(file) {
+			filePath = filepath.Join(input.WorkDir, file)
+		}
+
+		content, err := readNormalizedFile(filePath)
+		if err != nil {
+			// Missing files are reported by file-exists
+			continue
+		}
+		fset := token.NewFileSet()
+		parsed, err := parser.ParseFile(fset, filePath, content, parser.ParseComments)
+		if err != nil {
+			// Files that do not compile are left to the build
+			continue
+		}
+
+		for _, symbol := range g.exportedSymbols(parsed) {
+			total++
+			if !symbol.documented {
+				undocumented = append(undocumented, fmt.Sprintf("%s:%d %s", file, fset.Position(symbol.pos).Line, symbol.name))
+			}
+		}
+	}
+
+	if total == 0 && len(docFiles) == 0 {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    "No exported Go symbols or API docs changed",
+		}
+	}
+
+	if len(undocumented) == 0 {
+		details := fmt.Sprintf("All %d exported symbols are documented", total)
+		if total == 0 {
+			details = fmt.Sprintf("API docs changed: %s", strings.Join(docFiles, ", "))
+		}
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        true,
+			Score:         100,
+			Details:       details,
+			Skipped:       false,
+			SkipReason:    "",
+		}
+	}
+
+	details := fmt.Sprintf("%d of %d exported symbols undocumented: %s", len(undocumented), total, strings.Join(undocumented, ", "))
+	if len(docFiles) > 0 {
+		// Documentation written alongside the API stands in for doc comments
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        true,
+			Score:         100,
+			Details:       fmt.Sprintf("API docs changed: %s; %s", strings.Join(docFiles, ", "), details),
+			Skipped:       false,
+			SkipReason:    "",
+		}
+	}
+
+	return GradeResult{
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        false,
+		Score:         float64(total-len(undocumented)) / float64(total) * 100,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
+	}
+}
+
+// exportedSymbol is an exported top-level declaration and whether it has a doc comment
+type exportedSymbol struct {
+	name       string
+	pos        token.Pos
+	documented bool
+}
+
+// exportedSymbols returns the exported functions, types and methods on exported types declared in file.
+// A type in a grouped declaration is documented by its own comment or the group's.
+func (g *DocExistsGrader) exportedSymbols(file *ast.File) []exportedSymbol {
+	var symbols []exportedSymbol
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if !d.Name.IsExported() {
+				continue
+			}
+			name := d.Name.Name
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				receiver := receiverTypeName(d.Recv.List[0].Type)
+				if !ast.IsExported(receiver) {
+					continue
+				}
+				name = receiver + "." + name
+			}
+			symbols = append(symbols, exportedSymbol{name: name, pos: d.Pos(), documented: hasDocComment(d.Doc)})
+
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || !typeSpec.Name.IsExported() {
+					continue
+				}
+				documented := hasDocComment(typeSpec.Doc) || hasDocComment(d.Doc)
+				symbols = append(symbols, exportedSymbol{name: typeSpec.Name.Name, pos: typeSpec.Pos(), documented: documented})
+			}
+		}
+	}
+	return symbols
+}
+
+// receiverTypeName returns the base type name of a method receiver, e.g. Store for *Store or Set[T]
+func receiverTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(t.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
+
+// hasDocComment reports whether a doc comment group has any text
+func hasDocComment(doc *ast.CommentGroup) bool {
+	return doc != nil && strings.TrimSpace(doc.Text()) != ""
+}
+
+// goFiles returns the changed non-test Go files
+func (g *DocExistsGrader) goFiles(changedFiles []string) []string {
+	testExists := NewTestExistsGrader()
+	var files []string
+	for _, file := range changedFiles {
+		if strings.HasSuffix(file, ".go") && !testExists.isTestFile(file) {
+			files = append(files, file)
+		}
+	}
+	return files
+}
+
+// docFiles returns the changed files under an api/ or docs/ directory
+func (g *DocExistsGrader) docFiles(changedFiles []string) []string {
+	var files []string
+	for _, file := range changedFiles {
+		dirs := strings.Split(filepath.ToSlash(filepath.Dir(file)), "/")
+		for _, dir := range dirs {
+			if docPathSegments[dir] {
+				files = append(files, file)
+				break
+			}
+		}
+	}
+	return files
+}
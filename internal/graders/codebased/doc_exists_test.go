@@ -0,0 +1,178 @@
+package codebased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// gradeDocFiles writes name -> content to a temp dir and grades them as a feature task
+func gradeDocFiles(t *testing.T, files map[string]string) GradeResult {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	var changed []string
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		changed = append(changed, name)
+	}
+
+	return NewDocExistsGrader().Grade(GradeInput{TaskType: "feature", ChangedFiles: changed, WorkDir: tmpDir})
+}
+
+// TestDocExistsGraderInterface verifies DocExistsGrader implements CodeGrader
+func TestDocExistsGraderInterface(t *testing.T) {
+	var _ CodeGrader = (*DocExistsGrader)(nil)
+}
+
+// TestDocExistsGraderIsApplicable verifies the grader only applies to feature tasks changing Go files or API docs
+func TestDocExistsGraderIsApplicable(t *testing.T) {
+	grader := NewDocExistsGrader()
+	tests := []struct {
+		name     string
+		input    GradeInput
+		expected bool
+	}{
+		{
+			name:     "applicable for feature task with Go file",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"store.go"}},
+			expected: true,
+		},
+		{
+			name:     "applicable for feature task with docs file",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"docs/store.md"}},
+			expected: true,
+		},
+		{
+			name:     "not applicable for bug, chore or spike tasks",
+			input:    GradeInput{TaskType: "bug", ChangedFiles: []string{"store.go"}},
+			expected: false,
+		},
+		{
+			name:     "not applicable for test files and other languages",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"store_test.go", "app.ts", "apidocs.md"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grader.IsApplicable(tt.input); got != tt.expected {
+				t.Errorf("IsApplicable() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	for _, taskType := range []string{"chore", "spike"} {
+		result := grader.Grade(GradeInput{TaskType: taskType, ChangedFiles: []string{"store.go"}})
+		if !result.Skipped || !strings.Contains(result.SkipReason, taskType) {
+			t.Errorf("Expected skip for %s task, got %+v", taskType, result)
+		}
+	}
+}
+
+// TestDocExistsGraderUndocumented verifies undocumented exported symbols are reported as file:line
+func TestDocExistsGraderUndocumented(t *testing.T) {
+	content := `package store
+
+// Store keeps users
+type Store struct{}
+
+type Config struct{}
+
+// Find looks up a user
+func (s *Store) Find(id string) {}
+
+func (s *Store) Save() {}
+
+func New() *Store { return &Store{} }
+
+func helper() {}
+
+type cache struct{}
+
+func (c *cache) Get() {}
+
+const Query = "func Hidden() {}"
+`
+	result := gradeDocFiles(t, map[string]string{"store.go": content})
+
+	if result.Passed || result.Skipped {
+		t.Fatalf("Expected failure, got %+v", result)
+	}
+	for _, want := range []string{"3 of 5 exported symbols undocumented", "store.go:6 Config", "store.go:11 Store.Save", "store.go:13 New"} {
+		if !strings.Contains(result.Details, want) {
+			t.Errorf("Expected details to contain %q, got: %s", want, result.Details)
+		}
+	}
+	for _, unwanted := range []string{"helper", "cache", "Get", "Hidden"} {
+		if strings.Contains(result.Details, unwanted) {
+			t.Errorf("Expected %s to be ignored, got: %s", unwanted, result.Details)
+		}
+	}
+	if result.Score != 40 {
+		t.Errorf("Expected score 40, got %.1f", result.Score)
+	}
+}
+
+// TestDocExistsGraderDocumented verifies documented symbols pass, including types documented by their group
+func TestDocExistsGraderDocumented(t *testing.T) {
+	result := gradeDocFiles(t, map[string]string{
+		"store.go": `package store
+
+// Identifiers used by the store
+type (
+	UserID string
+	// OrderID identifies an order
+	OrderID string
+)
+
+// Load reads the store from disk
+func Load() {}
+`,
+		"store_test.go": "package store\n\nfunc TestLoad() {}\n",
+	})
+
+	if !result.Passed || result.Score != 100 {
+		t.Fatalf("Expected pass with score 100, got %+v", result)
+	}
+	if result.Details != "All 3 exported symbols are documented" {
+		t.Errorf("Unexpected details: %s", result.Details)
+	}
+}
+
+// TestDocExistsGraderAPIDocs verifies a changed api/ or docs/ file passes despite undocumented symbols
+func TestDocExistsGraderAPIDocs(t *testing.T) {
+	result := gradeDocFiles(t, map[string]string{
+		"store.go":         "package store\n\nfunc New() {}\n",
+		"api/openapi.yaml": "openapi: 3.0.0\n",
+	})
+
+	if !result.Passed || result.Score != 100 {
+		t.Fatalf("Expected pass with API docs changed, got %+v", result)
+	}
+	for _, want := range []string{"API docs changed: api/openapi.yaml", "store.go:3 New"} {
+		if !strings.Contains(result.Details, want) {
+			t.Errorf("Expected details to contain %q, got: %s", want, result.Details)
+		}
+	}
+}
+
+// TestDocExistsGraderSkipsWithoutExports verifies the grader skips when no exported symbols or docs changed
+func TestDocExistsGraderSkipsWithoutExports(t *testing.T) {
+	result := gradeDocFiles(t, map[string]string{
+		"main.go":   "package main\n\nfunc main() {}\n",
+		"broken.go": "package broken\n\nfunc Broken( {\n",
+	})
+
+	if !result.Skipped || result.SkipReason != "No exported Go symbols or API docs changed" {
+		t.Errorf("Expected skip without exported symbols, got %+v", result)
+	}
+}
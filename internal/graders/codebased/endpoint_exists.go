@@ -2,15 +2,36 @@ package codebased
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
 )
 
+// Route definition patterns per framework.
+// Note: These patterns do not enforce matching quotes (e.g., '/path" will match).
+// This is acceptable for typical code patterns and simplifies the regexes.
+var (
+	// Express: (app|router).(get|post|put|patch|delete)('path' or "path"
+	expressRoutePattern = regexp.MustCompile(`(app|router)\.(get|post|put|patch|delete)\s*\(\s*['"]([^'"]+)['"]`)
+	// Flask: @app.route('/path', methods=['POST']); methods defaults to GET
+	flaskRoutePattern   = regexp.MustCompile(`@\w+\.route\s*\(\s*['"]([^'"]+)['"]([^)]*)\)`)
+	flaskMethodsPattern = regexp.MustCompile(`methods\s*=\s*[\[(]([^\])]*)`)
+	quotedWordPattern   = regexp.MustCompile(`['"](\w+)['"]`)
+	// Flask 2.0 and FastAPI: @app.get('/path'), @router.post("/path")
+	pythonMethodRoutePattern = regexp.MustCompile(`@\w+\.(get|post|put|patch|delete)\s*\(\s*['"]([^'"]+)['"]`)
+	// net/http: http.HandleFunc("/path", ...), mux.Handle("POST /path", ...)
+	goHandleRoutePattern = regexp.MustCompile(`\.Handle(?:Func)?\s*\(\s*"((?:[A-Z]+\s+)?/[^"]*)"`)
+	// chi: r.Get("/path", ...); gin: r.GET("/path", ...)
+	goMethodRoutePattern = regexp.MustCompile(`\.(Get|Post|Put|Patch|Delete|GET|POST|PUT|PATCH|DELETE)\s*\(\s*"(/[^"]*)"`)
+)
+
 // EndpointExistsGrader discovers and reports API endpoints from changed files
 type EndpointExistsGrader struct{}
 
+func init() {
+	Register("endpoint-exists", func() CodeGrader { return NewEndpointExistsGrader() })
+}
+
 // NewEndpointExistsGrader creates a new EndpointExistsGrader
 func NewEndpointExistsGrader() *EndpointExistsGrader {
 	return &EndpointExistsGrader{}
@@ -21,14 +42,28 @@ func (g *EndpointExistsGrader) Name() string {
 	return "endpoint-exists"
 }
 
-// IsApplicable returns true if there are JS/TS files to check and task type is not chore/spike
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *EndpointExistsGrader) Version() string {
+	return "1.1.0"
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *EndpointExistsGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Discovers API routes (Express, Flask, FastAPI, net/http, chi, gin) in changed files",
+		TaskTypes:   []string{"feature", "bug", "test"},
+		Files:       ".go, .py, .js, .jsx, .ts and .tsx files",
+	}
+}
+
+// IsApplicable returns true if there are JS/TS, Python or Go files to check and task type is not chore/spike
 func (g *EndpointExistsGrader) IsApplicable(input GradeInput) bool {
 	// Skip for certain task types
 	skipTaskTypes := map[string]bool{
 		"chore": true,
 		"spike": true,
 	}
-	if skipTaskTypes[input.TaskType] {
+	if !input.AnyTaskType && skipTaskTypes[input.TaskType] {
 		return false
 	}
 
@@ -37,14 +72,8 @@ func (g *EndpointExistsGrader) IsApplicable(input GradeInput) bool {
 		return false
 	}
 
-	// Check if there are any JS/TS files
-	for _, file := range input.ChangedFiles {
-		if g.isJSFile(file) {
-			return true
-		}
-	}
-
-	return false
+	// Check if there are any route source files
+	return g.hasRouteFiles(input.ChangedFiles)
 }
 
 // Grade discovers and reports API endpoints from changed files
@@ -53,23 +82,24 @@ func (g *EndpointExistsGrader) Grade(input GradeInput) GradeResult {
 	if !g.IsApplicable(input) {
 		skipReason := "No changed files"
 		if len(input.ChangedFiles) > 0 {
-			if input.TaskType == "chore" || input.TaskType == "spike" {
+			if !input.AnyTaskType && (input.TaskType == "chore" || input.TaskType == "spike") {
 				skipReason = fmt.Sprintf("Not applicable for %s tasks", input.TaskType)
-			} else if !g.hasJSFiles(input.ChangedFiles) {
-				skipReason = "No JS/TS files to check"
+			} else if !g.hasRouteFiles(input.ChangedFiles) {
+				skipReason = "No JS/TS, Python or Go files to check"
 			}
 		}
 		return GradeResult{
-			GraderName: g.Name(),
-			Passed:     false,
-			Score:      0,
-			Details:    "",
-			Skipped:    true,
-			SkipReason: skipReason,
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    skipReason,
 		}
 	}
 
-	// Extract endpoints from all JS/TS files
+	// Extract endpoints from all route source files
 	endpoints := g.extractEndpoints(input)
 
 	// Calculate score and build result
@@ -86,31 +116,34 @@ func (g *EndpointExistsGrader) Grade(input GradeInput) GradeResult {
 	}
 
 	return GradeResult{
-		GraderName: g.Name(),
-		Passed:     passed,
-		Score:      score,
-		Details:    details,
-		Skipped:    false,
-		SkipReason: "",
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        passed,
+		Score:         score,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
 	}
 }
 
-// isJSFile checks if a file is a JavaScript/TypeScript file
-func (g *EndpointExistsGrader) isJSFile(file string) bool {
+// isRouteFile checks if a file is a JavaScript/TypeScript, Python or Go file
+func (g *EndpointExistsGrader) isRouteFile(file string) bool {
 	ext := strings.ToLower(filepath.Ext(file))
-	jsExts := map[string]bool{
+	routeExts := map[string]bool{
 		".js":  true,
 		".ts":  true,
 		".jsx": true,
 		".tsx": true,
+		".py":  true,
+		".go":  true,
 	}
-	return jsExts[ext]
+	return routeExts[ext]
 }
 
-// hasJSFiles checks if there are any JS/TS files in the list
-func (g *EndpointExistsGrader) hasJSFiles(files []string) bool {
+// hasRouteFiles checks if there are any route source files in the list
+func (g *EndpointExistsGrader) hasRouteFiles(files []string) bool {
 	for _, file := range files {
-		if g.isJSFile(file) {
+		if g.isRouteFile(file) {
 			return true
 		}
 	}
@@ -122,15 +155,9 @@ func (g *EndpointExistsGrader) extractEndpoints(input GradeInput) []string {
 	var endpoints []string
 	seenEndpoints := make(map[string]bool)
 
-	// Regex pattern to match Express route definitions
-	// Pattern: (app|router).(get|post|put|patch|delete)('path' or "path"
-	// Note: This pattern does not enforce matching quotes (e.g., '/path" will match).
-	// This is acceptable for typical code patterns and simplifies the regex.
-	pattern := regexp.MustCompile(`(app|router)\.(get|post|put|patch|delete)\s*\(\s*['"]([^'"]+)['"]`)
-
 	for _, file := range input.ChangedFiles {
-		// Skip non-JS/TS files
-		if !g.isJSFile(file) {
+		// Skip files that can't define routes
+		if !g.isRouteFile(file) {
 			continue
 		}
 
@@ -140,29 +167,92 @@ func (g *EndpointExistsGrader) extractEndpoints(input GradeInput) []string {
 			filePath = filepath.Join(input.WorkDir, file)
 		}
 
-		// Read file content
-		content, err := os.ReadFile(filePath)
+		// Read file content, normalizing BOM and CRLF from Windows-authored files
+		content, err := readNormalizedFile(filePath)
 		if err != nil {
 			// Skip files that can't be read
 			continue
 		}
 
-		// Find all matches in the file
-		matches := pattern.FindAllSubmatch(content, -1)
-		for _, match := range matches {
-			if len(match) >= 4 {
-				method := strings.ToUpper(string(match[2]))
-				path := string(match[3])
-				endpoint := fmt.Sprintf("%s %s", method, path)
-
-				// Only add unique endpoints
-				if !seenEndpoints[endpoint] {
-					seenEndpoints[endpoint] = true
-					endpoints = append(endpoints, endpoint)
-				}
+		for _, endpoint := range g.findEndpoints(strings.ToLower(filepath.Ext(file)), content) {
+			// Only add unique endpoints
+			if !seenEndpoints[endpoint] {
+				seenEndpoints[endpoint] = true
+				endpoints = append(endpoints, endpoint)
+			}
+		}
+	}
+
+	return endpoints
+}
+
+// findEndpoints returns the "METHOD /path" endpoints defined in a file's content
+func (g *EndpointExistsGrader) findEndpoints(ext string, content []byte) []string {
+	switch ext {
+	case ".py":
+		return g.findPythonEndpoints(content)
+	case ".go":
+		return g.findGoEndpoints(content)
+	default:
+		return g.findExpressEndpoints(content)
+	}
+}
+
+// findExpressEndpoints finds Express route definitions
+func (g *EndpointExistsGrader) findExpressEndpoints(content []byte) []string {
+	var endpoints []string
+	for _, match := range expressRoutePattern.FindAllSubmatch(content, -1) {
+		endpoints = append(endpoints, formatEndpoint(string(match[2]), string(match[3])))
+	}
+	return endpoints
+}
+
+// findPythonEndpoints finds Flask and FastAPI route definitions
+func (g *EndpointExistsGrader) findPythonEndpoints(content []byte) []string {
+	var endpoints []string
+
+	for _, match := range flaskRoutePattern.FindAllSubmatch(content, -1) {
+		path := string(match[1])
+		methods := []string{"GET"}
+		if methodsMatch := flaskMethodsPattern.FindSubmatch(match[2]); methodsMatch != nil {
+			methods = nil
+			for _, method := range quotedWordPattern.FindAllSubmatch(methodsMatch[1], -1) {
+				methods = append(methods, string(method[1]))
 			}
 		}
+		for _, method := range methods {
+			endpoints = append(endpoints, formatEndpoint(method, path))
+		}
+	}
+
+	for _, match := range pythonMethodRoutePattern.FindAllSubmatch(content, -1) {
+		endpoints = append(endpoints, formatEndpoint(string(match[1]), string(match[2])))
+	}
+
+	return endpoints
+}
+
+// findGoEndpoints finds net/http, chi and gin route definitions.
+// net/http patterns without a method (Go 1.22 "POST /path" syntax) match any method.
+func (g *EndpointExistsGrader) findGoEndpoints(content []byte) []string {
+	var endpoints []string
+
+	for _, match := range goHandleRoutePattern.FindAllSubmatch(content, -1) {
+		method, path := "ANY", string(match[1])
+		if fields := strings.Fields(path); len(fields) == 2 {
+			method, path = fields[0], fields[1]
+		}
+		endpoints = append(endpoints, formatEndpoint(method, path))
+	}
+
+	for _, match := range goMethodRoutePattern.FindAllSubmatch(content, -1) {
+		endpoints = append(endpoints, formatEndpoint(string(match[1]), string(match[2])))
 	}
 
 	return endpoints
 }
+
+// formatEndpoint formats an endpoint as "METHOD /path"
+func formatEndpoint(method, path string) string {
+	return fmt.Sprintf("%s %s", strings.ToUpper(method), path)
+}
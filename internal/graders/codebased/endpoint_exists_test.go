@@ -70,13 +70,33 @@ func TestEndpointExistsGraderIsApplicable(t *testing.T) {
 			expected: true,
 		},
 		{
-			name: "not applicable when only Go files changed",
+			name: "applicable when Go files changed",
 			input: GradeInput{
 				TaskID:       "task-123",
 				TaskType:     "feature",
 				ChangedFiles: []string{"main.go"},
 				WorkDir:      "/tmp",
 			},
+			expected: true,
+		},
+		{
+			name: "applicable when Python files changed",
+			input: GradeInput{
+				TaskID:       "task-123",
+				TaskType:     "feature",
+				ChangedFiles: []string{"app.py"},
+				WorkDir:      "/tmp",
+			},
+			expected: true,
+		},
+		{
+			name: "not applicable when only non-source files changed",
+			input: GradeInput{
+				TaskID:       "task-123",
+				TaskType:     "feature",
+				ChangedFiles: []string{"README.md", "config.yaml"},
+				WorkDir:      "/tmp",
+			},
 			expected: false,
 		},
 		{
@@ -425,14 +445,14 @@ func TestEndpointExistsGraderSkipWhenNotApplicable(t *testing.T) {
 			skipReason: "Not applicable for spike tasks",
 		},
 		{
-			name: "skip when only non-JS files changed",
+			name: "skip when only non-source files changed",
 			input: GradeInput{
 				TaskID:       "task-123",
 				TaskType:     "feature",
-				ChangedFiles: []string{"main.go", "utils.py"},
+				ChangedFiles: []string{"README.md", "config.yaml"},
 				WorkDir:      "/tmp",
 			},
-			skipReason: "No JS/TS files",
+			skipReason: "No JS/TS, Python or Go files",
 		},
 	}
 
@@ -450,6 +470,177 @@ func TestEndpointExistsGraderSkipWhenNotApplicable(t *testing.T) {
 	}
 }
 
+// TestEndpointExistsGraderFrameworks verifies route detection for Flask, FastAPI, net/http, chi and gin
+func TestEndpointExistsGraderFrameworks(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected []string
+	}{
+		{
+			name: "Flask route with methods",
+			file: "app.py",
+			content: `
+@app.route('/users', methods=['GET', 'POST'])
+def users():
+    pass
+
+@bp.route("/health")
+def health():
+    pass
+`,
+			expected: []string{"GET /users", "POST /users", "GET /health"},
+		},
+		{
+			name: "Flask method decorators",
+			file: "views.py",
+			content: `
+@app.get('/items')
+def list_items():
+    pass
+
+@app.delete('/items/<int:id>')
+def delete_item(id):
+    pass
+`,
+			expected: []string{"GET /items", "DELETE /items/<int:id>"},
+		},
+		{
+			name: "FastAPI routes",
+			file: "main.py",
+			content: `
+@app.get("/orders/{order_id}")
+async def read_order(order_id: int):
+    pass
+
+@router.put("/orders/{order_id}")
+async def update_order(order_id: int):
+    pass
+`,
+			expected: []string{"GET /orders/{order_id}", "PUT /orders/{order_id}"},
+		},
+		{
+			name: "Go net/http routes",
+			file: "server.go",
+			content: `
+func routes(mux *http.ServeMux) {
+	http.HandleFunc("/legacy", legacyHandler)
+	mux.HandleFunc("POST /users", createUser)
+	mux.Handle("/static/", fileServer)
+}
+`,
+			expected: []string{"ANY /legacy", "POST /users", "ANY /static/"},
+		},
+		{
+			name: "Go chi routes",
+			file: "router.go",
+			content: `
+r := chi.NewRouter()
+r.Get("/users", listUsers)
+r.Post("/users", createUser)
+resp, _ := http.Get("https://example.com/users")
+`,
+			expected: []string{"GET /users", "POST /users"},
+		},
+		{
+			name: "Go gin routes",
+			file: "routes.go",
+			content: `
+r := gin.Default()
+r.GET("/ping", ping)
+r.PATCH("/users/:id", updateUser)
+`,
+			expected: []string{"GET /ping", "PATCH /users/:id"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tmpDir, tt.file), []byte(tt.content), 0644); err != nil {
+				t.Fatalf("Failed to write %s: %v", tt.file, err)
+			}
+
+			result := NewEndpointExistsGrader().Grade(GradeInput{
+				TaskID:       "task-123",
+				TaskType:     "feature",
+				ChangedFiles: []string{tt.file},
+				WorkDir:      tmpDir,
+			})
+
+			if !result.Passed {
+				t.Fatalf("Expected Passed to be true, details: %s", result.Details)
+			}
+			expectedDetails := "Discovered endpoints: " + strings.Join(tt.expected, ", ")
+			if result.Details != expectedDetails {
+				t.Errorf("Expected Details %q, got %q", expectedDetails, result.Details)
+			}
+		})
+	}
+}
+
+// TestEndpointExistsGraderMixedLanguages verifies one file with endpoints passes alongside files without
+func TestEndpointExistsGraderMixedLanguages(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"handlers.go": "package api\n\nfunc helper() int { return 42 }\n",
+		"api.py":      "@app.post('/jobs')\ndef create_job():\n    pass\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	result := NewEndpointExistsGrader().Grade(GradeInput{
+		TaskID:       "task-123",
+		TaskType:     "feature",
+		ChangedFiles: []string{"handlers.go", "api.py"},
+		WorkDir:      tmpDir,
+	})
+
+	if !result.Passed {
+		t.Errorf("Expected Passed to be true when one file has routes, details: %s", result.Details)
+	}
+	if result.Details != "Discovered endpoints: POST /jobs" {
+		t.Errorf("Expected Details 'Discovered endpoints: POST /jobs', got %q", result.Details)
+	}
+}
+
+// TestEndpointExistsGraderBOMAndCRLF verifies Windows-authored files with a BOM and CRLF line endings are parsed
+func TestEndpointExistsGraderBOMAndCRLF(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"routes.py": "\ufeff@app.route('/users', methods=['GET', 'POST'])\r\ndef users():\r\n    pass\r\n@app.delete('/users/<id>')\r\ndef delete_user(id):\r\n    pass\r\n",
+		"routes.js": "\ufeffapp.get('/health', handler);\r\nrouter.put('/items/:id', handler);\r\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	result := NewEndpointExistsGrader().Grade(GradeInput{
+		TaskID:       "task-123",
+		TaskType:     "feature",
+		ChangedFiles: []string{"routes.py", "routes.js"},
+		WorkDir:      tmpDir,
+	})
+
+	if !result.Passed {
+		t.Fatalf("Expected Passed to be true, details: %s", result.Details)
+	}
+	for _, endpoint := range []string{"GET /users", "POST /users", "DELETE /users/<id>", "GET /health", "PUT /items/:id"} {
+		if !strings.Contains(result.Details, endpoint) {
+			t.Errorf("Expected Details to contain %q, got %q", endpoint, result.Details)
+		}
+	}
+	if strings.Contains(result.Details, "\r") {
+		t.Errorf("Expected no carriage returns in Details, got %q", result.Details)
+	}
+}
+
 // TestEndpointExistsGraderSingleQuotes verifies single quote handling
 func TestEndpointExistsGraderSingleQuotes(t *testing.T) {
 	tmpDir := t.TempDir()
@@ -9,6 +9,10 @@ import (
 // FileExistsGrader checks that changed files actually exist in the working directory
 type FileExistsGrader struct{}
 
+func init() {
+	Register("file-exists", func() CodeGrader { return NewFileExistsGrader() })
+}
+
 // NewFileExistsGrader creates a new FileExistsGrader
 func NewFileExistsGrader() *FileExistsGrader {
 	return &FileExistsGrader{}
@@ -19,6 +23,20 @@ func (g *FileExistsGrader) Name() string {
 	return "file-exists"
 }
 
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *FileExistsGrader) Version() string {
+	return "1.0.0"
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *FileExistsGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Checks that every changed file exists in the working directory",
+		TaskTypes:   []string{"feature", "bug", "test", "spike", "chore"},
+		Files:       "All changed files",
+	}
+}
+
 // IsApplicable returns true if there are changed files to check
 func (g *FileExistsGrader) IsApplicable(input GradeInput) bool {
 	return len(input.ChangedFiles) > 0
@@ -29,12 +47,13 @@ func (g *FileExistsGrader) Grade(input GradeInput) GradeResult {
 	// Skip if not applicable
 	if !g.IsApplicable(input) {
 		return GradeResult{
-			GraderName: g.Name(),
-			Passed:     false,
-			Score:      0,
-			Details:    "",
-			Skipped:    true,
-			SkipReason: "No changed files to check",
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    "No changed files to check",
 		}
 	}
 
@@ -72,11 +91,12 @@ func (g *FileExistsGrader) Grade(input GradeInput) GradeResult {
 	}
 
 	return GradeResult{
-		GraderName: g.Name(),
-		Passed:     passed,
-		Score:      score,
-		Details:    details,
-		Skipped:    false,
-		SkipReason: "",
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        passed,
+		Score:         score,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
 	}
 }
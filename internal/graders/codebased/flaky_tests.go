@@ -0,0 +1,236 @@
+package codebased
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// flakyAllowMarker exempts a line from non-deterministic pattern detection
+const flakyAllowMarker = "kaizen:allow-flaky"
+
+// flakyPattern is a construct that makes a test depend on timing or randomness
+type flakyPattern struct {
+	// kind names the pattern in findings, e.g. "sleep"
+	kind string
+	// pattern matches a line using the construct
+	pattern *regexp.Regexp
+	// unseededOnly reports the pattern only when the file never seeds its random source
+	unseededOnly bool
+}
+
+var (
+	goFlakyPatterns = []flakyPattern{
+		{kind: "time.Now", pattern: regexp.MustCompile(`\btime\.(Now|Since|Until)\s*\(`)},
+		{kind: "sleep", pattern: regexp.MustCompile(`\btime\.(Sleep|After|Tick)\s*\(`)},
+		{kind: "unseeded random", pattern: regexp.MustCompile(`\brand\.(Int|Intn|Int31|Int31n|Int63|Int63n|IntN|Uint32|Uint64|Float32|Float64|Perm|Shuffle|N)\s*\(`), unseededOnly: true},
+	}
+	pyFlakyPatterns = []flakyPattern{
+		{kind: "time.Now", pattern: regexp.MustCompile(`\b(datetime\.(now|utcnow|today)|time\.time)\s*\(`)},
+		{kind: "sleep", pattern: regexp.MustCompile(`\b(time|asyncio)\.sleep\s*\(`)},
+		{kind: "unseeded random", pattern: regexp.MustCompile(`\brandom\.(random|randint|randrange|choice|choices|shuffle|sample|uniform)\s*\(`), unseededOnly: true},
+	}
+	jsFlakyPatterns = []flakyPattern{
+		{kind: "time.Now", pattern: regexp.MustCompile(`\bDate\.now\s*\(|\bnew\s+Date\s*\(\s*\)`)},
+		{kind: "sleep", pattern: regexp.MustCompile(`\b(setTimeout|sleep)\s*\(`)},
+		{kind: "unseeded random", pattern: regexp.MustCompile(`\bMath\.random\s*\(`)},
+	}
+
+	// flakyPatterns maps test file extensions to the non-deterministic patterns for that language
+	flakyPatterns = map[string][]flakyPattern{
+		".go":  goFlakyPatterns,
+		".py":  pyFlakyPatterns,
+		".js":  jsFlakyPatterns,
+		".jsx": jsFlakyPatterns,
+		".ts":  jsFlakyPatterns,
+		".tsx": jsFlakyPatterns,
+	}
+
+	// randomSeedPatterns match an explicitly seeded random source, which makes randomness reproducible
+	randomSeedPatterns = map[string]*regexp.Regexp{
+		".go": regexp.MustCompile(`\brand\.(Seed|New|NewSource|NewPCG|NewChaCha8)\s*\(`),
+		".py": regexp.MustCompile(`\brandom\.(seed|Random)\s*\(`),
+	}
+)
+
+// FlakyTestGrader checks changed test files for non-deterministic patterns (wall-clock time,
+// sleeps, unseeded randomness) that correlate with flaky tests
+type FlakyTestGrader struct {
+	failOnFindings bool
+}
+
+func init() {
+	Register("flaky-tests", func() CodeGrader { return NewFlakyTestGrader() })
+}
+
+// NewFlakyTestGrader creates a new FlakyTestGrader that reports findings as a warning
+func NewFlakyTestGrader() *FlakyTestGrader {
+	return &FlakyTestGrader{}
+}
+
+// WithFailOnFindings makes the grader fail when non-deterministic patterns are found instead of warning
+func (g *FlakyTestGrader) WithFailOnFindings(fail bool) *FlakyTestGrader {
+	g.failOnFindings = fail
+	return g
+}
+
+// Name returns the grader name
+func (g *FlakyTestGrader) Name() string {
+	return "flaky-tests"
+}
+
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *FlakyTestGrader) Version() string {
+	return "1.0.0"
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *FlakyTestGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Warns about wall-clock time, sleeps and unseeded randomness in tests",
+		TaskTypes:   []string{"feature", "test"},
+		Files:       "Go, Python and JS/TS test files",
+	}
+}
+
+// IsApplicable returns true for feature/test tasks that changed supported test files
+func (g *FlakyTestGrader) IsApplicable(input GradeInput) bool {
+	if !input.AnyTaskType && input.TaskType != "feature" && input.TaskType != "test" {
+		return false
+	}
+
+	return len(g.testFiles(input.ChangedFiles)) > 0
+}
+
+// Grade scans changed test files for non-deterministic patterns and lists them as file:line
+func (g *FlakyTestGrader) Grade(input GradeInput) GradeResult {
+	// Skip if not applicable
+	if !g.IsApplicable(input) {
+		skipReason := "No test files to check"
+		if !input.AnyTaskType && input.TaskType != "feature" && input.TaskType != "test" {
+			skipReason = fmt.Sprintf("Not applicable for %s tasks", input.TaskType)
+		}
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    skipReason,
+		}
+	}
+
+	testFiles := g.testFiles(input.ChangedFiles)
+	filesWithFindings := 0
+	var findings []string
+
+	for _, file := range testFiles {
+		// Resolve path relative to WorkDir if not absolute
+		filePath := file
+		if !filepath.IsAbs(file) {
+			filePath = filepath.Join(input.WorkDir, file)
+		}
+
+		content, err := readNormalizedFile(filePath)
+		if err != nil {
+			// Missing files are reported by file-exists
+			continue
+		}
+
+		fileFindings := g.findFlakyLines(strings.ToLower(filepath.Ext(file)), content)
+		if len(fileFindings) > 0 {
+			filesWithFindings++
+		}
+		for _, finding := range fileFindings {
+			findings = append(findings, fmt.Sprintf("%s:%s", file, finding))
+		}
+	}
+
+	if len(findings) == 0 {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        true,
+			Score:         100,
+			Details:       fmt.Sprintf("No non-deterministic patterns in %d test files", len(testFiles)),
+			Skipped:       false,
+			SkipReason:    "",
+		}
+	}
+
+	details := fmt.Sprintf("%d non-deterministic patterns found: %s", len(findings), strings.Join(findings, ", "))
+	if !g.failOnFindings {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        true,
+			Score:         100,
+			Details:       "Warning: " + details,
+			Skipped:       false,
+			SkipReason:    "",
+		}
+	}
+
+	score := float64(len(testFiles)-filesWithFindings) / float64(len(testFiles)) * 100
+	return GradeResult{
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        false,
+		Score:         score,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
+	}
+}
+
+// testFiles returns the changed files that are test files in a supported language
+func (g *FlakyTestGrader) testFiles(files []string) []string {
+	testExists := NewTestExistsGrader()
+	var testFiles []string
+	for _, file := range files {
+		if _, ok := flakyPatterns[strings.ToLower(filepath.Ext(file))]; ok && testExists.isTestFile(file) {
+			testFiles = append(testFiles, file)
+		}
+	}
+	return testFiles
+}
+
+// findFlakyLines returns "line (kind)" for each line using a non-deterministic pattern.
+// Comment lines and lines carrying the allow marker are ignored.
+func (g *FlakyTestGrader) findFlakyLines(ext string, content []byte) []string {
+	seeded := false
+	if seedPattern, ok := randomSeedPatterns[ext]; ok {
+		seeded = seedPattern.Match(content)
+	}
+
+	var findings []string
+	lineNum := 0
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "*") {
+			continue
+		}
+		if strings.Contains(line, flakyAllowMarker) {
+			continue
+		}
+		for _, p := range flakyPatterns[ext] {
+			if p.unseededOnly && seeded {
+				continue
+			}
+			if p.pattern.MatchString(line) {
+				findings = append(findings, fmt.Sprintf("%d (%s)", lineNum, p.kind))
+				break
+			}
+		}
+	}
+
+	return findings
+}
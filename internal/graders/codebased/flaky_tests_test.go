@@ -0,0 +1,140 @@
+package codebased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestFlakyTestGraderInterface verifies FlakyTestGrader implements CodeGrader
+func TestFlakyTestGraderInterface(t *testing.T) {
+	var _ CodeGrader = (*FlakyTestGrader)(nil)
+}
+
+// TestFlakyTestGraderIsApplicable verifies applicability logic
+func TestFlakyTestGraderIsApplicable(t *testing.T) {
+	grader := NewFlakyTestGrader()
+
+	tests := []struct {
+		name     string
+		input    GradeInput
+		expected bool
+	}{
+		{
+			name:     "applicable for feature task with test files",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"foo_test.go"}},
+			expected: true,
+		},
+		{
+			name:     "applicable for test task with JS spec files",
+			input:    GradeInput{TaskType: "test", ChangedFiles: []string{"foo.spec.ts"}},
+			expected: true,
+		},
+		{
+			name:     "not applicable without test files",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"foo.go"}},
+			expected: false,
+		},
+		{
+			name:     "not applicable for bug tasks",
+			input:    GradeInput{TaskType: "bug", ChangedFiles: []string{"foo_test.go"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grader.IsApplicable(tt.input); got != tt.expected {
+				t.Errorf("IsApplicable() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestFlakyTestGraderUnseededRandom verifies unseeded randomness is reported, and seeded randomness is not
+func TestFlakyTestGraderUnseededRandom(t *testing.T) {
+	tmpDir := t.TempDir()
+	unseeded := `package shuffle
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestShuffle(t *testing.T) {
+	n := rand.Intn(10)
+	_ = n
+}
+`
+	seeded := `package shuffle
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestShuffleSeeded(t *testing.T) {
+	rng := rand.New(rand.NewSource(42))
+	n := rand.Intn(10)
+	_, _ = rng, n
+}
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "shuffle_test.go"), []byte(unseeded), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "seeded_test.go"), []byte(seeded), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewFlakyTestGrader().Grade(GradeInput{
+		TaskType:     "test",
+		ChangedFiles: []string{"shuffle_test.go", "seeded_test.go"},
+		WorkDir:      tmpDir,
+	})
+
+	if !result.Passed {
+		t.Error("Expected grader to pass with a warning by default")
+	}
+	if !strings.HasPrefix(result.Details, "Warning:") || !strings.Contains(result.Details, "shuffle_test.go:9 (unseeded random)") {
+		t.Errorf("Expected warning listing shuffle_test.go:9, got: %s", result.Details)
+	}
+	if strings.Contains(result.Details, "seeded_test.go") {
+		t.Errorf("Expected seeded randomness to be allowed, got: %s", result.Details)
+	}
+}
+
+// TestFlakyTestGraderSleep verifies sleeps are reported and fail when configured
+func TestFlakyTestGraderSleep(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `import { render } from "./render";
+
+test("renders after delay", async () => {
+  // setTimeout( in a comment is ignored
+  await new Promise((resolve) => setTimeout(resolve, 500));
+  expect(render()).toBeTruthy();
+});
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "render.test.ts"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "clean.test.ts"), []byte("test(\"ok\", () => expect(1).toBe(1));\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewFlakyTestGrader().WithFailOnFindings(true).Grade(GradeInput{
+		TaskType:     "feature",
+		ChangedFiles: []string{"render.test.ts", "clean.test.ts"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Passed {
+		t.Error("Expected grader to fail when configured to fail on findings")
+	}
+	if result.Score != 50 {
+		t.Errorf("Expected score 50 (1 of 2 files clean), got %.1f", result.Score)
+	}
+	if !strings.Contains(result.Details, "render.test.ts:5 (sleep)") || strings.Contains(result.Details, ":4") {
+		t.Errorf("Expected only render.test.ts:5 to be listed, got: %s", result.Details)
+	}
+}
@@ -6,21 +6,48 @@ type GradeInput struct {
 	TaskType     string   `json:"task_type"` // feature, bug, test, spike, chore
 	ChangedFiles []string `json:"changed_files"`
 	WorkDir      string   `json:"work_dir"`
+	// CoverageFile is an existing go test coverage profile; when empty, coverage graders run the tests themselves
+	CoverageFile string `json:"coverage_file,omitempty"`
+	// AnyTaskType lifts the graders' task-type restrictions, e.g. for graders a configured
+	// pipeline names for this task type. Graders still skip when no changed file is theirs to check.
+	AnyTaskType bool `json:"-"`
 }
 
 // GradeResult is the output from a code-based grader
 type GradeResult struct {
-	GraderName string  `json:"grader_name"`
-	Passed     bool    `json:"passed"`
-	Score      float64 `json:"score"`   // 0-100
-	Details    string  `json:"details"` // Human-readable details
-	Skipped    bool    `json:"skipped"` // true if grader not applicable
-	SkipReason string  `json:"skip_reason"`
+	GraderName    string  `json:"grader_name"`
+	GraderVersion string  `json:"grader_version,omitempty"`
+	Passed        bool    `json:"passed"`
+	Score         float64 `json:"score"`   // 0-100
+	Details       string  `json:"details"` // Human-readable details
+	Skipped       bool    `json:"skipped"` // true if grader not applicable
+	SkipReason    string  `json:"skip_reason"`
+	// Weight is the grader's share of the overall score, set by the caller from Weight(grader)
+	Weight float64 `json:"weight,omitempty"`
 }
 
 // CodeGrader interface for code-based evaluations
 type CodeGrader interface {
 	Name() string
+	Version() string
 	Grade(input GradeInput) GradeResult
 	IsApplicable(input GradeInput) bool
 }
+
+// GraderInfo describes what a grader checks and when it applies, for listings like `kaizen graders list`
+type GraderInfo struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	TaskTypes   []string `json:"task_types"` // task types the grader runs for
+	Files       string   `json:"files"`      // changed files the grader looks at
+}
+
+// Describer is implemented by graders that can describe themselves
+type Describer interface {
+	Describe() GraderInfo
+}
+
+// Weighter is implemented by graders that count more (or less) than others in an overall score
+type Weighter interface {
+	Weight() float64
+}
@@ -77,6 +77,7 @@ func TestGradeResultSkipped(t *testing.T) {
 // MockCodeGrader implements CodeGrader for testing
 type MockCodeGrader struct {
 	NameValue         string
+	VersionValue      string
 	GradeResult       GradeResult
 	IsApplicableValue bool
 }
@@ -85,6 +86,10 @@ func (m *MockCodeGrader) Name() string {
 	return m.NameValue
 }
 
+func (m *MockCodeGrader) Version() string {
+	return m.VersionValue
+}
+
 func (m *MockCodeGrader) Grade(input GradeInput) GradeResult {
 	return m.GradeResult
 }
@@ -137,3 +142,29 @@ func TestCodeGraderMethods(t *testing.T) {
 		t.Errorf("Expected Score to be 100, got %f", result.Score)
 	}
 }
+
+// TestGradersReportVersion verifies each grader exposes a version and stamps it on results
+func TestGradersReportVersion(t *testing.T) {
+	graders := []CodeGrader{
+		NewFileExistsGrader(),
+		NewTestExistsGrader(),
+		NewEndpointExistsGrader(),
+		NewTestCoverageGrader(),
+		NewSkippedTestGrader(),
+		NewDebugStatementGrader(),
+		NewTestRatioGrader(),
+	}
+
+	for _, grader := range graders {
+		t.Run(grader.Name(), func(t *testing.T) {
+			if grader.Version() == "" {
+				t.Fatal("Expected non-empty version")
+			}
+			// A chore task is skipped by every grader, which still carries the version
+			result := grader.Grade(GradeInput{TaskType: "chore", WorkDir: t.TempDir()})
+			if result.GraderVersion != grader.Version() {
+				t.Errorf("Expected GraderVersion %q, got %q", grader.Version(), result.GraderVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package codebased
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// defaultMigrationsDir is the directory migrations are expected in by default
+const defaultMigrationsDir = "migrations"
+
+// modelPathSegments are directory names whose files define ORM models or entities
+var modelPathSegments = map[string]bool{
+	"models": true,
+	"entity": true,
+}
+
+// schemaContentPattern matches content that defines a table or an ORM model
+var schemaContentPattern = regexp.MustCompile(`(?i)\bcreate\s+table\b|\bdb\.Model\b`)
+
+// MigrationGrader checks that changes to database models or schema definitions come with a
+// migration in the same change set
+type MigrationGrader struct {
+	migrationsDir string
+}
+
+func init() {
+	Register("migration-exists", func() CodeGrader { return NewMigrationExistsGrader() })
+}
+
+// NewMigrationExistsGrader creates a new MigrationGrader expecting migrations under migrations/
+func NewMigrationExistsGrader() *MigrationGrader {
+	return NewMigrationGrader(defaultMigrationsDir)
+}
+
+// NewMigrationGrader creates a new MigrationGrader expecting migrations under migrationsDir,
+// a slash-separated path relative to the repository root such as "db/migrate"
+func NewMigrationGrader(migrationsDir string) *MigrationGrader {
+	migrationsDir = strings.Trim(path.Clean(filepath.ToSlash(migrationsDir)), "/")
+	if migrationsDir == "" || migrationsDir == "." {
+		migrationsDir = defaultMigrationsDir
+	}
+	return &MigrationGrader{
+		migrationsDir: migrationsDir,
+	}
+}
+
+// Name returns the grader name
+func (g *MigrationGrader) Name() string {
+	return "migration-exists"
+}
+
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *MigrationGrader) Version() string {
+	return "1.0.0"
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *MigrationGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Fails when changed ORM models or schema definitions have no migration in the same change",
+		TaskTypes:   []string{"feature", "bug"},
+		Files:       fmt.Sprintf("Files under models/ or entity/, or containing CREATE TABLE or db.Model; migrations under %s/ or named *_migrate.*", g.migrationsDir),
+	}
+}
+
+// migrationTaskTypes are the task types whose model changes are expected to ship with a migration
+var migrationTaskTypes = map[string]bool{
+	"feature": true,
+	"bug":     true,
+}
+
+// IsApplicable returns true for feature/bug tasks with changed files
+func (g *MigrationGrader) IsApplicable(input GradeInput) bool {
+	return (input.AnyTaskType || migrationTaskTypes[input.TaskType]) && len(input.ChangedFiles) > 0
+}
+
+// Grade lists the model changes and fails when none of the changed files is a migration
+func (g *MigrationGrader) Grade(input GradeInput) GradeResult {
+	if !g.IsApplicable(input) {
+		skipReason := "No changed files"
+		if !input.AnyTaskType && !migrationTaskTypes[input.TaskType] {
+			skipReason = fmt.Sprintf("Not applicable for %s tasks", input.TaskType)
+		}
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    skipReason,
+		}
+	}
+
+	var models, migrations []string
+	for _, file := range input.ChangedFiles {
+		if g.isMigrationFile(file) {
+			migrations = append(migrations, file)
+		} else if reason := g.modelChangeReason(file, input.WorkDir); reason != "" {
+			models = append(models, fmt.Sprintf("%s (%s)", file, reason))
+		}
+	}
+
+	if len(models) == 0 {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    "No model or schema changes",
+		}
+	}
+
+	if len(migrations) == 0 {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details: fmt.Sprintf("Model changes without a migration: %s; expected a file under %s/ or named *_migrate.*",
+				strings.Join(models, ", "), g.migrationsDir),
+			Skipped:    false,
+			SkipReason: "",
+		}
+	}
+
+	return GradeResult{
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        true,
+		Score:         100,
+		Details:       fmt.Sprintf("Model changes %s come with migrations: %s", strings.Join(models, ", "), strings.Join(migrations, ", ")),
+		Skipped:       false,
+		SkipReason:    "",
+	}
+}
+
+// isMigrationFile reports whether file is under the migrations directory or named *_migrate.*
+func (g *MigrationGrader) isMigrationFile(file string) bool {
+	slashed := filepath.ToSlash(file)
+	if strings.HasPrefix(slashed, g.migrationsDir+"/") || strings.Contains(slashed, "/"+g.migrationsDir+"/") {
+		return true
+	}
+	matched, _ := path.Match("*_migrate.*", path.Base(slashed))
+	return matched
+}
+
+// modelChangeReason returns why file looks like a model or schema definition, or "" if it doesn't.
+// Test files are ignored; a file that can't be read is judged by its path alone.
+func (g *MigrationGrader) modelChangeReason(file, workDir string) string {
+	if NewTestExistsGrader().isTestFile(file) {
+		return ""
+	}
+
+	dirs := strings.Split(filepath.ToSlash(filepath.Dir(file)), "/")
+	for _, dir := range dirs {
+		if modelPathSegments[dir] {
+			return "under " + dir + "/"
+		}
+	}
+
+	// Resolve path relative to WorkDir if not absolute
+	filePath := file
+	if !filepath.IsAbs(file) {
+		filePath = filepath.Join(workDir, file)
+	}
+	content, err := readNormalizedFile(filePath)
+	if err != nil {
+		// Missing files are reported by file-exists
+		return ""
+	}
+	if match := schemaContentPattern.Find(content); match != nil {
+		return "contains " + strings.Join(strings.Fields(string(match)), " ")
+	}
+	return ""
+}
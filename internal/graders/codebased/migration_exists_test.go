@@ -0,0 +1,149 @@
+package codebased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// gradeMigrationFiles writes name -> content to a temp dir and grades them as a feature task
+func gradeMigrationFiles(t *testing.T, grader *MigrationGrader, files map[string]string) GradeResult {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	var changed []string
+	for name, content := range files {
+		path := filepath.Join(tmpDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		changed = append(changed, name)
+	}
+
+	return grader.Grade(GradeInput{TaskType: "feature", ChangedFiles: changed, WorkDir: tmpDir})
+}
+
+// TestMigrationGraderInterface verifies MigrationGrader implements CodeGrader
+func TestMigrationGraderInterface(t *testing.T) {
+	var _ CodeGrader = (*MigrationGrader)(nil)
+}
+
+// TestMigrationGraderIsApplicable verifies the grader only applies to feature and bug tasks
+func TestMigrationGraderIsApplicable(t *testing.T) {
+	grader := NewMigrationExistsGrader()
+	for taskType, expected := range map[string]bool{
+		"feature": true,
+		"bug":     true,
+		"test":    false,
+		"chore":   false,
+		"spike":   false,
+	} {
+		input := GradeInput{TaskType: taskType, ChangedFiles: []string{"models/user.go"}}
+		if got := grader.IsApplicable(input); got != expected {
+			t.Errorf("IsApplicable(%s) = %v, want %v", taskType, got, expected)
+		}
+		if !expected {
+			result := grader.Grade(input)
+			if !result.Skipped || result.SkipReason != "Not applicable for "+taskType+" tasks" {
+				t.Errorf("Expected skip for %s task, got %+v", taskType, result)
+			}
+		}
+	}
+}
+
+// TestMigrationGraderMissingMigration verifies model changes without a migration fail with the model files
+func TestMigrationGraderMissingMigration(t *testing.T) {
+	result := gradeMigrationFiles(t, NewMigrationExistsGrader(), map[string]string{
+		"internal/models/user.go": "package models\n\ntype User struct{}\n",
+		"app/schema.py":           "class User(db.Model):\n    id = db.Column(db.Integer)\n",
+		"db/schema.sql":           "create table users (id int);\n",
+		"models/user_test.go":     "package models\n",
+		"handlers/user.go":        "package handlers\n",
+	})
+
+	if result.Passed || result.Skipped || result.Score != 0 {
+		t.Fatalf("Expected failure, got %+v", result)
+	}
+	for _, want := range []string{
+		"Model changes without a migration:",
+		"internal/models/user.go (under models/)",
+		"app/schema.py (contains db.Model)",
+		"db/schema.sql (contains create table)",
+		"expected a file under migrations/ or named *_migrate.*",
+	} {
+		if !strings.Contains(result.Details, want) {
+			t.Errorf("Expected details to contain %q, got: %s", want, result.Details)
+		}
+	}
+	for _, unwanted := range []string{"user_test.go", "handlers"} {
+		if strings.Contains(result.Details, unwanted) {
+			t.Errorf("Expected %s to be ignored, got: %s", unwanted, result.Details)
+		}
+	}
+}
+
+// TestMigrationGraderWithMigration verifies a migration under migrations/ or named *_migrate.* passes
+func TestMigrationGraderWithMigration(t *testing.T) {
+	tests := []struct {
+		name      string
+		migration string
+	}{
+		{name: "migrations directory", migration: "migrations/0002_add_users.sql"},
+		{name: "nested migrations directory", migration: "service/migrations/0002_add_users.sql"},
+		{name: "migrate file name", migration: "db/users_migrate.go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := gradeMigrationFiles(t, NewMigrationExistsGrader(), map[string]string{
+				"entity/user.ts": "export class User {}\n",
+				tt.migration:     "CREATE TABLE users (id int);\n",
+			})
+
+			if !result.Passed || result.Score != 100 {
+				t.Fatalf("Expected pass, got %+v", result)
+			}
+			if !strings.Contains(result.Details, "entity/user.ts (under entity/)") || !strings.Contains(result.Details, tt.migration) {
+				t.Errorf("Expected details to name the model and migration, got: %s", result.Details)
+			}
+		})
+	}
+}
+
+// TestMigrationGraderCustomDirectory verifies the migrations directory is configurable
+func TestMigrationGraderCustomDirectory(t *testing.T) {
+	grader := NewMigrationGrader("db/migrate/")
+
+	result := gradeMigrationFiles(t, grader, map[string]string{
+		"models/user.rb":                  "class User < ApplicationRecord\nend\n",
+		"db/migrate/20260101_users.rb":    "class CreateUsers < ActiveRecord::Migration[7.0]\nend\n",
+		"migrations/0001_ignored_here.rb": "",
+	})
+	if !result.Passed {
+		t.Errorf("Expected pass with migration under db/migrate, got %+v", result)
+	}
+
+	result = gradeMigrationFiles(t, grader, map[string]string{
+		"models/user.rb":        "class User < ApplicationRecord\nend\n",
+		"migrations/0001_x.sql": "",
+	})
+	if result.Passed || !strings.Contains(result.Details, "expected a file under db/migrate/") {
+		t.Errorf("Expected failure naming db/migrate/, got %+v", result)
+	}
+}
+
+// TestMigrationGraderSkipsWithoutModelChanges verifies the grader skips when no model or schema changed
+func TestMigrationGraderSkipsWithoutModelChanges(t *testing.T) {
+	result := gradeMigrationFiles(t, NewMigrationExistsGrader(), map[string]string{
+		"handlers/user.go":    "package handlers\n",
+		"migrations/0001.sql": "CREATE TABLE users (id int);\n",
+	})
+
+	if !result.Skipped || result.SkipReason != "No model or schema changes" {
+		t.Errorf("Expected skip without model changes, got %+v", result)
+	}
+}
@@ -0,0 +1,16 @@
+package codebased
+
+import (
+	"os"
+
+	"github.com/srstomp/kaizen/internal/textutil"
+)
+
+// readNormalizedFile reads a file and normalizes its BOM and line endings
+func readNormalizedFile(filePath string) ([]byte, error) {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(textutil.NormalizeLineEndings(string(content))), nil
+}
@@ -0,0 +1,85 @@
+package codebased
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]func() CodeGrader)
+)
+
+// Register makes a grader available by name. Graders register themselves from init.
+// Register panics if factory is nil or a grader is registered twice under the same name.
+func Register(name string, factory func() CodeGrader) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if factory == nil {
+		panic("codebased: Register factory is nil")
+	}
+	if _, dup := registry[name]; dup {
+		panic(fmt.Sprintf("codebased: Register called twice for grader %q", name))
+	}
+	registry[name] = factory
+}
+
+// New returns a new instance of the named grader, or nil if no grader is registered under that name
+func New(name string) CodeGrader {
+	registryMu.RLock()
+	factory := registry[name]
+	registryMu.RUnlock()
+
+	if factory == nil {
+		return nil
+	}
+	return factory()
+}
+
+// Names returns the names of all registered graders, sorted
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Describe returns the description of a grader, falling back to its name for graders
+// that don't implement Describer
+func Describe(grader CodeGrader) GraderInfo {
+	if d, ok := grader.(Describer); ok {
+		info := d.Describe()
+		info.Name = grader.Name()
+		return info
+	}
+	return GraderInfo{Name: grader.Name()}
+}
+
+// DefaultWeight is the overall-score weight of graders that don't implement Weighter
+const DefaultWeight = 1.0
+
+// Weight returns a grader's overall-score weight, falling back to DefaultWeight for graders
+// that don't implement Weighter or return a non-positive weight
+func Weight(grader CodeGrader) float64 {
+	if w, ok := grader.(Weighter); ok && w.Weight() > 0 {
+		return w.Weight()
+	}
+	return DefaultWeight
+}
+
+// All returns a new instance of every registered grader, sorted by name
+func All() []CodeGrader {
+	names := Names()
+	graders := make([]CodeGrader, 0, len(names))
+	for _, name := range names {
+		graders = append(graders, New(name))
+	}
+	return graders
+}
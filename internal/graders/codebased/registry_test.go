@@ -0,0 +1,104 @@
+package codebased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestRegistryIncludesBuiltinGraders verifies every built-in grader self-registers under its Name
+func TestRegistryIncludesBuiltinGraders(t *testing.T) {
+	want := []string{
+		"debug-statements",
+		"doc-exists",
+		"endpoint-exists",
+		"file-exists",
+		"flaky-tests",
+		"migration-exists",
+		"skipped-tests",
+		"sql-injection",
+		"test-coverage",
+		"test-exists",
+		"test-ratio",
+	}
+	if got := strings.Join(Names(), ","); got != strings.Join(want, ",") {
+		t.Errorf("Expected registered graders %v, got %v", want, Names())
+	}
+
+	for i, grader := range All() {
+		if grader.Name() != want[i] {
+			t.Errorf("All()[%d]: expected %s, got %s", i, want[i], grader.Name())
+		}
+	}
+}
+
+// TestRegistryNew verifies New returns fresh instances and nil for unknown names
+func TestRegistryNew(t *testing.T) {
+	first, second := New("test-ratio"), New("test-ratio")
+	if first == nil || first == second {
+		t.Errorf("Expected distinct test-ratio instances, got %p and %p", first, second)
+	}
+	if New("no-such-grader") != nil {
+		t.Error("Expected nil for unknown grader")
+	}
+}
+
+// TestWeight verifies graders implementing Weighter report their weight and others the default
+func TestWeight(t *testing.T) {
+	for name, want := range map[string]float64{
+		"file-exists":   DefaultWeight,
+		"test-exists":   2,
+		"test-coverage": 2,
+		"sql-injection": 2,
+	} {
+		if got := Weight(New(name)); got != want {
+			t.Errorf("Weight(%s) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+// TestRegisterDuplicatePanics verifies a name can only be registered once
+func TestRegisterDuplicatePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Register to panic on duplicate name")
+		}
+	}()
+	Register("file-exists", func() CodeGrader { return NewFileExistsGrader() })
+}
+
+// TestDescribeMatchesApplicability verifies every built-in grader describes itself and that the
+// task types it lists are exactly those IsApplicable accepts
+func TestDescribeMatchesApplicability(t *testing.T) {
+	files := []string{"main.go", "main_test.go", "app.ts", "app.test.ts", "README.md"}
+
+	// Some graders only apply when the changed files have certain content, such as SQL
+	tmpDir := t.TempDir()
+	contents := map[string]string{
+		"main.go": "package main\n\nconst query = \"SELECT name FROM users WHERE id = ?\"\n",
+	}
+	for _, file := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, file), []byte(contents[file]), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, grader := range All() {
+		info := Describe(grader)
+		if info.Name != grader.Name() || info.Description == "" || info.Files == "" {
+			t.Errorf("%s: incomplete description %+v", grader.Name(), info)
+		}
+
+		listed := make(map[string]bool)
+		for _, taskType := range info.TaskTypes {
+			listed[taskType] = true
+		}
+		for _, taskType := range []string{"feature", "bug", "test", "spike", "chore"} {
+			applicable := grader.IsApplicable(GradeInput{TaskType: taskType, ChangedFiles: files, WorkDir: tmpDir})
+			if applicable != listed[taskType] {
+				t.Errorf("%s: IsApplicable(%s) = %v but task types are %v", grader.Name(), taskType, applicable, info.TaskTypes)
+			}
+		}
+	}
+}
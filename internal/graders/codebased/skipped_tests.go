@@ -0,0 +1,220 @@
+package codebased
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	// goTestFuncPattern matches Go test function declarations
+	goTestFuncPattern = regexp.MustCompile(`^func\s+(Test\w*)\s*\(`)
+	// goSkipPattern matches t.Skip, t.Skipf and t.SkipNow calls
+	goSkipPattern = regexp.MustCompile(`\b\w+\.Skip(f|Now)?\s*\(`)
+	// jsSkipPattern matches it.skip/test.skip/describe.skip and xit/xtest/xdescribe
+	jsSkipPattern = regexp.MustCompile("(?:\\b(?:it|test|describe)\\.skip|\\bx(?:it|test|describe))\\s*\\(\\s*['\"`]([^'\"`]+)['\"`]")
+	// pySkipPattern matches @pytest.mark.skip and @pytest.mark.skipif decorators
+	pySkipPattern = regexp.MustCompile(`^\s*@pytest\.mark\.skip(if)?\b`)
+	// pyTestFuncPattern matches Python test function declarations
+	pyTestFuncPattern = regexp.MustCompile(`^\s*(?:async\s+)?def\s+(test\w*)\s*\(`)
+)
+
+// SkippedTestGrader checks changed test files for skipped or disabled tests
+type SkippedTestGrader struct{}
+
+func init() {
+	Register("skipped-tests", func() CodeGrader { return NewSkippedTestGrader() })
+}
+
+// NewSkippedTestGrader creates a new SkippedTestGrader
+func NewSkippedTestGrader() *SkippedTestGrader {
+	return &SkippedTestGrader{}
+}
+
+// Name returns the grader name
+func (g *SkippedTestGrader) Name() string {
+	return "skipped-tests"
+}
+
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *SkippedTestGrader) Version() string {
+	return "1.0.0"
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *SkippedTestGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Fails when changed tests are skipped (t.Skip, it.skip, @pytest.mark.skip)",
+		TaskTypes:   []string{"feature", "bug", "test"},
+		Files:       "Go, Python and JS/TS test files",
+	}
+}
+
+// IsApplicable returns true for feature/bug/test tasks that changed test files
+func (g *SkippedTestGrader) IsApplicable(input GradeInput) bool {
+	applicableTaskTypes := map[string]bool{
+		"feature": true,
+		"bug":     true,
+		"test":    true,
+	}
+	if !input.AnyTaskType && !applicableTaskTypes[input.TaskType] {
+		return false
+	}
+
+	return len(g.testFiles(input.ChangedFiles)) > 0
+}
+
+// Grade scans changed test files for skip markers and fails listing the skipped tests
+func (g *SkippedTestGrader) Grade(input GradeInput) GradeResult {
+	// Skip if not applicable
+	if !g.IsApplicable(input) {
+		skipReason := "No test files to check"
+		if !input.AnyTaskType && input.TaskType != "feature" && input.TaskType != "bug" && input.TaskType != "test" {
+			skipReason = fmt.Sprintf("Not applicable for %s tasks", input.TaskType)
+		}
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    skipReason,
+		}
+	}
+
+	testFiles := g.testFiles(input.ChangedFiles)
+	cleanFiles := 0
+	var skippedTests []string
+
+	for _, file := range testFiles {
+		// Resolve path relative to WorkDir if not absolute
+		filePath := file
+		if !filepath.IsAbs(file) {
+			filePath = filepath.Join(input.WorkDir, file)
+		}
+
+		content, err := readNormalizedFile(filePath)
+		if err != nil {
+			// Missing files are reported by file-exists
+			cleanFiles++
+			continue
+		}
+
+		skipped := g.findSkippedTests(file, content)
+		if len(skipped) == 0 {
+			cleanFiles++
+			continue
+		}
+		for _, name := range skipped {
+			skippedTests = append(skippedTests, fmt.Sprintf("%s:%s", filepath.Base(file), name))
+		}
+	}
+
+	score := float64(cleanFiles) / float64(len(testFiles)) * 100
+	passed := len(skippedTests) == 0
+
+	var details string
+	if passed {
+		details = fmt.Sprintf("No skipped tests in %d test files", len(testFiles))
+	} else {
+		details = fmt.Sprintf("%d skipped tests found: %s", len(skippedTests), strings.Join(skippedTests, ", "))
+	}
+
+	return GradeResult{
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        passed,
+		Score:         score,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
+	}
+}
+
+// testFiles returns the changed files that are test files
+func (g *SkippedTestGrader) testFiles(files []string) []string {
+	testExists := NewTestExistsGrader()
+	var testFiles []string
+	for _, file := range files {
+		if testExists.isTestFile(file) {
+			testFiles = append(testFiles, file)
+		}
+	}
+	return testFiles
+}
+
+// findSkippedTests returns the names of skipped tests in a test file
+func (g *SkippedTestGrader) findSkippedTests(file string, content []byte) []string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".go":
+		return g.findSkippedGoTests(content)
+	case ".py":
+		return g.findSkippedPythonTests(content)
+	case ".js", ".jsx", ".ts", ".tsx":
+		var skipped []string
+		for _, match := range jsSkipPattern.FindAllSubmatch(content, -1) {
+			skipped = append(skipped, string(match[1]))
+		}
+		return skipped
+	}
+	return nil
+}
+
+// findSkippedGoTests returns Go test functions that call t.Skip
+func (g *SkippedTestGrader) findSkippedGoTests(content []byte) []string {
+	var skipped []string
+	currentTest := ""
+	reported := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if match := goTestFuncPattern.FindStringSubmatch(line); match != nil {
+			currentTest = match[1]
+			reported = false
+			continue
+		}
+		if strings.HasPrefix(line, "func ") {
+			currentTest = ""
+			continue
+		}
+		if currentTest != "" && !reported && goSkipPattern.MatchString(line) {
+			skipped = append(skipped, currentTest)
+			reported = true
+		}
+	}
+
+	return skipped
+}
+
+// findSkippedPythonTests returns Python test functions decorated with a pytest skip marker
+func (g *SkippedTestGrader) findSkippedPythonTests(content []byte) []string {
+	var skipped []string
+	pendingSkip := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if pySkipPattern.MatchString(line) {
+			pendingSkip = true
+			continue
+		}
+		if match := pyTestFuncPattern.FindStringSubmatch(line); match != nil {
+			if pendingSkip {
+				skipped = append(skipped, match[1])
+			}
+			pendingSkip = false
+			continue
+		}
+		// Other decorators can sit between the skip marker and the function
+		if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "@") {
+			pendingSkip = false
+		}
+	}
+
+	return skipped
+}
@@ -0,0 +1,252 @@
+package codebased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSkippedTestGraderInterface verifies SkippedTestGrader implements CodeGrader
+func TestSkippedTestGraderInterface(t *testing.T) {
+	var _ CodeGrader = (*SkippedTestGrader)(nil)
+}
+
+// TestSkippedTestGraderName verifies the grader name
+func TestSkippedTestGraderName(t *testing.T) {
+	grader := NewSkippedTestGrader()
+	if grader.Name() != "skipped-tests" {
+		t.Errorf("Expected name 'skipped-tests', got %s", grader.Name())
+	}
+}
+
+// TestSkippedTestGraderIsApplicable verifies applicability logic
+func TestSkippedTestGraderIsApplicable(t *testing.T) {
+	grader := NewSkippedTestGrader()
+
+	tests := []struct {
+		name     string
+		input    GradeInput
+		expected bool
+	}{
+		{
+			name:     "applicable for feature task with test files",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"foo_test.go"}},
+			expected: true,
+		},
+		{
+			name:     "applicable for test task with JS spec files",
+			input:    GradeInput{TaskType: "test", ChangedFiles: []string{"foo.spec.ts"}},
+			expected: true,
+		},
+		{
+			name:     "not applicable without test files",
+			input:    GradeInput{TaskType: "bug", ChangedFiles: []string{"foo.go"}},
+			expected: false,
+		},
+		{
+			name:     "not applicable for chore tasks",
+			input:    GradeInput{TaskType: "chore", ChangedFiles: []string{"foo_test.go"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grader.IsApplicable(tt.input); got != tt.expected {
+				t.Errorf("IsApplicable() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestSkippedTestGraderGoSkips verifies t.Skip calls in Go tests are reported
+func TestSkippedTestGraderGoSkips(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `package foo
+
+import "testing"
+
+func TestRuns(t *testing.T) {
+	if 1+1 != 2 {
+		t.Fatal("math")
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	t.Skip("flaky")
+	t.Skip("still flaky")
+}
+
+func TestSkipNow(t *testing.T) {
+	t.SkipNow()
+}
+
+func helper() {
+	skipper.Skip()
+}
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "foo_test.go"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader := NewSkippedTestGrader()
+	result := grader.Grade(GradeInput{
+		TaskID:       "task-123",
+		TaskType:     "feature",
+		ChangedFiles: []string{"foo_test.go"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Skipped {
+		t.Fatalf("Expected grader to run, skipped: %s", result.SkipReason)
+	}
+	if result.Passed {
+		t.Error("Expected grader to fail when tests are skipped")
+	}
+	if !strings.Contains(result.Details, "2 skipped tests") {
+		t.Errorf("Expected 2 skipped tests in details, got: %s", result.Details)
+	}
+	for _, name := range []string{"foo_test.go:TestDisabled", "foo_test.go:TestSkipNow"} {
+		if !strings.Contains(result.Details, name) {
+			t.Errorf("Expected details to list %s, got: %s", name, result.Details)
+		}
+	}
+	if strings.Contains(result.Details, "TestRuns") {
+		t.Errorf("TestRuns should not be reported as skipped: %s", result.Details)
+	}
+}
+
+// TestSkippedTestGraderJSSkips verifies it.skip/xit/describe.skip in JS tests are reported
+func TestSkippedTestGraderJSSkips(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `describe('users', () => {
+  it('creates a user', () => {});
+  it.skip('deletes a user', () => {});
+  xit("updates a user", () => {});
+  test.skip(` + "`lists users`" + `, () => {});
+});
+
+describe.skip('admin', () => {
+  it('bans a user', () => {});
+});
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "users.test.js"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader := NewSkippedTestGrader()
+	result := grader.Grade(GradeInput{
+		TaskID:       "task-123",
+		TaskType:     "bug",
+		ChangedFiles: []string{"users.test.js"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Passed {
+		t.Error("Expected grader to fail when tests are skipped")
+	}
+	for _, name := range []string{"deletes a user", "updates a user", "lists users", "admin"} {
+		if !strings.Contains(result.Details, name) {
+			t.Errorf("Expected details to list %q, got: %s", name, result.Details)
+		}
+	}
+	if strings.Contains(result.Details, "creates a user") {
+		t.Errorf("Active test should not be reported as skipped: %s", result.Details)
+	}
+}
+
+// TestSkippedTestGraderPythonSkips verifies pytest skip markers are reported
+func TestSkippedTestGraderPythonSkips(t *testing.T) {
+	tmpDir := t.TempDir()
+	content := `import pytest
+
+@pytest.mark.skip(reason="broken")
+def test_broken():
+    assert False
+
+@pytest.mark.skipif(True, reason="platform")
+@pytest.mark.slow
+def test_platform():
+    pass
+
+def test_works():
+    assert True
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "test_api.py"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader := NewSkippedTestGrader()
+	result := grader.Grade(GradeInput{
+		TaskType:     "test",
+		ChangedFiles: []string{"test_api.py"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Passed {
+		t.Error("Expected grader to fail when tests are skipped")
+	}
+	if !strings.Contains(result.Details, "test_broken") || !strings.Contains(result.Details, "test_platform") {
+		t.Errorf("Expected skipped Python tests in details, got: %s", result.Details)
+	}
+	if strings.Contains(result.Details, "test_works") {
+		t.Errorf("test_works should not be reported as skipped: %s", result.Details)
+	}
+}
+
+// TestSkippedTestGraderNoSkips verifies the grader passes when no tests are skipped
+func TestSkippedTestGraderNoSkips(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "bar_test.go"), []byte("package bar\n\nfunc TestBar(t *testing.T) {}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(tmpDir, "bar.spec.ts"), []byte("it('works', () => {});\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	grader := NewSkippedTestGrader()
+	result := grader.Grade(GradeInput{
+		TaskType:     "feature",
+		ChangedFiles: []string{"bar.go", "bar_test.go", "bar.spec.ts"},
+		WorkDir:      tmpDir,
+	})
+
+	if !result.Passed {
+		t.Errorf("Expected grader to pass, got: %s", result.Details)
+	}
+	if result.Score != 100 {
+		t.Errorf("Expected score 100, got %f", result.Score)
+	}
+}
+
+// TestSkippedTestGraderBOMAndCRLF verifies skip markers are found in Windows-authored files with a BOM and CRLF line endings
+func TestSkippedTestGraderBOMAndCRLF(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"test_api.py": "\ufeff@pytest.mark.skip(reason=\"flaky\")\r\ndef test_first():\r\n    pass\r\n\r\ndef test_second():\r\n    pass\r\n",
+		"foo_test.go": "\ufeffpackage foo\r\n\r\nfunc TestFoo(t *testing.T) {\r\n\tt.Skip(\"later\")\r\n}\r\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	grader := NewSkippedTestGrader()
+	result := grader.Grade(GradeInput{
+		TaskType:     "test",
+		ChangedFiles: []string{"test_api.py", "foo_test.go"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Passed {
+		t.Error("Expected grader to fail when tests are skipped")
+	}
+	if !strings.Contains(result.Details, "test_first") || !strings.Contains(result.Details, "TestFoo") {
+		t.Errorf("Expected test_first and TestFoo in details, got: %s", result.Details)
+	}
+	if strings.Contains(result.Details, "test_second") {
+		t.Errorf("test_second should not be reported as skipped: %s", result.Details)
+	}
+}
@@ -0,0 +1,236 @@
+package codebased
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// sqlAllowMarker exempts a line from SQL injection detection
+const sqlAllowMarker = "kaizen:allow-sql"
+
+// sqlTaskTypes are the task types whose changes can introduce queries
+var sqlTaskTypes = map[string]bool{
+	"feature": true,
+	"bug":     true,
+	"chore":   true,
+}
+
+// sqlStatementPattern matches SQL statement structure rather than bare keywords, so prose like
+// "select an option from the menu" is not mistaken for a query
+var sqlStatementPattern = regexp.MustCompile(`(?i)\bselect\s+(distinct\s+)?[\w.*()]+(\s+as\s+\w+)?(\s*,\s*[\w.*()]+(\s+as\s+\w+)?)*\s+from\b|\binsert\s+into\b|\bupdate\s+\w+\s+set\b|\bdelete\s+from\b|\bwhere\s+\w+\s*(=|<>|!=|<|>|\blike\b|\bin\b)`)
+
+// sqlInjectionPattern is a way of building a query from values instead of binding parameters
+type sqlInjectionPattern struct {
+	// kind names the pattern in findings, e.g. "concatenation"
+	kind string
+	// pattern matches a line that builds SQL this way
+	pattern *regexp.Regexp
+}
+
+var (
+	// sqlConcatenation matches a string literal joined to a non-literal value with +
+	sqlConcatenation = sqlInjectionPattern{
+		kind:    "concatenation",
+		pattern: regexp.MustCompile("[\"'`]\\s*\\+=?\\s*[A-Za-z_$(]|[\\w$)\\]]\\s*\\+=?\\s*[\"'`]"),
+	}
+
+	goSQLInjectionPatterns = []sqlInjectionPattern{
+		sqlConcatenation,
+		{kind: "Sprintf", pattern: regexp.MustCompile("\\bfmt\\.Sprintf\\s*\\(\\s*[\"`][^\"`]*%[sdvq]")},
+	}
+	pySQLInjectionPatterns = []sqlInjectionPattern{
+		sqlConcatenation,
+		{kind: "f-string", pattern: regexp.MustCompile(`(^|[^\w])[rR]?[fF][rR]?["'][^"']*\{`)},
+		{kind: "% formatting", pattern: regexp.MustCompile(`["']\s*%\s*[\w(]`)},
+		{kind: "str.format", pattern: regexp.MustCompile(`["']\.format\s*\(`)},
+	}
+	// Tagged templates such as sql`...${id}` bind their values, so only untagged templates match
+	jsSQLInjectionPatterns = []sqlInjectionPattern{
+		sqlConcatenation,
+		{kind: "template literal", pattern: regexp.MustCompile("(^|[^\\w.])`[^`]*\\$\\{")},
+	}
+
+	// sqlInjectionPatterns maps source file extensions to the unsafe query-building patterns for that language
+	sqlInjectionPatterns = map[string][]sqlInjectionPattern{
+		".go":  goSQLInjectionPatterns,
+		".py":  pySQLInjectionPatterns,
+		".js":  jsSQLInjectionPatterns,
+		".jsx": jsSQLInjectionPatterns,
+		".ts":  jsSQLInjectionPatterns,
+		".tsx": jsSQLInjectionPatterns,
+	}
+)
+
+// SQLInjectionGrader checks changed source files for SQL queries built by concatenating or
+// interpolating values, which are open to SQL injection. Parameterized queries pass.
+type SQLInjectionGrader struct{}
+
+func init() {
+	Register("sql-injection", func() CodeGrader { return NewSQLInjectionGrader() })
+}
+
+// NewSQLInjectionGrader creates a new SQLInjectionGrader
+func NewSQLInjectionGrader() *SQLInjectionGrader {
+	return &SQLInjectionGrader{}
+}
+
+// Name returns the grader name
+func (g *SQLInjectionGrader) Name() string {
+	return "sql-injection"
+}
+
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *SQLInjectionGrader) Version() string {
+	return "1.0.0"
+}
+
+// Weight counts the grader double in the overall score, as injection risks are security flaws
+func (g *SQLInjectionGrader) Weight() float64 {
+	return 2
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *SQLInjectionGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Fails on SQL queries built by concatenating or interpolating values instead of parameters",
+		TaskTypes:   []string{"feature", "bug", "chore"},
+		Files:       "Non-test .go, .py, .js, .jsx, .ts and .tsx files containing SQL",
+	}
+}
+
+// IsApplicable returns true for feature/bug/chore tasks whose changed source files contain SQL
+func (g *SQLInjectionGrader) IsApplicable(input GradeInput) bool {
+	if !input.AnyTaskType && !sqlTaskTypes[input.TaskType] {
+		return false
+	}
+
+	return len(g.sqlFiles(input)) > 0
+}
+
+// Grade scans changed source files that contain SQL for unsafe query building and lists them as file:line
+func (g *SQLInjectionGrader) Grade(input GradeInput) GradeResult {
+	if !input.AnyTaskType && !sqlTaskTypes[input.TaskType] {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    fmt.Sprintf("Not applicable for %s tasks", input.TaskType),
+		}
+	}
+
+	sqlFiles := g.sqlFiles(input)
+	if len(sqlFiles) == 0 {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    "No changed source files contain SQL",
+		}
+	}
+
+	filesWithFindings := 0
+	var findings []string
+	for _, file := range sqlFiles {
+		fileFindings := g.findInjectionLines(strings.ToLower(filepath.Ext(file.path)), file.content)
+		if len(fileFindings) > 0 {
+			filesWithFindings++
+		}
+		for _, finding := range fileFindings {
+			findings = append(findings, fmt.Sprintf("%s:%s", file.path, finding))
+		}
+	}
+
+	if len(findings) == 0 {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        true,
+			Score:         100,
+			Details:       fmt.Sprintf("No SQL injection risks in %d files with SQL", len(sqlFiles)),
+			Skipped:       false,
+			SkipReason:    "",
+		}
+	}
+
+	score := float64(len(sqlFiles)-filesWithFindings) / float64(len(sqlFiles)) * 100
+	return GradeResult{
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        false,
+		Score:         score,
+		Details:       fmt.Sprintf("%d likely SQL injection risks found: %s", len(findings), strings.Join(findings, ", ")),
+		Skipped:       false,
+		SkipReason:    "",
+	}
+}
+
+// sqlSourceFile is a changed source file that contains SQL
+type sqlSourceFile struct {
+	path    string
+	content []byte
+}
+
+// sqlFiles returns the changed non-test source files in a supported language that contain SQL
+func (g *SQLInjectionGrader) sqlFiles(input GradeInput) []sqlSourceFile {
+	testExists := NewTestExistsGrader()
+	var files []sqlSourceFile
+	for _, file := range input.ChangedFiles {
+		if _, ok := sqlInjectionPatterns[strings.ToLower(filepath.Ext(file))]; !ok || testExists.isTestFile(file) {
+			continue
+		}
+
+		// Resolve path relative to WorkDir if not absolute
+		filePath := file
+		if !filepath.IsAbs(file) {
+			filePath = filepath.Join(input.WorkDir, file)
+		}
+
+		content, err := readNormalizedFile(filePath)
+		if err != nil {
+			// Missing files are reported by file-exists
+			continue
+		}
+		if sqlStatementPattern.Match(content) {
+			files = append(files, sqlSourceFile{path: file, content: content})
+		}
+	}
+	return files
+}
+
+// findInjectionLines returns "line (kind)" for each line that contains SQL and builds it unsafely.
+// Comment lines and lines carrying the allow marker are ignored.
+func (g *SQLInjectionGrader) findInjectionLines(ext string, content []byte) []string {
+	var findings []string
+	lineNum := 0
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		lineNum++
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, "*") || strings.HasPrefix(trimmed, "/*") {
+			continue
+		}
+		if strings.Contains(line, sqlAllowMarker) || !sqlStatementPattern.MatchString(line) {
+			continue
+		}
+		for _, p := range sqlInjectionPatterns[ext] {
+			if p.pattern.MatchString(line) {
+				findings = append(findings, fmt.Sprintf("%d (%s)", lineNum, p.kind))
+				break
+			}
+		}
+	}
+
+	return findings
+}
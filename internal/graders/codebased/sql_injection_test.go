@@ -0,0 +1,219 @@
+package codebased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// gradeSQLFiles writes name -> content to a temp dir and grades them as a feature task
+func gradeSQLFiles(t *testing.T, files map[string]string) GradeResult {
+	t.Helper()
+
+	tmpDir := t.TempDir()
+	var changed []string
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		changed = append(changed, name)
+	}
+
+	return NewSQLInjectionGrader().Grade(GradeInput{TaskType: "feature", ChangedFiles: changed, WorkDir: tmpDir})
+}
+
+// TestSQLInjectionGraderInterface verifies SQLInjectionGrader implements CodeGrader
+func TestSQLInjectionGraderInterface(t *testing.T) {
+	var _ CodeGrader = (*SQLInjectionGrader)(nil)
+}
+
+// TestSQLInjectionGraderIsApplicable verifies the grader only applies when changed source files contain SQL
+func TestSQLInjectionGraderIsApplicable(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"store.go":      "package store\n\nconst q = \"SELECT id FROM users WHERE id = ?\"\n",
+		"menu.go":       "package menu\n\n// Please select an option from the menu\nconst title = \"Select from menu\"\n",
+		"store_test.go": "package store\n\nconst q = \"SELECT id FROM users WHERE id = 1\"\n",
+		"schema.sql":    "SELECT id FROM users;\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	grader := NewSQLInjectionGrader()
+	tests := []struct {
+		name     string
+		input    GradeInput
+		expected bool
+	}{
+		{
+			name:     "applicable for feature task with SQL in Go file",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"store.go"}, WorkDir: tmpDir},
+			expected: true,
+		},
+		{
+			name:     "not applicable for test tasks",
+			input:    GradeInput{TaskType: "test", ChangedFiles: []string{"store.go"}, WorkDir: tmpDir},
+			expected: false,
+		},
+		{
+			name:     "not applicable when keywords are not a statement",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"menu.go"}, WorkDir: tmpDir},
+			expected: false,
+		},
+		{
+			name:     "not applicable for test files or unsupported extensions",
+			input:    GradeInput{TaskType: "bug", ChangedFiles: []string{"store_test.go", "schema.sql"}, WorkDir: tmpDir},
+			expected: false,
+		},
+		{
+			name:     "not applicable when files are missing",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"missing.go"}, WorkDir: tmpDir},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grader.IsApplicable(tt.input); got != tt.expected {
+				t.Errorf("IsApplicable() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestSQLInjectionGraderGo verifies concatenated and Sprintf-built Go queries are reported as file:line
+func TestSQLInjectionGraderGo(t *testing.T) {
+	content := `package store
+
+import "fmt"
+
+func Find(db DB, id, name string) {
+	db.Query("SELECT * FROM users WHERE id = " + id)
+	// db.Query("SELECT * FROM users WHERE id = " + id)
+	db.Query(fmt.Sprintf("DELETE FROM users WHERE name = '%s'", name))
+	db.Query("SELECT * FROM users WHERE id = $1", id)
+	db.Query("SELECT * FROM " + table + " WHERE id = ?", id) // kaizen:allow-sql
+	log := "selected " + name
+}
+`
+	result := gradeSQLFiles(t, map[string]string{"store.go": content})
+
+	if result.Passed || result.Skipped {
+		t.Fatalf("Expected failure, got %+v", result)
+	}
+	for _, want := range []string{"2 likely SQL injection risks", "store.go:6 (concatenation)", "store.go:8 (Sprintf)"} {
+		if !strings.Contains(result.Details, want) {
+			t.Errorf("Expected details to contain %q, got: %s", want, result.Details)
+		}
+	}
+	for _, unwanted := range []string{"store.go:7", "store.go:9", "store.go:10", "store.go:11"} {
+		if strings.Contains(result.Details, unwanted) {
+			t.Errorf("Expected %s to be ignored, got: %s", unwanted, result.Details)
+		}
+	}
+	if result.Score != 0 {
+		t.Errorf("Expected score 0 with one file failing, got %.1f", result.Score)
+	}
+}
+
+// TestSQLInjectionGraderPython verifies f-strings and string formatting into SQL are reported
+func TestSQLInjectionGraderPython(t *testing.T) {
+	content := `def find(cursor, user_id, name):
+    cursor.execute(f"SELECT * FROM users WHERE id = {user_id}")
+    # cursor.execute(f"SELECT * FROM users WHERE id = {user_id}")
+    cursor.execute("SELECT * FROM users WHERE name = '%s'" % name)
+    cursor.execute("UPDATE users SET name = '{}'".format(name))
+    cursor.execute("SELECT * FROM users WHERE id = %s", (user_id,))
+`
+	result := gradeSQLFiles(t, map[string]string{"repo.py": content})
+
+	if result.Passed {
+		t.Fatalf("Expected failure, got %+v", result)
+	}
+	for _, want := range []string{"repo.py:2 (f-string)", "repo.py:4 (% formatting)", "repo.py:5 (str.format)"} {
+		if !strings.Contains(result.Details, want) {
+			t.Errorf("Expected details to contain %q, got: %s", want, result.Details)
+		}
+	}
+	for _, unwanted := range []string{"repo.py:3", "repo.py:6"} {
+		if strings.Contains(result.Details, unwanted) {
+			t.Errorf("Expected %s to be ignored, got: %s", unwanted, result.Details)
+		}
+	}
+}
+
+// TestSQLInjectionGraderTypeScript verifies untagged template literals and concatenation are reported
+func TestSQLInjectionGraderTypeScript(t *testing.T) {
+	content := "export async function find(db: Db, id: string) {\n" +
+		"  await db.query(`SELECT * FROM users WHERE id = ${id}`);\n" +
+		"  await db.query(sql`SELECT * FROM users WHERE id = ${id}`);\n" +
+		"  await db.query('SELECT * FROM users WHERE id = ' + id);\n" +
+		"  await db.query('SELECT * FROM users WHERE id = $1', [id]);\n" +
+		"}\n"
+	result := gradeSQLFiles(t, map[string]string{"repo.ts": content})
+
+	if result.Passed {
+		t.Fatalf("Expected failure, got %+v", result)
+	}
+	for _, want := range []string{"repo.ts:2 (template literal)", "repo.ts:4 (concatenation)"} {
+		if !strings.Contains(result.Details, want) {
+			t.Errorf("Expected details to contain %q, got: %s", want, result.Details)
+		}
+	}
+	for _, unwanted := range []string{"repo.ts:3", "repo.ts:5"} {
+		if strings.Contains(result.Details, unwanted) {
+			t.Errorf("Expected %s to be ignored, got: %s", unwanted, result.Details)
+		}
+	}
+}
+
+// TestSQLInjectionGraderParameterized verifies parameterized queries pass and score by file
+func TestSQLInjectionGraderParameterized(t *testing.T) {
+	result := gradeSQLFiles(t, map[string]string{
+		"store.go": "package store\n\nfunc Find(db DB, id string) {\n\tdb.Query(\"SELECT * FROM users WHERE id = ?\", id)\n}\n",
+		"repo.py":  "cursor.execute(\"INSERT INTO users (name) VALUES (%s)\", (name,))\n",
+		"util.go":  "package util\n\nfunc Greet(name string) string { return \"hi \" + name }\n",
+	})
+
+	if !result.Passed || result.Score != 100 {
+		t.Fatalf("Expected pass with score 100, got %+v", result)
+	}
+	if !strings.Contains(result.Details, "2 files with SQL") {
+		t.Errorf("Expected details to count files with SQL, got: %s", result.Details)
+	}
+}
+
+// TestSQLInjectionGraderPartialScore verifies the score reflects the share of SQL files without risks
+func TestSQLInjectionGraderPartialScore(t *testing.T) {
+	result := gradeSQLFiles(t, map[string]string{
+		"safe.go":   "package store\n\nconst q = \"SELECT * FROM users WHERE id = ?\"\n",
+		"unsafe.go": "package store\n\nfunc q(id string) string { return \"SELECT * FROM users WHERE id = \" + id }\n",
+	})
+
+	if result.Passed {
+		t.Fatalf("Expected failure, got %+v", result)
+	}
+	if result.Score != 50 {
+		t.Errorf("Expected score 50, got %.1f", result.Score)
+	}
+}
+
+// TestSQLInjectionGraderSkipsWithoutSQL verifies the grader skips when no changed file contains SQL
+func TestSQLInjectionGraderSkipsWithoutSQL(t *testing.T) {
+	result := gradeSQLFiles(t, map[string]string{
+		"main.go": "package main\n\nfunc main() { println(\"hello \" + name) }\n",
+	})
+
+	if !result.Skipped || result.SkipReason != "No changed source files contain SQL" {
+		t.Errorf("Expected skip without SQL, got %+v", result)
+	}
+
+	result = NewSQLInjectionGrader().Grade(GradeInput{TaskType: "spike", ChangedFiles: []string{"main.go"}})
+	if !result.Skipped || !strings.Contains(result.SkipReason, "spike") {
+		t.Errorf("Expected skip for spike task, got %+v", result)
+	}
+}
@@ -3,39 +3,80 @@ package codebased
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
-	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 )
 
-// TestCoverageGrader runs Go test coverage and compares against a minimum threshold
+// defaultCoverageThreshold is the default minimum coverage percentage
+const defaultCoverageThreshold = 80.0
+
+// TestCoverageGrader measures Go test coverage of the changed packages and compares it against a minimum threshold
 type TestCoverageGrader struct {
 	threshold float64
 }
 
-// NewTestCoverageGrader creates a new TestCoverageGrader
+func init() {
+	Register("test-coverage", func() CodeGrader { return NewTestCoverageGrader() })
+}
+
+// NewTestCoverageGrader creates a new TestCoverageGrader with the default threshold
 func NewTestCoverageGrader() *TestCoverageGrader {
+	return NewCoverageGrader(defaultCoverageThreshold)
+}
+
+// NewCoverageGrader creates a new TestCoverageGrader that passes when coverage is at least threshold percent
+func NewCoverageGrader(threshold float64) *TestCoverageGrader {
 	return &TestCoverageGrader{
-		threshold: 80.0,
+		threshold: threshold,
 	}
 }
 
+// WithThreshold sets the minimum coverage percentage the changed packages must reach
+func (g *TestCoverageGrader) WithThreshold(threshold float64) *TestCoverageGrader {
+	g.threshold = threshold
+	return g
+}
+
 // Name returns the grader name
 func (g *TestCoverageGrader) Name() string {
 	return "test-coverage"
 }
 
-// IsApplicable returns true if there are Go files in ChangedFiles and task type is not chore/spike
-func (g *TestCoverageGrader) IsApplicable(input GradeInput) bool {
-	// Skip for certain task types
-	skipTaskTypes := map[string]bool{
-		"chore": true,
-		"spike": true,
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *TestCoverageGrader) Version() string {
+	return "1.1.0"
+}
+
+// Weight counts the grader double in the overall score, as it measures how well changes are tested
+func (g *TestCoverageGrader) Weight() float64 {
+	return 2
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *TestCoverageGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Checks that the changed Go packages meet a test coverage threshold",
+		TaskTypes:   []string{"feature", "bug", "test"},
+		Files:       ".go files",
 	}
-	if skipTaskTypes[input.TaskType] {
+}
+
+// coverageTaskTypes are the task types whose changes are expected to be covered by tests
+var coverageTaskTypes = map[string]bool{
+	"feature": true,
+	"bug":     true,
+	"test":    true,
+}
+
+// IsApplicable returns true for feature/bug/test tasks with Go files in ChangedFiles
+func (g *TestCoverageGrader) IsApplicable(input GradeInput) bool {
+	if !input.AnyTaskType && !coverageTaskTypes[input.TaskType] {
 		return false
 	}
 
@@ -54,68 +95,87 @@ func (g *TestCoverageGrader) Grade(input GradeInput) GradeResult {
 	// Skip if not applicable
 	if !g.IsApplicable(input) {
 		skipReason := "No Go files to check"
-		if input.TaskType == "chore" || input.TaskType == "spike" {
+		if !input.AnyTaskType && !coverageTaskTypes[input.TaskType] {
 			skipReason = fmt.Sprintf("Not applicable for %s tasks", input.TaskType)
 		}
 		return GradeResult{
-			GraderName: g.Name(),
-			Passed:     false,
-			Score:      0,
-			Details:    "",
-			Skipped:    true,
-			SkipReason: skipReason,
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    skipReason,
 		}
 	}
 
-	// Execute go test with coverage
-	coverage, err := g.runCoverageTests(input.WorkDir)
+	// Read the provided coverage profile, or run go test to produce one
+	packages := g.changedPackages(input.WorkDir, input.ChangedFiles)
+	var coverage float64
+	var err error
+	source := ""
+	if input.CoverageFile != "" {
+		profilePath := input.CoverageFile
+		if !filepath.IsAbs(profilePath) {
+			profilePath = filepath.Join(input.WorkDir, profilePath)
+		}
+		coverage, err = g.readCoverageProfile(profilePath, g.modulePath(input.WorkDir), packages)
+		source = fmt.Sprintf(" from %s", input.CoverageFile)
+	} else {
+		coverage, err = g.runCoverageTests(input.WorkDir, packages)
+	}
 	if err != nil {
 		// Check if it's a "no test files" error
 		if strings.Contains(err.Error(), "no test files") {
 			return GradeResult{
-				GraderName: g.Name(),
-				Passed:     false,
-				Score:      0,
-				Details:    "No test files found",
-				Skipped:    false,
-				SkipReason: "",
+				GraderName:    g.Name(),
+				GraderVersion: g.Version(),
+				Passed:        false,
+				Score:         0,
+				Details:       "No test files found",
+				Skipped:       false,
+				SkipReason:    "",
 			}
 		}
 
-		// Check if it's a parse error
-		if strings.Contains(err.Error(), "Failed to parse coverage") {
+		// Check if the coverage profile could not be read or had no data for the changed packages
+		if strings.Contains(err.Error(), "coverage profile") {
 			return GradeResult{
-				GraderName: g.Name(),
-				Passed:     false,
-				Score:      0,
-				Details:    err.Error(),
-				Skipped:    false,
-				SkipReason: "",
+				GraderName:    g.Name(),
+				GraderVersion: g.Version(),
+				Passed:        false,
+				Score:         0,
+				Details:       err.Error(),
+				Skipped:       false,
+				SkipReason:    "",
 			}
 		}
 
 		// General test execution failure
 		return GradeResult{
-			GraderName: g.Name(),
-			Passed:     false,
-			Score:      0,
-			Details:    fmt.Sprintf("Test execution failed: %v", err),
-			Skipped:    false,
-			SkipReason: "",
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       fmt.Sprintf("Test execution failed: %v", err),
+			Skipped:       false,
+			SkipReason:    "",
 		}
 	}
 
 	// Evaluate against threshold
 	passed := g.evaluateCoverage(coverage, g.threshold)
-	details := fmt.Sprintf("Coverage: %.1f%% (threshold: %.1f%%)", coverage, g.threshold)
+	details := fmt.Sprintf("Coverage: %.1f%% of changed packages %s (threshold: %.1f%%)%s",
+		coverage, strings.Join(packages, ", "), g.threshold, source)
 
 	return GradeResult{
-		GraderName: g.Name(),
-		Passed:     passed,
-		Score:      coverage,
-		Details:    details,
-		Skipped:    false,
-		SkipReason: "",
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        passed,
+		Score:         coverage,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
 	}
 }
 
@@ -125,46 +185,92 @@ func (g *TestCoverageGrader) isGoFile(file string) bool {
 	return ext == ".go"
 }
 
-// runCoverageTests executes go test with coverage and returns the coverage percentage
-func (g *TestCoverageGrader) runCoverageTests(workDir string) (float64, error) {
+// changedPackages returns the sorted, slash-separated directories of the changed Go files, relative to workDir
+func (g *TestCoverageGrader) changedPackages(workDir string, changedFiles []string) []string {
+	seen := make(map[string]bool)
+	var packages []string
+	for _, file := range changedFiles {
+		if !g.isGoFile(file) {
+			continue
+		}
+		if filepath.IsAbs(file) {
+			if rel, err := filepath.Rel(workDir, file); err == nil {
+				file = rel
+			}
+		}
+		dir := filepath.ToSlash(filepath.Dir(file))
+		if !seen[dir] {
+			seen[dir] = true
+			packages = append(packages, dir)
+		}
+	}
+	sort.Strings(packages)
+	return packages
+}
+
+// modulePath returns the module path declared in workDir's go.mod, or "" if there is none
+func (g *TestCoverageGrader) modulePath(workDir string) string {
+	data, err := os.ReadFile(filepath.Join(workDir, "go.mod"))
+	if err != nil {
+		return ""
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+	return ""
+}
+
+// runCoverageTests runs go test with a coverage profile for the changed packages and returns their coverage percentage
+func (g *TestCoverageGrader) runCoverageTests(workDir string, packages []string) (float64, error) {
 	// Create context with timeout
 	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
 	defer cancel()
 
-	// Execute go test -cover -v ./... to get verbose output
-	cmd := exec.CommandContext(ctx, "go", "test", "-cover", "-v", "./...")
+	profile, err := os.CreateTemp("", "kaizen-coverage-*.out")
+	if err != nil {
+		return 0, fmt.Errorf("creating coverage profile: %w", err)
+	}
+	profilePath := profile.Name()
+	profile.Close()
+	defer os.Remove(profilePath)
+
+	// Only test the packages that still exist; deleted packages can't be covered
+	args := []string{"test", "-coverprofile=" + profilePath}
+	for _, pkg := range packages {
+		if info, err := os.Stat(filepath.Join(workDir, filepath.FromSlash(pkg))); err == nil && info.IsDir() {
+			args = append(args, "./"+pkg)
+		}
+	}
+	if len(args) == 2 {
+		return 0, fmt.Errorf("no test files")
+	}
+
+	cmd := exec.CommandContext(ctx, "go", args...)
 	cmd.Dir = workDir
 
 	output, err := cmd.CombinedOutput()
 	outputStr := string(output)
 
 	// Check for "no test files" case
-	if strings.Contains(outputStr, "no test files") || strings.Contains(outputStr, "[no test files]") {
+	if strings.Contains(outputStr, "no test files") {
 		return 0, fmt.Errorf("no test files")
 	}
 
-	// If command failed, check if it's because of test failures (still might have coverage)
-	// or because of compilation/other errors
-	if err != nil {
-		// Try to parse coverage even if tests failed
-		coverage, parseErr := g.parseCoverage(outputStr)
-		if parseErr == nil {
-			// We got coverage even though tests failed - return the coverage
-			return coverage, nil
+	// Failing tests still write a profile; anything else (build errors, bad work dir) doesn't
+	coverage, parseErr := g.readCoverageProfile(profilePath, g.modulePath(workDir), packages)
+	if parseErr != nil {
+		if err != nil {
+			return 0, fmt.Errorf("command failed: %v, output: %s", err, outputStr)
 		}
-		// Could not parse coverage and command failed
-		return 0, fmt.Errorf("command failed: %v, output: %s", err, outputStr)
-	}
-
-	// Parse coverage from output
-	coverage, err := g.parseCoverage(outputStr)
-	if err != nil {
-		return 0, err
+		return 0, parseErr
 	}
 
 	// Check if there were actually any tests run
 	// When there are no test files, go test still reports "coverage: 0.0%"
-	// but doesn't have any test execution output (RUN, PASS, FAIL, ok, etc.)
+	// but doesn't have any test execution output (ok, FAIL, etc.)
 	if coverage == 0.0 && !g.hasTestExecution(outputStr) {
 		return 0, fmt.Errorf("no test files")
 	}
@@ -176,11 +282,10 @@ func (g *TestCoverageGrader) runCoverageTests(workDir string) (float64, error) {
 func (g *TestCoverageGrader) hasTestExecution(output string) bool {
 	// Look for indicators that tests were executed
 	indicators := []string{
-		"=== RUN",   // Test execution
-		"--- PASS",  // Test passed
-		"--- FAIL",  // Test failed
-		"ok  ",      // Package test summary
-		"FAIL\t",    // Failed package
+		"--- PASS", // Test passed
+		"--- FAIL", // Test failed
+		"ok  ",     // Package test summary
+		"FAIL\t",   // Failed package
 	}
 
 	for _, indicator := range indicators {
@@ -192,23 +297,88 @@ func (g *TestCoverageGrader) hasTestExecution(output string) bool {
 	return false
 }
 
-// parseCoverage extracts coverage percentage from go test output
-// Expected format: "coverage: 94.9% of statements" or similar
-func (g *TestCoverageGrader) parseCoverage(output string) (float64, error) {
-	// Regex pattern to match coverage percentage
-	pattern := regexp.MustCompile(`coverage:\s+(\d+\.?\d*)%\s+of\s+statements`)
-	matches := pattern.FindStringSubmatch(output)
+// readCoverageProfile computes the statement coverage of the given packages from a go test coverage profile.
+// Blocks reported by several test binaries are counted once, as covered if any run covered them.
+func (g *TestCoverageGrader) readCoverageProfile(profilePath, modulePath string, packages []string) (float64, error) {
+	data, err := os.ReadFile(profilePath)
+	if err != nil {
+		return 0, fmt.Errorf("reading coverage profile: %w", err)
+	}
 
-	if len(matches) < 2 {
-		return 0, fmt.Errorf("Failed to parse coverage output")
+	type block struct {
+		statements int
+		covered    bool
 	}
+	blocks := make(map[string]*block)
 
-	coverage, err := strconv.ParseFloat(matches[1], 64)
-	if err != nil {
-		return 0, fmt.Errorf("Failed to parse coverage value: %v", err)
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+
+		// Format: name.go:line.column,line.column numberOfStatements count
+		fields := strings.Fields(line)
+		if len(fields) != 3 || !strings.Contains(fields[0], ":") {
+			return 0, fmt.Errorf("invalid coverage profile line: %q", line)
+		}
+		colon := strings.LastIndex(fields[0], ":")
+		if !g.inPackages(path.Dir(fields[0][:colon]), modulePath, packages) {
+			continue
+		}
+
+		statements, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid coverage profile line: %q", line)
+		}
+		count, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return 0, fmt.Errorf("invalid coverage profile line: %q", line)
+		}
+
+		b, ok := blocks[fields[0]]
+		if !ok {
+			b = &block{statements: statements}
+			blocks[fields[0]] = b
+		}
+		if count > 0 {
+			b.covered = true
+		}
 	}
 
-	return coverage, nil
+	total, covered := 0, 0
+	for _, b := range blocks {
+		total += b.statements
+		if b.covered {
+			covered += b.statements
+		}
+	}
+	if total == 0 {
+		return 0, fmt.Errorf("coverage profile has no statements for changed packages")
+	}
+
+	return float64(covered) / float64(total) * 100, nil
+}
+
+// inPackages reports whether a profile entry's import directory belongs to one of the changed packages.
+// Without a module path, packages are matched by their trailing path elements.
+func (g *TestCoverageGrader) inPackages(importDir, modulePath string, packages []string) bool {
+	for _, pkg := range packages {
+		if modulePath != "" {
+			want := modulePath
+			if pkg != "." {
+				want = modulePath + "/" + pkg
+			}
+			if importDir == want {
+				return true
+			}
+			continue
+		}
+		if pkg == "." || importDir == pkg || strings.HasSuffix(importDir, "/"+pkg) {
+			return true
+		}
+	}
+	return false
 }
 
 // evaluateCoverage checks if coverage meets or exceeds the threshold
@@ -3,6 +3,7 @@ package codebased
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -417,3 +418,110 @@ func TestTestCoverageGraderThresholdBoundary(t *testing.T) {
 		t.Error("Expected coverage 79.9% to fail with threshold 80.0%")
 	}
 }
+
+// TestNewCoverageGraderThreshold verifies the threshold passed to the constructor is used
+func TestNewCoverageGraderThreshold(t *testing.T) {
+	if got := NewCoverageGrader(65).threshold; got != 65 {
+		t.Errorf("Expected threshold 65, got %f", got)
+	}
+	if got := NewTestCoverageGrader().threshold; got != defaultCoverageThreshold {
+		t.Errorf("Expected default threshold %f, got %f", defaultCoverageThreshold, got)
+	}
+}
+
+// TestTestCoverageGraderTaskTypes verifies only feature, bug and test tasks are graded
+func TestTestCoverageGraderTaskTypes(t *testing.T) {
+	grader := NewCoverageGrader(50)
+	for taskType, expected := range map[string]bool{
+		"feature": true,
+		"bug":     true,
+		"test":    true,
+		"chore":   false,
+		"spike":   false,
+		"docs":    false,
+	} {
+		input := GradeInput{TaskType: taskType, ChangedFiles: []string{"foo.go"}}
+		if got := grader.IsApplicable(input); got != expected {
+			t.Errorf("%s: expected IsApplicable %v, got %v", taskType, expected, got)
+		}
+	}
+}
+
+// TestTestCoverageGraderCoverageFile verifies an existing profile is read for the changed packages only
+func TestTestCoverageGraderCoverageFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte("module example.com/app\n\ngo 1.21\n"), 0644); err != nil {
+		t.Fatalf("Failed to create go.mod: %v", err)
+	}
+
+	// auth is 3/4 covered, billing is uncovered, and the root package is fully covered
+	profile := `mode: set
+example.com/app/auth/login.go:3.30,5.2 2 1
+example.com/app/auth/login.go:7.30,9.2 1 1
+example.com/app/auth/login.go:11.30,13.2 1 0
+example.com/app/billing/invoice.go:3.30,5.2 4 0
+example.com/app/main.go:3.13,5.2 2 1
+`
+	if err := os.WriteFile(filepath.Join(tmpDir, "coverage.out"), []byte(profile), 0644); err != nil {
+		t.Fatalf("Failed to write coverage profile: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		changedFiles []string
+		threshold    float64
+		wantScore    float64
+		wantPassed   bool
+	}{
+		{"changed package above threshold", []string{"auth/login.go"}, 70, 75, true},
+		{"changed package below threshold", []string{"auth/login.go"}, 80, 75, false},
+		{"uncovered package drags coverage down", []string{"auth/login.go", "billing/invoice.go"}, 50, 37.5, false},
+		{"root package", []string{"main.go"}, 80, 100, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewCoverageGrader(tt.threshold).Grade(GradeInput{
+				TaskID:       "task-123",
+				TaskType:     "feature",
+				ChangedFiles: tt.changedFiles,
+				WorkDir:      tmpDir,
+				CoverageFile: "coverage.out",
+			})
+			if result.Skipped {
+				t.Fatalf("Expected grader to run, skipped: %s", result.SkipReason)
+			}
+			if result.Score != tt.wantScore {
+				t.Errorf("Expected score %.1f, got %.1f (%s)", tt.wantScore, result.Score, result.Details)
+			}
+			if result.Passed != tt.wantPassed {
+				t.Errorf("Expected Passed %v, got %v (%s)", tt.wantPassed, result.Passed, result.Details)
+			}
+			if !strings.Contains(result.Details, "from coverage.out") {
+				t.Errorf("Expected Details to name the coverage file, got %q", result.Details)
+			}
+		})
+	}
+}
+
+// TestTestCoverageGraderCoverageFileNoData verifies a profile without the changed packages fails with a reason
+func TestTestCoverageGraderCoverageFileNoData(t *testing.T) {
+	tmpDir := t.TempDir()
+	profile := "mode: set\nexample.com/app/billing/invoice.go:3.30,5.2 4 1\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, "coverage.out"), []byte(profile), 0644); err != nil {
+		t.Fatalf("Failed to write coverage profile: %v", err)
+	}
+
+	result := NewCoverageGrader(80).Grade(GradeInput{
+		TaskType:     "bug",
+		ChangedFiles: []string{"auth/login.go"},
+		WorkDir:      tmpDir,
+		CoverageFile: filepath.Join(tmpDir, "coverage.out"),
+	})
+	if result.Passed || result.Score != 0 {
+		t.Errorf("Expected failure with score 0, got passed=%v score=%.1f", result.Passed, result.Score)
+	}
+	if !strings.Contains(result.Details, "no statements for changed packages") {
+		t.Errorf("Expected no-data details, got %q", result.Details)
+	}
+}
@@ -10,6 +10,10 @@ import (
 // TestExistsGrader checks if code files have corresponding test files
 type TestExistsGrader struct{}
 
+func init() {
+	Register("test-exists", func() CodeGrader { return NewTestExistsGrader() })
+}
+
 // NewTestExistsGrader creates a new TestExistsGrader
 func NewTestExistsGrader() *TestExistsGrader {
 	return &TestExistsGrader{}
@@ -20,6 +24,25 @@ func (g *TestExistsGrader) Name() string {
 	return "test-exists"
 }
 
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *TestExistsGrader) Version() string {
+	return "1.0.0"
+}
+
+// Weight counts the grader double in the overall score, as missing tests are the most common failure
+func (g *TestExistsGrader) Weight() float64 {
+	return 2
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *TestExistsGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Checks that changed code files have corresponding test files",
+		TaskTypes:   []string{"feature", "bug", "test"},
+		Files:       "Non-test .go, .py, .js, .jsx, .ts and .tsx files",
+	}
+}
+
 // IsApplicable returns true if there are code files (non-test) to check
 func (g *TestExistsGrader) IsApplicable(input GradeInput) bool {
 	// Skip for certain task types
@@ -27,7 +50,7 @@ func (g *TestExistsGrader) IsApplicable(input GradeInput) bool {
 		"chore": true,
 		"spike": true,
 	}
-	if skipTaskTypes[input.TaskType] {
+	if !input.AnyTaskType && skipTaskTypes[input.TaskType] {
 		return false
 	}
 
@@ -46,16 +69,17 @@ func (g *TestExistsGrader) Grade(input GradeInput) GradeResult {
 	// Skip if not applicable
 	if !g.IsApplicable(input) {
 		skipReason := "No code files to check"
-		if input.TaskType == "chore" || input.TaskType == "spike" {
+		if !input.AnyTaskType && (input.TaskType == "chore" || input.TaskType == "spike") {
 			skipReason = fmt.Sprintf("Not applicable for %s tasks", input.TaskType)
 		}
 		return GradeResult{
-			GraderName: g.Name(),
-			Passed:     false,
-			Score:      0,
-			Details:    "",
-			Skipped:    true,
-			SkipReason: skipReason,
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    skipReason,
 		}
 	}
 
@@ -106,12 +130,13 @@ func (g *TestExistsGrader) Grade(input GradeInput) GradeResult {
 	}
 
 	return GradeResult{
-		GraderName: g.Name(),
-		Passed:     passed,
-		Score:      score,
-		Details:    details,
-		Skipped:    false,
-		SkipReason: "",
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        passed,
+		Score:         score,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
 	}
 }
 
@@ -0,0 +1,169 @@
+package codebased
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// defaultMinTestRatio is the default minimum ratio of test lines to source lines
+const defaultMinTestRatio = 0.5
+
+// TestRatioGrader checks that a change adds enough test code relative to production code
+type TestRatioGrader struct {
+	minRatio float64
+}
+
+func init() {
+	Register("test-ratio", func() CodeGrader { return NewTestRatioGrader() })
+}
+
+// NewTestRatioGrader creates a new TestRatioGrader with the default minimum ratio
+func NewTestRatioGrader() *TestRatioGrader {
+	return &TestRatioGrader{
+		minRatio: defaultMinTestRatio,
+	}
+}
+
+// WithMinRatio sets the minimum ratio of test lines to source lines required to pass
+func (g *TestRatioGrader) WithMinRatio(minRatio float64) *TestRatioGrader {
+	g.minRatio = minRatio
+	return g
+}
+
+// Name returns the grader name
+func (g *TestRatioGrader) Name() string {
+	return "test-ratio"
+}
+
+// Version returns the version of the grading logic, bumped when heuristics change
+func (g *TestRatioGrader) Version() string {
+	return "1.0.0"
+}
+
+// Describe returns what the grader checks and when it applies
+func (g *TestRatioGrader) Describe() GraderInfo {
+	return GraderInfo{
+		Description: "Checks that a change adds enough test lines relative to source lines",
+		TaskTypes:   []string{"feature", "bug"},
+		Files:       "Non-test .go, .py, .js, .jsx, .ts and .tsx files",
+	}
+}
+
+// IsApplicable returns true for feature/bug tasks that changed non-test source files
+func (g *TestRatioGrader) IsApplicable(input GradeInput) bool {
+	if !input.AnyTaskType && input.TaskType != "feature" && input.TaskType != "bug" {
+		return false
+	}
+
+	testExists := NewTestExistsGrader()
+	for _, file := range input.ChangedFiles {
+		if testExists.isCodeFile(file) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Grade sums the lines of changed test files and changed source files and compares their ratio to the minimum
+func (g *TestRatioGrader) Grade(input GradeInput) GradeResult {
+	// Skip if not applicable
+	if !g.IsApplicable(input) {
+		skipReason := "No source files to check"
+		if !input.AnyTaskType && input.TaskType != "feature" && input.TaskType != "bug" {
+			skipReason = fmt.Sprintf("Not applicable for %s tasks", input.TaskType)
+		}
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        false,
+			Score:         0,
+			Details:       "",
+			Skipped:       true,
+			SkipReason:    skipReason,
+		}
+	}
+
+	testExists := NewTestExistsGrader()
+	testLines := 0
+	sourceLines := 0
+
+	for _, file := range input.ChangedFiles {
+		isTest := testExists.isTestFile(file)
+		if !isTest && !testExists.isCodeFile(file) {
+			continue
+		}
+
+		// Resolve path relative to WorkDir if not absolute
+		filePath := file
+		if !filepath.IsAbs(file) {
+			filePath = filepath.Join(input.WorkDir, file)
+		}
+
+		lines, err := g.countLines(filePath)
+		if err != nil {
+			// Missing files are reported by file-exists
+			continue
+		}
+		if isTest {
+			testLines += lines
+		} else {
+			sourceLines += lines
+		}
+	}
+
+	if sourceLines == 0 {
+		return GradeResult{
+			GraderName:    g.Name(),
+			GraderVersion: g.Version(),
+			Passed:        true,
+			Score:         100,
+			Details:       fmt.Sprintf("No source lines to compare (%d test lines)", testLines),
+			Skipped:       false,
+			SkipReason:    "",
+		}
+	}
+
+	ratio := float64(testLines) / float64(sourceLines)
+	passed := ratio >= g.minRatio
+
+	// Score is the ratio as a share of the minimum, capped at 100
+	score := float64(100)
+	if g.minRatio > 0 && ratio < g.minRatio {
+		score = ratio / g.minRatio * 100
+	}
+
+	details := fmt.Sprintf("Test-to-code ratio %.2f (%d test lines / %d source lines), minimum %.2f",
+		ratio, testLines, sourceLines, g.minRatio)
+
+	return GradeResult{
+		GraderName:    g.Name(),
+		GraderVersion: g.Version(),
+		Passed:        passed,
+		Score:         score,
+		Details:       details,
+		Skipped:       false,
+		SkipReason:    "",
+	}
+}
+
+// countLines returns the number of non-blank lines in a file
+func (g *TestRatioGrader) countLines(filePath string) (int, error) {
+	content, err := readNormalizedFile(filePath)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) != "" {
+			count++
+		}
+	}
+
+	return count, scanner.Err()
+}
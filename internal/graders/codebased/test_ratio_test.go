@@ -0,0 +1,131 @@
+package codebased
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestTestRatioGraderInterface verifies TestRatioGrader implements CodeGrader
+func TestTestRatioGraderInterface(t *testing.T) {
+	var _ CodeGrader = (*TestRatioGrader)(nil)
+}
+
+// TestTestRatioGraderIsApplicable verifies applicability logic
+func TestTestRatioGraderIsApplicable(t *testing.T) {
+	grader := NewTestRatioGrader()
+
+	tests := []struct {
+		name     string
+		input    GradeInput
+		expected bool
+	}{
+		{
+			name:     "applicable for feature task with source files",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"main.go", "main_test.go"}},
+			expected: true,
+		},
+		{
+			name:     "applicable for bug task with source files",
+			input:    GradeInput{TaskType: "bug", ChangedFiles: []string{"app.py"}},
+			expected: true,
+		},
+		{
+			name:     "not applicable for chore tasks",
+			input:    GradeInput{TaskType: "chore", ChangedFiles: []string{"main.go"}},
+			expected: false,
+		},
+		{
+			name:     "not applicable when only test files changed",
+			input:    GradeInput{TaskType: "feature", ChangedFiles: []string{"main_test.go"}},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grader.IsApplicable(tt.input); got != tt.expected {
+				t.Errorf("IsApplicable() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// writeLines writes a file with n non-blank lines separated by blank lines
+func writeLines(t *testing.T, path string, n int) {
+	t.Helper()
+	var sb strings.Builder
+	for i := 0; i < n; i++ {
+		sb.WriteString("line\n\n")
+	}
+	if err := os.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestTestRatioGraderMeetsRatio verifies a change with enough test code passes
+func TestTestRatioGraderMeetsRatio(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeLines(t, filepath.Join(tmpDir, "handler.go"), 40)
+	writeLines(t, filepath.Join(tmpDir, "handler_test.go"), 30)
+
+	result := NewTestRatioGrader().Grade(GradeInput{
+		TaskType:     "feature",
+		ChangedFiles: []string{"handler.go", "handler_test.go", "README.md"},
+		WorkDir:      tmpDir,
+	})
+
+	if !result.Passed {
+		t.Errorf("Expected ratio 0.75 to pass, got: %s", result.Details)
+	}
+	if result.Score != 100 {
+		t.Errorf("Expected score 100, got %f", result.Score)
+	}
+	if !strings.Contains(result.Details, "ratio 0.75") || !strings.Contains(result.Details, "30 test lines / 40 source lines") {
+		t.Errorf("Expected computed ratio in details, got: %s", result.Details)
+	}
+}
+
+// TestTestRatioGraderBelowRatio verifies a change with too little test code fails
+func TestTestRatioGraderBelowRatio(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeLines(t, filepath.Join(tmpDir, "cart.js"), 100)
+	writeLines(t, filepath.Join(tmpDir, "cart.test.js"), 20)
+
+	result := NewTestRatioGrader().Grade(GradeInput{
+		TaskType:     "bug",
+		ChangedFiles: []string{"cart.js", "cart.test.js"},
+		WorkDir:      tmpDir,
+	})
+
+	if result.Passed {
+		t.Errorf("Expected ratio 0.20 to fail, got: %s", result.Details)
+	}
+	if result.Score < 39.9 || result.Score > 40.1 {
+		t.Errorf("Expected score 40 (0.20 of minimum 0.50), got %f", result.Score)
+	}
+	if !strings.Contains(result.Details, "ratio 0.20") || !strings.Contains(result.Details, "minimum 0.50") {
+		t.Errorf("Expected computed ratio and minimum in details, got: %s", result.Details)
+	}
+}
+
+// TestTestRatioGraderCustomMinimum verifies the minimum ratio is configurable
+func TestTestRatioGraderCustomMinimum(t *testing.T) {
+	tmpDir := t.TempDir()
+	writeLines(t, filepath.Join(tmpDir, "app.py"), 10)
+	writeLines(t, filepath.Join(tmpDir, "test_app.py"), 8)
+
+	input := GradeInput{
+		TaskType:     "feature",
+		ChangedFiles: []string{"app.py", "test_app.py"},
+		WorkDir:      tmpDir,
+	}
+
+	if result := NewTestRatioGrader().WithMinRatio(1.0).Grade(input); result.Passed {
+		t.Errorf("Expected ratio 0.80 to fail a 1.0 minimum, got: %s", result.Details)
+	}
+	if result := NewTestRatioGrader().WithMinRatio(0.8).Grade(input); !result.Passed {
+		t.Errorf("Expected ratio 0.80 to pass a 0.8 minimum, got: %s", result.Details)
+	}
+}
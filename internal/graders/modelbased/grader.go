@@ -1,10 +1,15 @@
 package modelbased
 
+// DefaultModel is the LLM model graders use unless another model is selected
+const DefaultModel = "claude-haiku-4"
+
 // Grader is the interface that all model-based graders must implement.
 // Model-based graders use LLM evaluation to assess content quality.
 type Grader interface {
 	// Grade evaluates the input content and returns a result with score and feedback
 	Grade(input GradeInput) (Result, error)
+	// Version returns the version of the grading logic, bumped when the rubric or prompt changes
+	Version() string
 }
 
 // GradeInput represents the input data to be graded
@@ -25,4 +30,6 @@ type Result struct {
 	Message string
 	// Details contains structured feedback for each evaluation criterion
 	Details map[string]any
+	// GraderVersion is the version of the grader that produced this result
+	GraderVersion string
 }
@@ -11,6 +11,35 @@ func TestGraderInterface(t *testing.T) {
 	var _ Grader = (*SpecComplianceGrader)(nil)
 }
 
+// TestGraderModelSelection verifies graders default to DefaultModel and accept another model
+func TestGraderModelSelection(t *testing.T) {
+	if got := NewSkillClarityGrader().Model(); got != DefaultModel {
+		t.Errorf("Expected skill clarity default model %q, got %q", DefaultModel, got)
+	}
+	if got := NewTaskQualityGrader().WithModel("claude-sonnet-4").Model(); got != "claude-sonnet-4" {
+		t.Errorf("Expected task quality model claude-sonnet-4, got %q", got)
+	}
+	if got := NewSpecComplianceGrader().WithModel("claude-opus-4").Model(); got != "claude-opus-4" {
+		t.Errorf("Expected spec compliance model claude-opus-4, got %q", got)
+	}
+}
+
+// TestGraderResultVersion verifies graders stamp their version on results
+func TestGraderResultVersion(t *testing.T) {
+	grader := NewSkillClarityGrader()
+	if grader.Version() == "" {
+		t.Fatal("Expected non-empty version")
+	}
+
+	result, err := grader.Grade(GradeInput{Content: "# Skill\n\n1. Run the command\n"})
+	if err != nil {
+		t.Fatalf("Grade failed: %v", err)
+	}
+	if result.GraderVersion != grader.Version() {
+		t.Errorf("Expected GraderVersion %q, got %q", grader.Version(), result.GraderVersion)
+	}
+}
+
 // TestResult verifies Result struct structure
 func TestResult(t *testing.T) {
 	result := Result{
@@ -0,0 +1,81 @@
+package modelbased
+
+import (
+	"math"
+	"sort"
+
+	"github.com/srstomp/kaizen/internal/metrics"
+)
+
+// selfConsistencyKey is the Details key holding how k LLM responses were aggregated
+const selfConsistencyKey = "self_consistency"
+
+// aggregateSelfConsistency combines the results of k LLM responses into one result.
+// Each criterion scores the median of its k scores and keeps the feedback of the response
+// closest to that median; the total is recomputed from the median scores with weights.
+// Details[selfConsistencyKey] records k, every response's total score and their spread.
+// Message and GraderVersion are left for the caller.
+func aggregateSelfConsistency(results []Result, weights map[string]float64, passingScore float64) Result {
+	criteriaNames := make([]string, 0, len(weights))
+	for criterion := range weights {
+		criteriaNames = append(criteriaNames, criterion)
+	}
+	sort.Strings(criteriaNames)
+
+	totalScore := 0.0
+	details := make(map[string]any)
+	for _, criterion := range criteriaNames {
+		scores := make([]float64, 0, len(results))
+		feedbacks := make([]string, 0, len(results))
+		for _, result := range results {
+			detail, ok := result.Details[criterion].(map[string]any)
+			if !ok {
+				continue
+			}
+			score, _ := detail["score"].(float64)
+			feedback, _ := detail["feedback"].(string)
+			scores = append(scores, score)
+			feedbacks = append(feedbacks, feedback)
+		}
+
+		median := metrics.Percentile(scores, 50)
+		feedback := ""
+		closest := math.Inf(1)
+		for i, score := range scores {
+			if distance := math.Abs(score - median); distance < closest {
+				closest = distance
+				feedback = feedbacks[i]
+			}
+		}
+
+		totalScore += median * weights[criterion]
+		details[criterion] = map[string]any{
+			"score":    median,
+			"feedback": feedback,
+			"weight":   weights[criterion],
+		}
+	}
+
+	totals := make([]float64, len(results))
+	for i, result := range results {
+		totals[i] = result.Score
+	}
+	minScore, maxScore := totals[0], totals[0]
+	for _, score := range totals[1:] {
+		minScore = math.Min(minScore, score)
+		maxScore = math.Max(maxScore, score)
+	}
+	details[selfConsistencyKey] = map[string]any{
+		"k":      len(results),
+		"scores": totals,
+		"min":    minScore,
+		"max":    maxScore,
+		"spread": maxScore - minScore,
+	}
+
+	return Result{
+		Passed:  totalScore >= passingScore,
+		Score:   totalScore,
+		Details: details,
+	}
+}
@@ -0,0 +1,151 @@
+package modelbased
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/srstomp/kaizen/internal/llm"
+)
+
+// rotatingLLMClient returns the queued responses in turn
+type rotatingLLMClient struct {
+	responses []string
+	calls     int
+}
+
+func (m *rotatingLLMClient) Complete(ctx context.Context, prompt string, options ...llm.CompletionOption) (string, error) {
+	response := m.responses[m.calls%len(m.responses)]
+	m.calls++
+	return response, nil
+}
+
+// uniformSkillClarityResponse scores every skill clarity criterion with score
+func uniformSkillClarityResponse(score int) string {
+	return fmt.Sprintf(`CLEAR_INSTRUCTIONS: %[1]d
+CLEAR_INSTRUCTIONS_FEEDBACK: Instructions scored %[1]d
+ACTIONABLE_STEPS: %[1]d
+ACTIONABLE_STEPS_FEEDBACK: Steps scored %[1]d
+GOOD_EXAMPLES: %[1]d
+GOOD_EXAMPLES_FEEDBACK: Examples scored %[1]d
+APPROPRIATE_SCOPE: %[1]d
+APPROPRIATE_SCOPE_FEEDBACK: Scope scored %[1]d`, score)
+}
+
+// uniformTaskQualityResponse scores every task quality criterion with score
+func uniformTaskQualityResponse(score int) string {
+	return fmt.Sprintf(`CLARITY: %[1]d
+CLARITY_FEEDBACK: Clarity scored %[1]d
+ACCEPTANCE: %[1]d
+ACCEPTANCE_FEEDBACK: Acceptance scored %[1]d
+SCOPE: %[1]d
+SCOPE_FEEDBACK: Scope scored %[1]d
+ACTIONABILITY: %[1]d
+ACTIONABILITY_FEEDBACK: Actionability scored %[1]d`, score)
+}
+
+// assertSelfConsistency checks that result uses the median of scores 20, 85 and 80, which
+// passes where the mean (61.7) would fail, and records the spread of the three responses
+func assertSelfConsistency(t *testing.T, result Result, feedbackCriterion string) {
+	t.Helper()
+
+	if result.Score != 80 {
+		t.Errorf("Expected median score 80, got %.1f", result.Score)
+	}
+	if !result.Passed {
+		t.Error("Expected median score to pass")
+	}
+	if !strings.Contains(result.Message, "over 3 responses") {
+		t.Errorf("Expected message to mention the responses, got %q", result.Message)
+	}
+
+	detail := result.Details[feedbackCriterion].(map[string]any)
+	if detail["score"] != 80.0 {
+		t.Errorf("Expected %s median score 80, got %v", feedbackCriterion, detail["score"])
+	}
+	if feedback := detail["feedback"].(string); !strings.HasSuffix(feedback, "scored 80") {
+		t.Errorf("Expected feedback from the median response, got %q", feedback)
+	}
+
+	consistency, ok := result.Details["self_consistency"].(map[string]any)
+	if !ok {
+		t.Fatalf("Expected self_consistency details, got %v", result.Details)
+	}
+	if consistency["k"] != 3 {
+		t.Errorf("Expected k 3, got %v", consistency["k"])
+	}
+	if consistency["min"] != 20.0 || consistency["max"] != 85.0 || consistency["spread"] != 65.0 {
+		t.Errorf("Expected min 20, max 85, spread 65, got %v", consistency)
+	}
+	if scores := consistency["scores"].([]float64); len(scores) != 3 {
+		t.Errorf("Expected 3 response scores, got %v", scores)
+	}
+}
+
+func TestSkillClarityGrader_SelfConsistency(t *testing.T) {
+	client := &rotatingLLMClient{responses: []string{
+		uniformSkillClarityResponse(20),
+		uniformSkillClarityResponse(85),
+		uniformSkillClarityResponse(80),
+	}}
+	grader := NewSkillClarityGrader().WithLLMClient(client).WithSelfConsistency(3)
+
+	result, err := grader.Grade(GradeInput{Content: "# Skill"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.calls != 3 {
+		t.Errorf("Expected 3 LLM calls, got %d", client.calls)
+	}
+	assertSelfConsistency(t, result, "clear_instructions")
+	if result.GraderVersion != grader.Version() {
+		t.Errorf("Expected grader version %q, got %q", grader.Version(), result.GraderVersion)
+	}
+}
+
+func TestTaskQualityGrader_SelfConsistency(t *testing.T) {
+	client := &rotatingLLMClient{responses: []string{
+		uniformTaskQualityResponse(20),
+		uniformTaskQualityResponse(85),
+		uniformTaskQualityResponse(80),
+	}}
+	grader := NewTaskQualityGrader().WithSelfConsistency(3)
+	grader.llmClient = client
+
+	result, err := grader.gradeWithLLM("# Test Task")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.calls != 3 {
+		t.Errorf("Expected 3 LLM calls, got %d", client.calls)
+	}
+	assertSelfConsistency(t, result, "clarity")
+}
+
+func TestSelfConsistency_SingleResponse(t *testing.T) {
+	client := &rotatingLLMClient{responses: []string{validSkillClarityResponse}}
+	grader := NewSkillClarityGrader().WithLLMClient(client).WithSelfConsistency(0)
+
+	result, err := grader.Grade(GradeInput{Content: "# Skill"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.calls != 1 {
+		t.Errorf("Expected 1 LLM call, got %d", client.calls)
+	}
+	if _, ok := result.Details["self_consistency"]; ok {
+		t.Error("Expected no self_consistency details for a single response")
+	}
+}
+
+func TestSelfConsistency_ResponseError(t *testing.T) {
+	client := &rotatingLLMClient{responses: []string{uniformTaskQualityResponse(80), "not a rubric"}}
+	grader := NewTaskQualityGrader().WithSelfConsistency(3)
+	grader.llmClient = client
+
+	_, err := grader.gradeWithLLM("# Test Task")
+	if err == nil || !strings.Contains(err.Error(), "self-consistency response 2/3") {
+		t.Errorf("Expected error naming the failed response, got %v", err)
+	}
+}
@@ -1,8 +1,14 @@
 package modelbased
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/llm"
 )
 
 // SkillClarityGrader evaluates skill documentation against clarity criteria
@@ -11,6 +17,16 @@ type SkillClarityGrader struct {
 	weights map[string]float64
 	// Passing threshold (0-100)
 	passingScore float64
+	// LLM client for evaluation (optional, uses heuristic evaluation if nil)
+	llmClient llm.Client
+	// Timeout for LLM requests
+	timeout time.Duration
+	// LLM model used for evaluation
+	model string
+	// Number of LLM responses aggregated per evaluation (1 disables self-consistency)
+	selfConsistency int
+	// Custom rubric sent as the system prompt, replacing the built-in criteria descriptions
+	rubric string
 }
 
 // Criterion represents a single evaluation criterion with its score and feedback
@@ -28,13 +44,83 @@ func NewSkillClarityGrader() *SkillClarityGrader {
 			"good_examples":      0.25, // 25% - Are examples helpful and realistic?
 			"appropriate_scope":  0.20, // 20% - Is the skill focused, not too broad/narrow?
 		},
-		passingScore: 70.0, // Default passing threshold
+		passingScore: 70.0,             // Default passing threshold
+		timeout:      60 * time.Second, // Default timeout
+		model:        DefaultModel,
+
+		selfConsistency: 1,
+	}
+}
+
+// WithLLMClient makes the grader evaluate skills with the given LLM client.
+// A nil client keeps the heuristic evaluation.
+func (g *SkillClarityGrader) WithLLMClient(client llm.Client) *SkillClarityGrader {
+	g.llmClient = client
+	return g
+}
+
+// WithPassingScore sets the minimum weighted score (0-100) for a skill to pass
+func (g *SkillClarityGrader) WithPassingScore(score float64) *SkillClarityGrader {
+	g.passingScore = score
+	return g
+}
+
+// PassingScore returns the minimum weighted score for a skill to pass
+func (g *SkillClarityGrader) PassingScore() float64 {
+	return g.passingScore
+}
+
+// WithModel sets the LLM model used for evaluation
+func (g *SkillClarityGrader) WithModel(model string) *SkillClarityGrader {
+	g.model = model
+	return g
+}
+
+// WithSelfConsistency makes LLM evaluation request k responses and use the median score of
+// each criterion, recording the spread of the k scores in the result details. k <= 1 uses a single response.
+func (g *SkillClarityGrader) WithSelfConsistency(k int) *SkillClarityGrader {
+	if k < 1 {
+		k = 1
 	}
+	g.selfConsistency = k
+	return g
+}
+
+// WithRubric makes LLM evaluation grade against the given rubric, sent as the system prompt,
+// instead of the built-in criteria descriptions. Scores are still reported per built-in criterion.
+// Heuristic evaluation ignores the rubric; an empty rubric restores the built-in criteria.
+func (g *SkillClarityGrader) WithRubric(rubric string) *SkillClarityGrader {
+	g.rubric = rubric
+	return g
+}
+
+// UsesRubric reports whether LLM evaluation grades against a custom rubric
+func (g *SkillClarityGrader) UsesRubric() bool {
+	return g.rubric != ""
+}
+
+// Model returns the LLM model used for evaluation
+func (g *SkillClarityGrader) Model() string {
+	return g.model
+}
+
+// UsesLLM reports whether the grader evaluates skills with an LLM rather than heuristics
+func (g *SkillClarityGrader) UsesLLM() bool {
+	return g.llmClient != nil
 }
 
 // Grade evaluates skill content against clarity criteria
 func (g *SkillClarityGrader) Grade(input GradeInput) (Result, error) {
-	// Stub implementation - will be replaced with LLM-based evaluation
+	return g.GradeContext(context.Background(), input)
+}
+
+// GradeContext evaluates skill content against clarity criteria, abandoning the LLM request when ctx is done
+func (g *SkillClarityGrader) GradeContext(ctx context.Context, input GradeInput) (Result, error) {
+	if g.llmClient != nil {
+		return g.gradeWithLLM(ctx, input.Content)
+	}
+
+	// Heuristic fallback when no LLM client is configured
 	criteria := g.evaluateCriteria(input.Content)
 
 	// Calculate weighted score
@@ -58,19 +144,33 @@ func (g *SkillClarityGrader) Grade(input GradeInput) (Result, error) {
 	message := g.generateMessage(totalScore, criteria)
 
 	return Result{
-		Passed:  totalScore >= g.passingScore,
-		Score:   totalScore,
-		Message: message,
-		Details: details,
+		Passed:        totalScore >= g.passingScore,
+		Score:         totalScore,
+		Message:       message,
+		Details:       details,
+		GraderVersion: g.Version(),
 	}, nil
 }
 
-// evaluateCriteria performs stub evaluation of each criterion
-// TODO: Replace with LLM-based evaluation
-func (g *SkillClarityGrader) evaluateCriteria(content string) map[string]Criterion {
-	// Stub implementation using basic heuristics
-	// This will be replaced with LLM calls in the future
+// Skill clarity grading logic versions. LLM grading and the heuristic fallback are unrelated
+// scorers, so each has its own version and trends never compare one against the other.
+const (
+	skillClarityHeuristicVersion = "1.0.0"
+	skillClarityLLMVersion       = "2.0.0"
+)
 
+// Version returns the version of the grading logic in use: the LLM version when an LLM client
+// is configured, otherwise the heuristic fallback's version
+func (g *SkillClarityGrader) Version() string {
+	if g.llmClient != nil {
+		return skillClarityLLMVersion
+	}
+	return skillClarityHeuristicVersion
+}
+
+// evaluateCriteria performs heuristic evaluation of each criterion, used when no LLM client is configured
+func (g *SkillClarityGrader) evaluateCriteria(content string) map[string]Criterion {
+	// Keyword heuristics only; gradeWithLLM does the real evaluation when a client is configured
 	criteria := make(map[string]Criterion)
 
 	// Clear Instructions - check for instruction markers
@@ -149,7 +249,7 @@ func (g *SkillClarityGrader) evaluateCriteria(content string) map[string]Criteri
 // generateMessage creates a human-readable summary message
 func (g *SkillClarityGrader) generateMessage(score float64, criteria map[string]Criterion) string {
 	if score >= g.passingScore {
-		return fmt.Sprintf("Skill clarity evaluation passed with score %.1f/100. Note: Using stub evaluation; LLM-based grading not yet implemented.", score)
+		return fmt.Sprintf("Skill clarity evaluation passed with score %.1f/100. Note: Graded by heuristic evaluation (no LLM client configured).", score)
 	}
 
 	// Find weakest criterion
@@ -162,6 +262,185 @@ func (g *SkillClarityGrader) generateMessage(score float64, criteria map[string]
 		}
 	}
 
-	return fmt.Sprintf("Skill clarity evaluation failed with score %.1f/100. Weakest area: %s (%.1f). Note: Using stub evaluation; LLM-based grading not yet implemented.",
+	return fmt.Sprintf("Skill clarity evaluation failed with score %.1f/100. Weakest area: %s (%.1f). Note: Graded by heuristic evaluation (no LLM client configured).",
 		score, weakestName, weakestScore)
 }
+
+// builtinCriteriaPrompt describes the built-in criteria the LLM grades against without a custom rubric
+const builtinCriteriaPrompt = `Evaluate the skill against these four criteria:
+
+1. CLEAR_INSTRUCTIONS (30% weight): Are the instructions unambiguous?
+2. ACTIONABLE_STEPS (25% weight): Are the steps concrete and executable?
+3. GOOD_EXAMPLES (25% weight): Are the examples helpful and realistic?
+4. APPROPRIATE_SCOPE (20% weight): Is the skill focused, neither too broad nor too narrow?`
+
+// rubricCriteriaPrompt asks the LLM to apply the custom rubric in the system prompt
+// to the four criteria the response is parsed into
+const rubricCriteriaPrompt = `Evaluate the skill against the rubric in the system prompt. Apply the rubric's
+guidance to each of these four criteria: CLEAR_INSTRUCTIONS, ACTIONABLE_STEPS, GOOD_EXAMPLES and APPROPRIATE_SCOPE.`
+
+// buildSystemPrompt returns the system prompt carrying the custom rubric, or "" without one
+func (g *SkillClarityGrader) buildSystemPrompt() string {
+	if g.rubric == "" {
+		return ""
+	}
+	return "You grade skill documents that instruct an AI coding agent. Grade strictly against this rubric:\n\n" + g.rubric
+}
+
+// buildPrompt constructs the LLM prompt for skill clarity evaluation
+func (g *SkillClarityGrader) buildPrompt(skillContent string) string {
+	criteria := builtinCriteriaPrompt
+	if g.rubric != "" {
+		criteria = rubricCriteriaPrompt
+	}
+
+	return fmt.Sprintf(`You are evaluating the clarity of a skill document that instructs an AI coding agent.
+
+## Skill to Evaluate
+%s
+
+%s
+
+For each criterion, provide:
+- A score from 0-100
+- Brief feedback explaining the score
+
+Respond in this exact format:
+CLEAR_INSTRUCTIONS: <score 0-100>
+CLEAR_INSTRUCTIONS_FEEDBACK: <brief explanation>
+ACTIONABLE_STEPS: <score 0-100>
+ACTIONABLE_STEPS_FEEDBACK: <brief explanation>
+GOOD_EXAMPLES: <score 0-100>
+GOOD_EXAMPLES_FEEDBACK: <brief explanation>
+APPROPRIATE_SCOPE: <score 0-100>
+APPROPRIATE_SCOPE_FEEDBACK: <brief explanation>`, skillContent, criteria)
+}
+
+// parseResponse parses the LLM response to extract scores and feedback for each criterion
+func (g *SkillClarityGrader) parseResponse(response string) (Result, error) {
+	if strings.TrimSpace(response) == "" {
+		return Result{}, errors.New("LLM returned empty response")
+	}
+
+	scores := make(map[string]float64)
+	feedbacks := make(map[string]string)
+
+	criteriaNames := []string{"clear_instructions", "actionable_steps", "good_examples", "appropriate_scope"}
+
+	for _, line := range strings.Split(response, "\n") {
+		line = strings.TrimSpace(line)
+
+		for _, criterion := range criteriaNames {
+			upper := strings.ToUpper(criterion)
+
+			// Parse score
+			if strings.HasPrefix(line, upper+":") {
+				scoreStr := strings.TrimSpace(strings.TrimPrefix(line, upper+":"))
+				score, err := strconv.ParseFloat(scoreStr, 64)
+				if err != nil {
+					return Result{}, fmt.Errorf("invalid %s score: %s (must be a number)", upper, scoreStr)
+				}
+				if score < 0 || score > 100 {
+					return Result{}, fmt.Errorf("invalid %s score: %.1f (must be between 0 and 100)", upper, score)
+				}
+				scores[criterion] = score
+			}
+
+			// Parse feedback
+			feedbackPrefix := upper + "_FEEDBACK:"
+			if strings.HasPrefix(line, feedbackPrefix) {
+				feedbacks[criterion] = strings.TrimSpace(strings.TrimPrefix(line, feedbackPrefix))
+			}
+		}
+	}
+
+	// Validate all required fields are present
+	for _, criterion := range criteriaNames {
+		if _, exists := scores[criterion]; !exists {
+			return Result{}, fmt.Errorf("missing %s score in LLM response", strings.ToUpper(criterion))
+		}
+		if _, exists := feedbacks[criterion]; !exists {
+			return Result{}, fmt.Errorf("missing %s feedback in LLM response", strings.ToUpper(criterion))
+		}
+	}
+
+	// Calculate weighted total score and build details
+	totalScore := 0.0
+	details := make(map[string]any)
+	for _, criterion := range criteriaNames {
+		totalScore += scores[criterion] * g.weights[criterion]
+		details[criterion] = map[string]any{
+			"score":    scores[criterion],
+			"feedback": feedbacks[criterion],
+			"weight":   g.weights[criterion],
+		}
+	}
+
+	passed := totalScore >= g.passingScore
+	message := fmt.Sprintf("Skill clarity evaluation: %s (score: %.1f/100). Graded by LLM evaluation.",
+		map[bool]string{true: "PASS", false: "FAIL"}[passed], totalScore)
+
+	return Result{
+		Passed:        passed,
+		Score:         totalScore,
+		Message:       message,
+		Details:       details,
+		GraderVersion: g.Version(),
+	}, nil
+}
+
+// gradeWithLLM performs LLM-based evaluation, aggregating several responses when self-consistency is enabled
+func (g *SkillClarityGrader) gradeWithLLM(parent context.Context, skillContent string) (Result, error) {
+	if g.llmClient == nil {
+		return Result{}, errors.New("LLM client not initialized")
+	}
+	if g.selfConsistency <= 1 {
+		return g.gradeOnceWithLLM(parent, skillContent)
+	}
+
+	results := make([]Result, 0, g.selfConsistency)
+	for i := 0; i < g.selfConsistency; i++ {
+		result, err := g.gradeOnceWithLLM(parent, skillContent)
+		if err != nil {
+			return Result{}, fmt.Errorf("self-consistency response %d/%d: %w", i+1, g.selfConsistency, err)
+		}
+		results = append(results, result)
+	}
+
+	result := aggregateSelfConsistency(results, g.weights, g.passingScore)
+	result.Message = fmt.Sprintf("Skill clarity evaluation: %s (median score: %.1f/100 over %d responses). Graded by LLM evaluation.",
+		map[bool]string{true: "PASS", false: "FAIL"}[result.Passed], result.Score, len(results))
+	result.GraderVersion = g.Version()
+	return result, nil
+}
+
+// gradeOnceWithLLM evaluates the skill with a single LLM response
+func (g *SkillClarityGrader) gradeOnceWithLLM(parent context.Context, skillContent string) (Result, error) {
+	// Build prompt
+	prompt := g.buildPrompt(skillContent)
+
+	// Create context with timeout
+	ctx, cancel := context.WithTimeout(parent, g.timeout)
+	defer cancel()
+
+	// Call LLM
+	options := []llm.CompletionOption{llm.WithModel(g.model)}
+	if system := g.buildSystemPrompt(); system != "" {
+		options = append(options, llm.WithSystemPrompt(system))
+	}
+	response, err := g.llmClient.Complete(ctx, prompt, options...)
+	if err != nil {
+		// The caller's context ending is reported as-is rather than as this request's timeout
+		if parent.Err() != nil {
+			return Result{}, fmt.Errorf("LLM request cancelled: %w", context.Cause(parent))
+		}
+		// Handle timeout specifically
+		if errors.Is(err, context.DeadlineExceeded) {
+			return Result{}, fmt.Errorf("LLM request timed out after %v: %w", g.timeout, err)
+		}
+		return Result{}, fmt.Errorf("LLM request failed: %w", err)
+	}
+
+	// Parse response
+	return g.parseResponse(response)
+}
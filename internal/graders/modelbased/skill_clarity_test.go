@@ -1,7 +1,12 @@
 package modelbased
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/srstomp/kaizen/internal/llm"
 )
 
 func TestSkillClarityGrader_New(t *testing.T) {
@@ -128,3 +133,201 @@ Focused and clear.
 		}
 	}
 }
+
+const validSkillClarityResponse = `CLEAR_INSTRUCTIONS: 90
+CLEAR_INSTRUCTIONS_FEEDBACK: Instructions are unambiguous
+ACTIONABLE_STEPS: 80
+ACTIONABLE_STEPS_FEEDBACK: Steps are concrete
+GOOD_EXAMPLES: 70
+GOOD_EXAMPLES_FEEDBACK: Examples could be more realistic
+APPROPRIATE_SCOPE: 60
+APPROPRIATE_SCOPE_FEEDBACK: Scope is somewhat broad`
+
+func TestSkillClarityGrader_BuildPrompt(t *testing.T) {
+	grader := NewSkillClarityGrader()
+	prompt := grader.buildPrompt("# My Skill\nDo the thing.")
+
+	for _, want := range []string{
+		"# My Skill",
+		"CLEAR_INSTRUCTIONS",
+		"ACTIONABLE_STEPS",
+		"GOOD_EXAMPLES",
+		"APPROPRIATE_SCOPE",
+		"APPROPRIATE_SCOPE_FEEDBACK",
+	} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected prompt to contain %q", want)
+		}
+	}
+}
+
+// recordingLLMClient returns response and records the prompts it was sent
+type recordingLLMClient struct {
+	response     string
+	prompt       string
+	systemPrompt string
+}
+
+func (m *recordingLLMClient) Complete(ctx context.Context, prompt string, options ...llm.CompletionOption) (string, error) {
+	m.prompt = prompt
+	m.systemPrompt = llm.SystemPrompt(options...)
+	return m.response, nil
+}
+
+func TestSkillClarityGrader_Rubric(t *testing.T) {
+	rubric := "# Team Rubric\n\nInstructions must name the exact CLI command to run."
+
+	client := &recordingLLMClient{response: validSkillClarityResponse}
+	grader := NewSkillClarityGrader().WithLLMClient(client).WithRubric(rubric)
+	if !grader.UsesRubric() {
+		t.Error("Expected UsesRubric to be true")
+	}
+
+	result, err := grader.Grade(GradeInput{Content: "# My Skill"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(client.systemPrompt, rubric) {
+		t.Errorf("Expected rubric in system prompt, got %q", client.systemPrompt)
+	}
+	if strings.Contains(client.prompt, "Are the instructions unambiguous?") {
+		t.Errorf("Expected rubric to replace the built-in criteria, got prompt:\n%s", client.prompt)
+	}
+	if !strings.Contains(client.prompt, "# My Skill") || !strings.Contains(client.prompt, "APPROPRIATE_SCOPE_FEEDBACK:") {
+		t.Errorf("Expected skill and response format in prompt, got:\n%s", client.prompt)
+	}
+	if result.Score < 76.49 || result.Score > 76.51 {
+		t.Errorf("Expected score 76.5 from the built-in weights, got %.2f", result.Score)
+	}
+
+	// Without a rubric there is no system prompt and the built-in criteria are used
+	client = &recordingLLMClient{response: validSkillClarityResponse}
+	if _, err := NewSkillClarityGrader().WithLLMClient(client).Grade(GradeInput{Content: "# My Skill"}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if client.systemPrompt != "" || !strings.Contains(client.prompt, "Are the instructions unambiguous?") {
+		t.Errorf("Expected built-in criteria without a system prompt, got system %q", client.systemPrompt)
+	}
+}
+
+func TestSkillClarityGrader_ParseResponse(t *testing.T) {
+	grader := NewSkillClarityGrader()
+
+	result, err := grader.parseResponse(validSkillClarityResponse)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 90*0.30 + 80*0.25 + 70*0.25 + 60*0.20 = 76.5
+	if result.Score < 76.49 || result.Score > 76.51 {
+		t.Errorf("Expected score 76.5, got %.2f", result.Score)
+	}
+	if !result.Passed {
+		t.Error("Expected result to pass")
+	}
+
+	scope, ok := result.Details["appropriate_scope"].(map[string]any)
+	if !ok {
+		t.Fatal("Expected appropriate_scope details")
+	}
+	if scope["feedback"] != "Scope is somewhat broad" {
+		t.Errorf("Unexpected feedback: %v", scope["feedback"])
+	}
+}
+
+func TestSkillClarityGrader_ParseResponse_Invalid(t *testing.T) {
+	grader := NewSkillClarityGrader()
+
+	tests := []struct {
+		name     string
+		response string
+		wantErr  string
+	}{
+		{"empty", "", "empty response"},
+		{"out of range", strings.Replace(validSkillClarityResponse, "GOOD_EXAMPLES: 70", "GOOD_EXAMPLES: 150", 1), "between 0 and 100"},
+		{"not a number", strings.Replace(validSkillClarityResponse, "GOOD_EXAMPLES: 70", "GOOD_EXAMPLES: high", 1), "must be a number"},
+		{"missing score", strings.Replace(validSkillClarityResponse, "APPROPRIATE_SCOPE: 60\n", "", 1), "missing APPROPRIATE_SCOPE score"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := grader.parseResponse(tt.response)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSkillClarityGrader_GradeWithLLM(t *testing.T) {
+	grader := NewSkillClarityGrader().WithLLMClient(&mockLLMClient{response: validSkillClarityResponse})
+
+	if !grader.UsesLLM() {
+		t.Error("Expected grader to use LLM when a client is set")
+	}
+
+	result, err := grader.Grade(GradeInput{Content: "# Skill"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(result.Message, "Graded by LLM") {
+		t.Errorf("Expected message to mention LLM grading, got %q", result.Message)
+	}
+	if result.GraderVersion != grader.Version() {
+		t.Errorf("Expected grader version %q, got %q", grader.Version(), result.GraderVersion)
+	}
+	if heuristic := NewSkillClarityGrader().Version(); result.GraderVersion == heuristic {
+		t.Errorf("Expected LLM grading to report a different version than the heuristic fallback, both are %q", heuristic)
+	}
+}
+
+func TestSkillClarityGrader_GradeWithLLM_Timeout(t *testing.T) {
+	grader := NewSkillClarityGrader().WithLLMClient(&mockLLMClient{simulateTimeout: true})
+	grader.timeout = 50 * time.Millisecond
+
+	_, err := grader.Grade(GradeInput{Content: "# Skill"})
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Expected timeout error, got %v", err)
+	}
+}
+
+func TestSkillClarityGrader_WithPassingScore(t *testing.T) {
+	// validSkillClarityResponse scores 76.5 overall
+	client := &mockLLMClient{response: validSkillClarityResponse}
+
+	result, err := NewSkillClarityGrader().WithLLMClient(client).Grade(GradeInput{Content: "# Skill"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.Passed {
+		t.Errorf("Expected score %.1f to pass the default threshold", result.Score)
+	}
+
+	grader := NewSkillClarityGrader().WithLLMClient(client).WithPassingScore(85)
+	if grader.PassingScore() != 85 {
+		t.Errorf("Expected passing score 85, got %.1f", grader.PassingScore())
+	}
+	result, err = grader.Grade(GradeInput{Content: "# Skill"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Passed {
+		t.Errorf("Expected score %.1f to fail a passing score of 85", result.Score)
+	}
+}
+
+func TestSkillClarityGrader_HeuristicFallback(t *testing.T) {
+	grader := NewSkillClarityGrader().WithLLMClient(nil)
+
+	if grader.UsesLLM() {
+		t.Error("Expected heuristic evaluation without an LLM client")
+	}
+
+	result, err := grader.Grade(GradeInput{Content: "# Skill\n\n## Instructions\n1. Do this"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(result.Message, "heuristic") {
+		t.Errorf("Expected message to mention heuristic evaluation, got %q", result.Message)
+	}
+}
@@ -15,6 +15,7 @@ import (
 type SpecComplianceGrader struct {
 	llmClient llm.Client
 	timeout   time.Duration
+	model     string
 }
 
 // NewSpecComplianceGrader creates a new spec compliance grader
@@ -23,9 +24,28 @@ func NewSpecComplianceGrader() *SpecComplianceGrader {
 	return &SpecComplianceGrader{
 		llmClient: nil, // Will be set when LLM integration is needed
 		timeout:   60 * time.Second,
+		model:     DefaultModel,
 	}
 }
 
+// WithLLMClient makes the grader evaluate specs with the given LLM client.
+// A nil client keeps the stub evaluation.
+func (g *SpecComplianceGrader) WithLLMClient(client llm.Client) *SpecComplianceGrader {
+	g.llmClient = client
+	return g
+}
+
+// WithModel sets the LLM model used for evaluation
+func (g *SpecComplianceGrader) WithModel(model string) *SpecComplianceGrader {
+	g.model = model
+	return g
+}
+
+// Model returns the LLM model used for evaluation
+func (g *SpecComplianceGrader) Model() string {
+	return g.model
+}
+
 // Grade evaluates if the implementation matches the specification
 func (g *SpecComplianceGrader) Grade(input GradeInput) (Result, error) {
 	// Validate inputs
@@ -40,6 +60,11 @@ func (g *SpecComplianceGrader) Grade(input GradeInput) (Result, error) {
 	return g.stubEvaluate(spec, input.Content)
 }
 
+// Version returns the version of the grading logic
+func (g *SpecComplianceGrader) Version() string {
+	return "1.0.0"
+}
+
 // validateInput checks that the input has required fields
 func (g *SpecComplianceGrader) validateInput(input GradeInput) error {
 	// Check for empty content
@@ -103,6 +128,7 @@ func (g *SpecComplianceGrader) stubEvaluate(spec, diff string) (Result, error) {
 			"reasoning": reasoning,
 			"note":      "Stub evaluation - LLM integration pending",
 		},
+		GraderVersion: g.Version(),
 	}, nil
 }
 
@@ -191,10 +217,11 @@ func (g *SpecComplianceGrader) parseResponse(response string) (Result, error) {
 	}
 
 	return Result{
-		Passed:  passed,
-		Score:   score,
-		Message: message,
-		Details: details,
+		Passed:        passed,
+		Score:         score,
+		Message:       message,
+		Details:       details,
+		GraderVersion: g.Version(),
 	}, nil
 }
 
@@ -213,7 +240,7 @@ func (g *SpecComplianceGrader) gradeWithLLM(spec, diff string) (Result, error) {
 	defer cancel()
 
 	// Call LLM
-	response, err := g.llmClient.Complete(ctx, prompt, llm.WithModel("claude-haiku-4"))
+	response, err := g.llmClient.Complete(ctx, prompt, llm.WithModel(g.model))
 	if err != nil {
 		// Handle timeout specifically
 		if errors.Is(err, context.DeadlineExceeded) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -11,16 +13,47 @@ import (
 	"github.com/srstomp/kaizen/internal/llm"
 )
 
+// defaultVagueScopeKeywords are the words that mark a task's scope as open-ended
+var defaultVagueScopeKeywords = []string{"various", "several", "improve", "better", "make it"}
+
+// defaultAcceptanceMarkers are the headings that introduce an acceptance-criteria section
+var defaultAcceptanceMarkers = []string{
+	"acceptance criteria",
+	"success criteria",
+	"requirements:",
+	"definition of done",
+	"done when",
+	"ac:",
+}
+
+// measurableTargetPattern matches a number later in the same sentence as a vague keyword,
+// e.g. "improve p95 latency to under 200ms", which makes the keyword acceptable
+var measurableTargetPattern = regexp.MustCompile(`^[^.!?\n]*\d`)
+
 // TaskQualityGrader evaluates task specifications against quality criteria
 type TaskQualityGrader struct {
 	// Criteria weights for evaluation
 	weights map[string]float64
 	// Passing threshold (0-100)
 	passingScore float64
+	// Minimum score per criterion; a criterion below its floor fails the task whatever the total
+	criterionFloors map[string]float64
 	// LLM client for evaluation (optional, uses stub evaluation if nil)
 	llmClient llm.Client
-	// Timeout for LLM requests
+	// Timeout for LLM requests, including retries
 	timeout time.Duration
+	// Retries for transient LLM errors such as rate limits
+	maxRetries int
+	// Backoff before the first retry; it doubles for each further retry
+	baseBackoff time.Duration
+	// LLM model used for evaluation
+	model string
+	// Number of LLM responses aggregated per evaluation (1 disables self-consistency)
+	selfConsistency int
+	// Keywords that mark the scope as vague in stub evaluation
+	vagueScopeKeywords []string
+	// Headings that mark an acceptance-criteria section in stub evaluation
+	acceptanceMarkers []string
 }
 
 // NewTaskQualityGrader creates a new task quality grader with default weights
@@ -35,7 +68,160 @@ func NewTaskQualityGrader() *TaskQualityGrader {
 		passingScore: 70.0,          // Default passing threshold
 		llmClient:    nil,            // Will be set when LLM integration is needed
 		timeout:      60 * time.Second, // Default timeout
+		maxRetries:   2,
+		baseBackoff:  time.Second,
+		model:        DefaultModel,
+
+		vagueScopeKeywords: defaultVagueScopeKeywords,
+		acceptanceMarkers:  defaultAcceptanceMarkers,
+		selfConsistency:    1,
+	}
+}
+
+// WithLLMClient makes the grader evaluate tasks with the given LLM client.
+// A nil client keeps the stub evaluation.
+func (g *TaskQualityGrader) WithLLMClient(client llm.Client) *TaskQualityGrader {
+	g.llmClient = client
+	return g
+}
+
+// WithAcceptanceMarkers replaces the headings recognized as an acceptance-criteria section in
+// stub evaluation, e.g. "Definition of Done". Matching is case-insensitive and starts at a word boundary.
+func (g *TaskQualityGrader) WithAcceptanceMarkers(markers []string) *TaskQualityGrader {
+	g.acceptanceMarkers = make([]string, 0, len(markers))
+	for _, marker := range markers {
+		if marker = strings.ToLower(strings.TrimSpace(marker)); marker != "" {
+			g.acceptanceMarkers = append(g.acceptanceMarkers, marker)
+		}
+	}
+	return g
+}
+
+// hasAcceptanceSection reports whether contentLower contains an acceptance marker at a word boundary,
+// so short markers like "ac:" don't match inside other words
+func (g *TaskQualityGrader) hasAcceptanceSection(contentLower string) bool {
+	for _, marker := range g.acceptanceMarkers {
+		offset := 0
+		for {
+			idx := strings.Index(contentLower[offset:], marker)
+			if idx < 0 {
+				break
+			}
+			start := offset + idx
+			if start == 0 || !isWordByte(contentLower[start-1]) {
+				return true
+			}
+			offset = start + 1
+		}
+	}
+	return false
+}
+
+// isWordByte reports whether b is an ASCII letter or digit
+func isWordByte(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+// WithVagueScopeKeywords replaces the keywords that mark a task's scope as vague in stub evaluation.
+// Matching is case-insensitive; a keyword followed by a number in the same sentence is not penalized.
+func (g *TaskQualityGrader) WithVagueScopeKeywords(keywords []string) *TaskQualityGrader {
+	g.vagueScopeKeywords = make([]string, 0, len(keywords))
+	for _, keyword := range keywords {
+		if keyword = strings.ToLower(strings.TrimSpace(keyword)); keyword != "" {
+			g.vagueScopeKeywords = append(g.vagueScopeKeywords, keyword)
+		}
+	}
+	return g
+}
+
+// hasVagueScope reports whether contentLower uses a vague-scope keyword without a measurable target
+func (g *TaskQualityGrader) hasVagueScope(contentLower string) bool {
+	for _, keyword := range g.vagueScopeKeywords {
+		rest := contentLower
+		for {
+			idx := strings.Index(rest, keyword)
+			if idx < 0 {
+				break
+			}
+			rest = rest[idx+len(keyword):]
+			if !measurableTargetPattern.MatchString(rest) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WithCriterionFloors sets a minimum score (0-100) per criterion, e.g. {"acceptance": 40}.
+// A criterion scoring below its floor fails the task even when the weighted total passes.
+// Criteria without a floor, or with a floor of zero, only count toward the total.
+func (g *TaskQualityGrader) WithCriterionFloors(floors map[string]float64) *TaskQualityGrader {
+	g.criterionFloors = make(map[string]float64, len(floors))
+	for criterion, floor := range floors {
+		if floor > 0 {
+			g.criterionFloors[strings.ToLower(strings.TrimSpace(criterion))] = floor
+		}
+	}
+	return g
+}
+
+// criteriaBelowFloor lists the criteria in details that scored below their floor, sorted by name,
+// e.g. "acceptance (10.0 < 40.0)"
+func (g *TaskQualityGrader) criteriaBelowFloor(details map[string]any) []string {
+	var below []string
+	for criterion, floor := range g.criterionFloors {
+		detail, ok := details[criterion].(map[string]any)
+		if !ok {
+			continue
+		}
+		if score, _ := detail["score"].(float64); score < floor {
+			below = append(below, fmt.Sprintf("%s (%.1f < %.1f)", criterion, score, floor))
+		}
+	}
+	sort.Strings(below)
+	return below
+}
+
+// floorNote formats the criteria below their floor as a sentence to append to a result message
+func floorNote(below []string) string {
+	if len(below) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" Below floor: %s.", strings.Join(below, ", "))
+}
+
+// WithModel sets the LLM model used for evaluation
+func (g *TaskQualityGrader) WithModel(model string) *TaskQualityGrader {
+	g.model = model
+	return g
+}
+
+// Model returns the LLM model used for evaluation
+func (g *TaskQualityGrader) Model() string {
+	return g.model
+}
+
+// WithRetry sets how often a transient LLM error (e.g. a 429 rate limit) is retried and the
+// backoff before the first retry, which doubles for each further retry. All attempts share the
+// grader's timeout.
+func (g *TaskQualityGrader) WithRetry(maxRetries int, baseBackoff time.Duration) *TaskQualityGrader {
+	if maxRetries < 0 {
+		maxRetries = 0
 	}
+	g.maxRetries = maxRetries
+	g.baseBackoff = baseBackoff
+	return g
+}
+
+// WithSelfConsistency makes LLM evaluation request k responses and use the median score of
+// each criterion, recording the spread of the k scores in the result details. Each response
+// retries transient errors on its own. k <= 1 uses a single response.
+func (g *TaskQualityGrader) WithSelfConsistency(k int) *TaskQualityGrader {
+	if k < 1 {
+		k = 1
+	}
+	g.selfConsistency = k
+	return g
 }
 
 // Grade evaluates task content against quality criteria
@@ -61,16 +247,27 @@ func (g *TaskQualityGrader) Grade(input GradeInput) (Result, error) {
 	}
 
 	// Generate summary message
-	message := g.generateMessage(totalScore, criteria)
+	below := g.criteriaBelowFloor(details)
+	message := g.generateMessage(totalScore, criteria, below)
 
 	return Result{
-		Passed:  totalScore >= g.passingScore,
-		Score:   totalScore,
-		Message: message,
-		Details: details,
+		Passed:        totalScore >= g.passingScore && len(below) == 0,
+		Score:         totalScore,
+		Message:       message,
+		Details:       details,
+		GraderVersion: g.Version(),
 	}, nil
 }
 
+// Version returns the version of the grading logic, bumped when heuristics change:
+// 1.1.0 made the vague-scope keywords configurable and exempts scopes with a measurable target
+// 1.2.0 made the acceptance-section markers configurable
+// 1.3.0 added self-consistency voting over repeated LLM gradings
+// 1.4.0 fails tasks scoring below a per-criterion floor
+func (g *TaskQualityGrader) Version() string {
+	return "1.4.0"
+}
+
 // evaluateCriteria performs stub evaluation of each criterion
 // TODO: Replace with LLM-based evaluation
 func (g *TaskQualityGrader) evaluateCriteria(content string) map[string]Criterion {
@@ -132,9 +329,7 @@ func (g *TaskQualityGrader) evaluateCriteria(content string) map[string]Criterio
 	acceptanceScore := 50.0
 	acceptanceFeedback := "Stub evaluation: Acceptance criteria not yet evaluated by LLM"
 
-	hasAcceptanceCriteria := strings.Contains(contentLower, "acceptance criteria") ||
-		strings.Contains(contentLower, "success criteria") ||
-		strings.Contains(contentLower, "requirements:")
+	hasAcceptanceCriteria := g.hasAcceptanceSection(contentLower)
 
 	// Count numbered items (potential acceptance criteria)
 	numberedItems := strings.Count(content, "1.") + strings.Count(content, "2.") + strings.Count(content, "3.")
@@ -168,11 +363,7 @@ func (g *TaskQualityGrader) evaluateCriteria(content string) map[string]Criterio
 		strings.Contains(contentLower, "technical details")
 
 	// Check for vague, open-ended language
-	hasVagueScope := strings.Contains(contentLower, "various") ||
-		strings.Contains(contentLower, "several") ||
-		strings.Contains(contentLower, "improve") ||
-		strings.Contains(contentLower, "better") ||
-		strings.Contains(contentLower, "make it")
+	hasVagueScope := g.hasVagueScope(contentLower)
 
 	contentLength := len(content)
 
@@ -230,9 +421,9 @@ func (g *TaskQualityGrader) evaluateCriteria(content string) map[string]Criterio
 	return criteria
 }
 
-// generateMessage creates a human-readable summary message
-func (g *TaskQualityGrader) generateMessage(score float64, criteria map[string]Criterion) string {
-	if score >= g.passingScore {
+// generateMessage creates a human-readable summary message, naming any criteria below their floor
+func (g *TaskQualityGrader) generateMessage(score float64, criteria map[string]Criterion, belowFloor []string) string {
+	if score >= g.passingScore && len(belowFloor) == 0 {
 		return fmt.Sprintf("Task quality evaluation passed with score %.1f/100. Note: Using stub evaluation; LLM-based grading not yet implemented.", score)
 	}
 
@@ -259,8 +450,8 @@ func (g *TaskQualityGrader) generateMessage(score float64, criteria map[string]C
 		suggestion = "Review and improve task specification"
 	}
 
-	return fmt.Sprintf("Task quality evaluation failed with score %.1f/100. Weakest area: %s (%.1f). Suggestion: %s. Note: Using stub evaluation; LLM-based grading not yet implemented.",
-		score, weakestName, weakestScore, suggestion)
+	return fmt.Sprintf("Task quality evaluation failed with score %.1f/100.%s Weakest area: %s (%.1f). Suggestion: %s. Note: Using stub evaluation; LLM-based grading not yet implemented.",
+		score, floorNote(belowFloor), weakestName, weakestScore, suggestion)
 }
 
 // buildPrompt constructs the LLM prompt for task quality evaluation
@@ -365,24 +556,49 @@ func (g *TaskQualityGrader) parseResponse(response string) (Result, error) {
 		}
 	}
 
-	passed := totalScore >= g.passingScore
-	message := fmt.Sprintf("Task quality evaluation: %s (score: %.1f/100).",
-		map[bool]string{true: "PASS", false: "FAIL"}[passed], totalScore)
+	below := g.criteriaBelowFloor(details)
+	passed := totalScore >= g.passingScore && len(below) == 0
+	message := fmt.Sprintf("Task quality evaluation: %s (score: %.1f/100).%s",
+		map[bool]string{true: "PASS", false: "FAIL"}[passed], totalScore, floorNote(below))
 
 	return Result{
-		Passed:  passed,
-		Score:   totalScore,
-		Message: message,
-		Details: details,
+		Passed:        passed,
+		Score:         totalScore,
+		Message:       message,
+		Details:       details,
+		GraderVersion: g.Version(),
 	}, nil
 }
 
-// gradeWithLLM performs LLM-based evaluation
+// gradeWithLLM performs LLM-based evaluation, aggregating several responses when self-consistency is enabled
 func (g *TaskQualityGrader) gradeWithLLM(taskContent string) (Result, error) {
 	if g.llmClient == nil {
 		return Result{}, errors.New("LLM client not initialized")
 	}
+	if g.selfConsistency <= 1 {
+		return g.gradeOnceWithLLM(taskContent)
+	}
 
+	results := make([]Result, 0, g.selfConsistency)
+	for i := 0; i < g.selfConsistency; i++ {
+		result, err := g.gradeOnceWithLLM(taskContent)
+		if err != nil {
+			return Result{}, fmt.Errorf("self-consistency response %d/%d: %w", i+1, g.selfConsistency, err)
+		}
+		results = append(results, result)
+	}
+
+	result := aggregateSelfConsistency(results, g.weights, g.passingScore)
+	below := g.criteriaBelowFloor(result.Details)
+	result.Passed = result.Passed && len(below) == 0
+	result.Message = fmt.Sprintf("Task quality evaluation: %s (median score: %.1f/100 over %d responses).%s",
+		map[bool]string{true: "PASS", false: "FAIL"}[result.Passed], result.Score, len(results), floorNote(below))
+	result.GraderVersion = g.Version()
+	return result, nil
+}
+
+// gradeOnceWithLLM evaluates the task with a single LLM response, retrying transient errors
+func (g *TaskQualityGrader) gradeOnceWithLLM(taskContent string) (Result, error) {
 	// Build prompt
 	prompt := g.buildPrompt(taskContent)
 
@@ -391,15 +607,56 @@ func (g *TaskQualityGrader) gradeWithLLM(taskContent string) (Result, error) {
 	defer cancel()
 
 	// Call LLM
-	response, err := g.llmClient.Complete(ctx, prompt, llm.WithModel("claude-haiku-4"))
+	response, attempts, err := g.completeWithRetry(ctx, prompt)
 	if err != nil {
 		// Handle timeout specifically
 		if errors.Is(err, context.DeadlineExceeded) {
-			return Result{}, fmt.Errorf("LLM request timed out after %v: %w", g.timeout, err)
+			return Result{}, fmt.Errorf("LLM request timed out after %v (attempts: %d): %w", g.timeout, attempts, err)
 		}
-		return Result{}, fmt.Errorf("LLM request failed: %w", err)
+		return Result{}, fmt.Errorf("LLM request failed (attempts: %d): %w", attempts, err)
 	}
 
 	// Parse response
 	return g.parseResponse(response)
 }
+
+// completeWithRetry calls the LLM, retrying transient errors with exponential backoff until
+// maxRetries is reached or ctx expires. It returns the number of attempts made.
+func (g *TaskQualityGrader) completeWithRetry(ctx context.Context, prompt string) (string, int, error) {
+	backoff := g.baseBackoff
+	attempts := 0
+	for {
+		attempts++
+		response, err := g.llmClient.Complete(ctx, prompt, llm.WithModel(g.model))
+		if err == nil {
+			return response, attempts, nil
+		}
+		if attempts > g.maxRetries || !isRetryableLLMError(err) {
+			return "", attempts, err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return "", attempts, fmt.Errorf("%w while waiting to retry: %v", ctx.Err(), err)
+		}
+		backoff *= 2
+	}
+}
+
+// isRetryableLLMError reports whether err looks transient, such as a rate limit or an
+// overloaded API; invalid requests and context errors are not retried
+func isRetryableLLMError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	msg := strings.ToLower(err.Error())
+	for _, marker := range []string{"429", "rate limit", "rate_limit", "overloaded", "529", "503"} {
+		if strings.Contains(msg, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -258,6 +258,84 @@ func TestTaskQualityGrader_VagueTaskFails(t *testing.T) {
 	}
 }
 
+func TestTaskQualityGrader_CustomVagueScopeKeywords(t *testing.T) {
+	content := `Improve the caching layer in the API gateway so repeated reads are served from memory.
+Technical details: add an LRU cache in front of the user repository.`
+
+	if got := NewTaskQualityGrader().evaluateCriteria(content)["scope"].Score; got != 30.0 {
+		t.Errorf("Expected default keywords to penalize 'improve' (scope 30), got %f", got)
+	}
+
+	grader := NewTaskQualityGrader().WithVagueScopeKeywords([]string{"various", " Several "})
+	if got := grader.evaluateCriteria(content)["scope"].Score; got == 30.0 {
+		t.Errorf("Expected custom keywords not to penalize 'improve', got scope %f", got)
+	}
+	if got := grader.evaluateCriteria("Clean up SEVERAL handlers in the API package.")["scope"].Score; got != 30.0 {
+		t.Errorf("Expected custom keyword 'several' to be matched case-insensitively, got scope %f", got)
+	}
+}
+
+func TestTaskQualityGrader_AcceptanceMarkers(t *testing.T) {
+	content := `Add rate limiting to the login endpoint.
+
+## Definition of Done
+- Requests above 10/minute per IP return 429
+- Limits are configurable`
+
+	grader := NewTaskQualityGrader()
+	if got := grader.evaluateCriteria(content)["acceptance"].Score; got != 70.0 {
+		t.Errorf("Expected 'Definition of Done' to count as acceptance criteria (score 70), got %f", got)
+	}
+
+	// Without the marker the same section scores as missing criteria
+	noMarkers := NewTaskQualityGrader().WithAcceptanceMarkers([]string{"acceptance criteria"})
+	if got := noMarkers.evaluateCriteria(content)["acceptance"].Score; got != 25.0 {
+		t.Errorf("Expected no acceptance section without the marker (score 25), got %f", got)
+	}
+
+	custom := NewTaskQualityGrader().WithAcceptanceMarkers([]string{" Verified By "})
+	if got := custom.evaluateCriteria("Fix the cache.\nVERIFIED BY: load test")["acceptance"].Score; got != 70.0 {
+		t.Errorf("Expected custom marker to match case-insensitively, got %f", got)
+	}
+
+	tests := []struct {
+		content string
+		want    bool
+	}{
+		{"ac: returns 200", true},
+		{"Notes\nAC: returns 200", true},
+		{"Use the mac: address", false},
+		{"Done when the job is green", true},
+	}
+	for _, tt := range tests {
+		if got := grader.hasAcceptanceSection(strings.ToLower(tt.content)); got != tt.want {
+			t.Errorf("hasAcceptanceSection(%q) = %v, want %v", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestTaskQualityGrader_VagueScopeMeasurableTarget(t *testing.T) {
+	grader := NewTaskQualityGrader()
+
+	tests := []struct {
+		name    string
+		content string
+		vague   bool
+	}{
+		{"measurable target", "Improve p95 latency of the search endpoint to under 200ms.", false},
+		{"no target", "Improve the search endpoint.", true},
+		{"target in a later sentence", "Improve the search endpoint. It handles 500 requests per second.", true},
+		{"one vague use among targeted ones", "Improve latency by 20%. Make it better.", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := grader.hasVagueScope(strings.ToLower(tt.content)); got != tt.vague {
+				t.Errorf("hasVagueScope(%q) = %v, want %v", tt.content, got, tt.vague)
+			}
+		})
+	}
+}
+
 func TestTaskQualityGrader_EmptyContentFails(t *testing.T) {
 	grader := NewTaskQualityGrader()
 
@@ -750,3 +828,177 @@ func (m *mockTaskQualityLLMClient) Complete(ctx context.Context, prompt string,
 
 	return m.response, nil
 }
+
+// sequenceTaskQualityLLMClient returns the queued errors in order, then the response
+type sequenceTaskQualityLLMClient struct {
+	errs     []error
+	response string
+	calls    int
+}
+
+func (m *sequenceTaskQualityLLMClient) Complete(ctx context.Context, prompt string, options ...llm.CompletionOption) (string, error) {
+	m.calls++
+	if m.calls <= len(m.errs) {
+		return "", m.errs[m.calls-1]
+	}
+	return m.response, nil
+}
+
+// TestTaskQualityGrader_GradeWithLLM_Retry tests retrying transient errors with backoff
+func TestTaskQualityGrader_GradeWithLLM_Retry(t *testing.T) {
+	validResponse := `CLARITY: 80
+CLARITY_FEEDBACK: Clear
+ACCEPTANCE: 80
+ACCEPTANCE_FEEDBACK: Defined
+SCOPE: 80
+SCOPE_FEEDBACK: Bounded
+ACTIONABILITY: 80
+ACTIONABILITY_FEEDBACK: Ready`
+	rateLimited := errors.New("429 Too Many Requests: rate limit exceeded")
+	taskContent := `# Test Task
+Description: A test task`
+
+	tests := []struct {
+		name        string
+		errs        []error
+		maxRetries  int
+		wantCalls   int
+		wantErr     string
+		wantSuccess bool
+	}{
+		{
+			name:        "rate limit then success",
+			errs:        []error{rateLimited, rateLimited},
+			maxRetries:  2,
+			wantCalls:   3,
+			wantSuccess: true,
+		},
+		{
+			name:       "retries exhausted",
+			errs:       []error{rateLimited, rateLimited, rateLimited},
+			maxRetries: 2,
+			wantCalls:  3,
+			wantErr:    "attempts: 3",
+		},
+		{
+			name:       "invalid request fails fast",
+			errs:       []error{errors.New("400 invalid_request_error: max_tokens too large")},
+			maxRetries: 2,
+			wantCalls:  1,
+			wantErr:    "attempts: 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := &sequenceTaskQualityLLMClient{errs: tt.errs, response: validResponse}
+			grader := NewTaskQualityGrader().WithRetry(tt.maxRetries, time.Millisecond)
+			grader.llmClient = mockClient
+
+			result, err := grader.gradeWithLLM(taskContent)
+
+			if mockClient.calls != tt.wantCalls {
+				t.Errorf("Expected %d calls, got %d", tt.wantCalls, mockClient.calls)
+			}
+			if tt.wantSuccess {
+				if err != nil {
+					t.Fatalf("Expected success, got %v", err)
+				}
+				if result.Score != 80 {
+					t.Errorf("Expected score 80, got %f", result.Score)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// TestTaskQualityGrader_GradeWithLLM_RetryRespectsTimeout tests that backoff stops at the timeout
+func TestTaskQualityGrader_GradeWithLLM_RetryRespectsTimeout(t *testing.T) {
+	mockClient := &sequenceTaskQualityLLMClient{errs: []error{errors.New("rate limit exceeded")}}
+	grader := NewTaskQualityGrader().WithRetry(3, time.Minute)
+	grader.llmClient = mockClient
+	grader.timeout = 50 * time.Millisecond
+
+	start := time.Now()
+	_, err := grader.gradeWithLLM("# Test Task")
+
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Expected retry loop to stop at the timeout, took %v", elapsed)
+	}
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Expected timeout error, got %v", err)
+	}
+	if mockClient.calls != 1 {
+		t.Errorf("Expected 1 call before the timeout, got %d", mockClient.calls)
+	}
+}
+
+// TestTaskQualityGrader_CriterionFloors tests that a criterion below its floor fails a task whose total passes
+func TestTaskQualityGrader_CriterionFloors(t *testing.T) {
+	response := `CLARITY: 100
+CLARITY_FEEDBACK: Clear
+ACCEPTANCE: 10
+ACCEPTANCE_FEEDBACK: No acceptance criteria
+SCOPE: 100
+SCOPE_FEEDBACK: Bounded
+ACTIONABILITY: 100
+ACTIONABILITY_FEEDBACK: Ready to start`
+
+	// Without floors the weighted total (73.0) passes
+	result, err := NewTaskQualityGrader().parseResponse(response)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !result.Passed || strings.Contains(result.Message, "Below floor") {
+		t.Fatalf("Expected a pass without floors, got %+v", result)
+	}
+
+	grader := NewTaskQualityGrader().WithCriterionFloors(map[string]float64{"acceptance": 40, "Scope": 50, "clarity": 0})
+	result, err = grader.parseResponse(response)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Passed {
+		t.Errorf("Expected a fail with acceptance below its floor, got score %.1f", result.Score)
+	}
+	if result.Score != 73.0 {
+		t.Errorf("Expected the floor to leave the score at 73.0, got %.1f", result.Score)
+	}
+	if want := "Task quality evaluation: FAIL (score: 73.0/100). Below floor: acceptance (10.0 < 40.0)."; result.Message != want {
+		t.Errorf("Message = %q, want %q", result.Message, want)
+	}
+
+	// The stub evaluation honors floors too
+	stub, err := NewTaskQualityGrader().WithCriterionFloors(map[string]float64{"clarity": 101}).Grade(GradeInput{Content: "Do something"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if stub.Passed || !strings.Contains(stub.Message, "Below floor: clarity (") {
+		t.Errorf("Expected stub evaluation to fail on the clarity floor, got %+v", stub)
+	}
+}
+
+// TestTaskQualityGrader_CriterionFloorsSelfConsistency tests that floors apply to the median of several responses
+func TestTaskQualityGrader_CriterionFloorsSelfConsistency(t *testing.T) {
+	grader := NewTaskQualityGrader().WithSelfConsistency(3).WithCriterionFloors(map[string]float64{"acceptance": 40})
+	grader.llmClient = &mockTaskQualityLLMClient{response: `CLARITY: 100
+CLARITY_FEEDBACK: Clear
+ACCEPTANCE: 10
+ACCEPTANCE_FEEDBACK: No acceptance criteria
+SCOPE: 100
+SCOPE_FEEDBACK: Bounded
+ACTIONABILITY: 100
+ACTIONABILITY_FEEDBACK: Ready to start`}
+
+	result, err := grader.gradeWithLLM("Task")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if result.Passed || !strings.HasSuffix(result.Message, "Below floor: acceptance (10.0 < 40.0).") {
+		t.Errorf("Expected a fail naming the acceptance floor, got %+v", result)
+	}
+}
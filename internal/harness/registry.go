@@ -18,11 +18,10 @@ func NewGraderRegistry() *GraderRegistry {
 		modelGraders: make(map[string]modelbased.Grader),
 	}
 
-	// Register code-based graders
-	registry.registerCodeGrader(codebased.NewFileExistsGrader())
-	registry.registerCodeGrader(codebased.NewTestExistsGrader())
-	registry.registerCodeGrader(codebased.NewEndpointExistsGrader())
-	registry.registerCodeGrader(codebased.NewTestCoverageGrader())
+	// Register code-based graders (each grader self-registers with the codebased package)
+	for _, grader := range codebased.All() {
+		registry.registerCodeGrader(grader)
+	}
 
 	// Register model-based graders
 	registry.registerModelGrader(modelbased.NewSpecComplianceGrader())
@@ -40,6 +40,41 @@ func TestGetCodeGrader_ValidGraders(t *testing.T) {
 			graderName: "test-coverage",
 			wantNil:    false,
 		},
+		{
+			name:       "skipped-tests grader exists",
+			graderName: "skipped-tests",
+			wantNil:    false,
+		},
+		{
+			name:       "debug-statements grader exists",
+			graderName: "debug-statements",
+			wantNil:    false,
+		},
+		{
+			name:       "flaky-tests grader exists",
+			graderName: "flaky-tests",
+			wantNil:    false,
+		},
+		{
+			name:       "sql-injection grader exists",
+			graderName: "sql-injection",
+			wantNil:    false,
+		},
+		{
+			name:       "doc-exists grader exists",
+			graderName: "doc-exists",
+			wantNil:    false,
+		},
+		{
+			name:       "migration-exists grader exists",
+			graderName: "migration-exists",
+			wantNil:    false,
+		},
+		{
+			name:       "test-ratio grader exists",
+			graderName: "test-ratio",
+			wantNil:    false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -31,6 +31,7 @@ type ClientOption func(*clientConfig)
 type clientConfig struct {
 	timeout    time.Duration
 	maxRetries int
+	apiKeyEnv  string
 }
 
 // CompletionOption is a function that configures a completion request
@@ -40,6 +41,7 @@ type CompletionOption func(*completionConfig)
 type completionConfig struct {
 	model     string
 	maxTokens int64
+	system    string
 }
 
 // Default configuration values
@@ -50,6 +52,9 @@ const (
 	defaultMaxTokens  = 4096
 )
 
+// DefaultAPIKeyEnv is the environment variable the API key is read from unless WithAPIKeyEnv names another
+const DefaultAPIKeyEnv = "ANTHROPIC_API_KEY"
+
 // WithTimeout sets the client timeout duration
 func WithTimeout(timeout time.Duration) ClientOption {
 	return func(c *clientConfig) {
@@ -64,6 +69,14 @@ func WithMaxRetries(maxRetries int) ClientOption {
 	}
 }
 
+// WithAPIKeyEnv sets the name of the environment variable holding the API key.
+// The key itself is resolved from the environment when the client is constructed.
+func WithAPIKeyEnv(name string) ClientOption {
+	return func(c *clientConfig) {
+		c.apiKeyEnv = name
+	}
+}
+
 // WithModel sets the model to use for completion
 func WithModel(model string) CompletionOption {
 	return func(c *completionConfig) {
@@ -78,18 +91,29 @@ func WithMaxTokens(maxTokens int64) CompletionOption {
 	}
 }
 
-// NewClient creates a new Anthropic API client with the specified options
-func NewClient(opts ...ClientOption) (Client, error) {
-	// Check for API key in environment
-	apiKey := os.Getenv("ANTHROPIC_API_KEY")
-	if apiKey == "" {
-		return nil, errors.New("ANTHROPIC_API_KEY environment variable is not set")
+// WithSystemPrompt sets the system prompt sent alongside the user prompt
+func WithSystemPrompt(system string) CompletionOption {
+	return func(c *completionConfig) {
+		c.system = system
+	}
+}
+
+// SystemPrompt returns the system prompt set by options, so other Client implementations can honor it
+func SystemPrompt(options ...CompletionOption) string {
+	config := &completionConfig{}
+	for _, opt := range options {
+		opt(config)
 	}
+	return config.system
+}
 
+// NewClient creates a new Anthropic API client with the specified options
+func NewClient(opts ...ClientOption) (Client, error) {
 	// Apply default configuration
 	config := &clientConfig{
 		timeout:    defaultTimeout,
 		maxRetries: defaultMaxRetries,
+		apiKeyEnv:  DefaultAPIKeyEnv,
 	}
 
 	// Apply custom options
@@ -97,6 +121,15 @@ func NewClient(opts ...ClientOption) (Client, error) {
 		opt(config)
 	}
 
+	// Check for API key in environment
+	if config.apiKeyEnv == "" {
+		return nil, errors.New("API key environment variable name cannot be empty")
+	}
+	apiKey := os.Getenv(config.apiKeyEnv)
+	if apiKey == "" {
+		return nil, fmt.Errorf("%s environment variable is not set", config.apiKeyEnv)
+	}
+
 	// Create SDK client options
 	sdkOpts := []option.RequestOption{
 		option.WithAPIKey(apiKey),
@@ -185,10 +218,9 @@ func (c *anthropicClient) Complete(ctx context.Context, prompt string, options .
 	return "", fmt.Errorf("failed after %d attempts: %w", maxAttempts, lastErr)
 }
 
-// makeAPICall performs the actual API call to Anthropic
-func (c *anthropicClient) makeAPICall(ctx context.Context, prompt string, config *completionConfig) (string, error) {
-	// Build the message request
-	message, err := c.client.Messages.New(ctx, anthropic.MessageNewParams{
+// messageParams builds the message request for a prompt and completion configuration
+func messageParams(prompt string, config *completionConfig) anthropic.MessageNewParams {
+	params := anthropic.MessageNewParams{
 		Model:     anthropic.Model(config.model),
 		MaxTokens: config.maxTokens,
 		Messages: []anthropic.MessageParam{
@@ -203,8 +235,16 @@ func (c *anthropicClient) makeAPICall(ctx context.Context, prompt string, config
 				},
 			},
 		},
-	})
+	}
+	if config.system != "" {
+		params.System = []anthropic.TextBlockParam{{Text: config.system}}
+	}
+	return params
+}
 
+// makeAPICall performs the actual API call to Anthropic
+func (c *anthropicClient) makeAPICall(ctx context.Context, prompt string, config *completionConfig) (string, error) {
+	message, err := c.client.Messages.New(ctx, messageParams(prompt, config))
 	if err != nil {
 		return "", fmt.Errorf("API call failed: %w", err)
 	}
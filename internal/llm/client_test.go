@@ -126,6 +126,45 @@ func TestNewClientWithOptions(t *testing.T) {
 	}
 }
 
+// TestNewClientWithAPIKeyEnv tests resolving the API key from a custom environment variable
+func TestNewClientWithAPIKeyEnv(t *testing.T) {
+	const envVar = "KAIZEN_TEST_LLM_KEY"
+
+	t.Run("env var set", func(t *testing.T) {
+		t.Setenv(envVar, "sk-ant-test-key-123")
+
+		client, err := NewClient(WithAPIKeyEnv(envVar))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if client == nil {
+			t.Error("Expected non-nil client")
+		}
+	})
+
+	t.Run("env var unset", func(t *testing.T) {
+		t.Setenv(envVar, "")
+		os.Unsetenv(envVar)
+
+		client, err := NewClient(WithAPIKeyEnv(envVar))
+		if err == nil {
+			t.Fatal("Expected error for unset env var, got nil")
+		}
+		if client != nil {
+			t.Error("Expected nil client on error")
+		}
+		if !strings.Contains(err.Error(), envVar+" environment variable is not set") {
+			t.Errorf("Expected error naming %s, got: %v", envVar, err)
+		}
+	})
+
+	t.Run("empty env var name", func(t *testing.T) {
+		if _, err := NewClient(WithAPIKeyEnv("")); err == nil {
+			t.Error("Expected error for empty env var name, got nil")
+		}
+	})
+}
+
 // TestClientTimeout tests that the client respects timeout configuration
 func TestClientTimeout(t *testing.T) {
 	// Save and restore env var
@@ -353,3 +392,27 @@ func TestRetryConfiguration(t *testing.T) {
 		})
 	}
 }
+
+// TestMessageParamsSystemPrompt tests that WithSystemPrompt sets the request's system prompt
+func TestMessageParamsSystemPrompt(t *testing.T) {
+	config := &completionConfig{model: defaultModel, maxTokens: defaultMaxTokens}
+	if params := messageParams("prompt", config); len(params.System) != 0 {
+		t.Errorf("Expected no system prompt by default, got %v", params.System)
+	}
+
+	WithSystemPrompt("Grade against the rubric")(config)
+	params := messageParams("prompt", config)
+	if len(params.System) != 1 || params.System[0].Text != "Grade against the rubric" {
+		t.Errorf("Expected system prompt in request, got %v", params.System)
+	}
+	if len(params.Messages) != 1 || params.Messages[0].Content[0].OfText.Text != "prompt" {
+		t.Errorf("Expected user prompt in request, got %v", params.Messages)
+	}
+
+	if got := SystemPrompt(WithModel("m"), WithSystemPrompt("rubric")); got != "rubric" {
+		t.Errorf("Expected SystemPrompt to return rubric, got %q", got)
+	}
+	if got := SystemPrompt(WithModel("m")); got != "" {
+		t.Errorf("Expected empty SystemPrompt by default, got %q", got)
+	}
+}
@@ -0,0 +1,95 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// FakeResponse is a canned response a FakeClient returns for prompts matching Pattern
+type FakeResponse struct {
+	// Pattern is a regular expression matched against the prompt, e.g. "(?i)auth" or "CLEAR_INSTRUCTIONS:"
+	Pattern string `yaml:"pattern"`
+	// Response is returned verbatim, so it must use the format the calling grader parses
+	Response string `yaml:"response"`
+}
+
+// fakeRule is a FakeResponse with its compiled pattern
+type fakeRule struct {
+	pattern  *regexp.Regexp
+	response string
+}
+
+// FakeClient is an offline Client returning canned responses, for running graders end-to-end
+// without an API key. It is safe for concurrent use.
+type FakeClient struct {
+	rules []fakeRule
+}
+
+// DefaultFakeResponses are passing responses in the formats of the skill clarity, task quality
+// and spec compliance graders, matched by the response format each grader's prompt asks for
+func DefaultFakeResponses() []FakeResponse {
+	return []FakeResponse{
+		{
+			Pattern: `CLEAR_INSTRUCTIONS:`,
+			Response: `CLEAR_INSTRUCTIONS: 80
+CLEAR_INSTRUCTIONS_FEEDBACK: Fake response: instructions are clear
+ACTIONABLE_STEPS: 80
+ACTIONABLE_STEPS_FEEDBACK: Fake response: steps are actionable
+GOOD_EXAMPLES: 80
+GOOD_EXAMPLES_FEEDBACK: Fake response: examples are helpful
+APPROPRIATE_SCOPE: 80
+APPROPRIATE_SCOPE_FEEDBACK: Fake response: scope is focused`,
+		},
+		{
+			Pattern: `ACTIONABILITY:`,
+			Response: `CLARITY: 80
+CLARITY_FEEDBACK: Fake response: description is clear
+ACCEPTANCE: 80
+ACCEPTANCE_FEEDBACK: Fake response: acceptance criteria are testable
+SCOPE: 80
+SCOPE_FEEDBACK: Fake response: scope is bounded
+ACTIONABILITY: 80
+ACTIONABILITY_FEEDBACK: Fake response: work can begin`,
+		},
+		{
+			Pattern: `VERDICT:`,
+			Response: `VERDICT: PASS
+SCORE: 80
+REASONING: Fake response: implementation matches the specification
+ISSUES: None`,
+		},
+	}
+}
+
+// NewFakeClient creates a FakeClient that answers each prompt with the first response whose
+// pattern matches it. Prompts matching no pattern return an error.
+func NewFakeClient(responses []FakeResponse) (*FakeClient, error) {
+	client := &FakeClient{rules: make([]fakeRule, 0, len(responses))}
+	for i, r := range responses {
+		pattern, err := regexp.Compile(r.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("fake response %d: invalid pattern %q: %w", i+1, r.Pattern, err)
+		}
+		client.rules = append(client.rules, fakeRule{pattern: pattern, response: r.Response})
+	}
+	return client, nil
+}
+
+// Complete returns the canned response for the prompt; the system prompt and options are ignored
+func (c *FakeClient) Complete(ctx context.Context, prompt string, options ...CompletionOption) (string, error) {
+	if prompt == "" {
+		return "", errors.New("prompt cannot be empty")
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	for _, rule := range c.rules {
+		if rule.pattern.MatchString(prompt) {
+			return rule.response, nil
+		}
+	}
+	return "", errors.New("fake LLM client: no canned response matches the prompt")
+}
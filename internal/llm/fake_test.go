@@ -0,0 +1,85 @@
+package llm
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// TestFakeClientComplete verifies the first matching pattern's response is returned
+func TestFakeClientComplete(t *testing.T) {
+	client, err := NewFakeClient([]FakeResponse{
+		{Pattern: `(?i)deploy`, Response: "deploy response"},
+		{Pattern: `.`, Response: "fallback response"},
+	})
+	if err != nil {
+		t.Fatalf("NewFakeClient failed: %v", err)
+	}
+
+	tests := []struct {
+		prompt string
+		want   string
+	}{
+		{prompt: "How do I DEPLOY this?", want: "deploy response"},
+		{prompt: "Something else", want: "fallback response"},
+	}
+	for _, tt := range tests {
+		got, err := client.Complete(context.Background(), tt.prompt, WithSystemPrompt("ignored"))
+		if err != nil {
+			t.Fatalf("Complete(%q) failed: %v", tt.prompt, err)
+		}
+		if got != tt.want {
+			t.Errorf("Complete(%q) = %q, want %q", tt.prompt, got, tt.want)
+		}
+	}
+}
+
+// TestFakeClientErrors verifies invalid patterns, unmatched and empty prompts, and cancelled contexts fail
+func TestFakeClientErrors(t *testing.T) {
+	if _, err := NewFakeClient([]FakeResponse{{Pattern: "(", Response: "x"}}); err == nil {
+		t.Error("Expected an error for an invalid pattern")
+	}
+
+	client, err := NewFakeClient([]FakeResponse{{Pattern: "^known$", Response: "x"}})
+	if err != nil {
+		t.Fatalf("NewFakeClient failed: %v", err)
+	}
+	if _, err := client.Complete(context.Background(), "unknown"); err == nil || !strings.Contains(err.Error(), "no canned response") {
+		t.Errorf("Expected a no canned response error, got %v", err)
+	}
+	if _, err := client.Complete(context.Background(), ""); err == nil {
+		t.Error("Expected an error for an empty prompt")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Complete(ctx, "known"); err == nil {
+		t.Error("Expected an error for a cancelled context")
+	}
+}
+
+// TestDefaultFakeResponses verifies each grader's response format has a default
+func TestDefaultFakeResponses(t *testing.T) {
+	client, err := NewFakeClient(DefaultFakeResponses())
+	if err != nil {
+		t.Fatalf("NewFakeClient failed: %v", err)
+	}
+
+	tests := []struct {
+		prompt string
+		want   string
+	}{
+		{prompt: "Respond with:\nCLEAR_INSTRUCTIONS: <score 0-100>", want: "GOOD_EXAMPLES: 80"},
+		{prompt: "Respond with:\nCLARITY: <score>\nACTIONABILITY: <score 0-100>", want: "ACCEPTANCE: 80"},
+		{prompt: "Respond with:\nVERDICT: PASS or FAIL", want: "VERDICT: PASS"},
+	}
+	for _, tt := range tests {
+		got, err := client.Complete(context.Background(), tt.prompt)
+		if err != nil {
+			t.Fatalf("Complete(%q) failed: %v", tt.prompt, err)
+		}
+		if !strings.Contains(got, tt.want) {
+			t.Errorf("Complete(%q) = %q, want it to contain %q", tt.prompt, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import "sort"
+
+// Percentile returns the p-th percentile (0-100) of values using linear
+// interpolation between closest ranks (the method used by NumPy's default
+// and Excel's PERCENTILE.INC).
+//
+// The rank is computed as r = p/100 * (n-1) over the sorted values; the result
+// interpolates between the values at floor(r) and ceil(r).
+//
+// Semantics:
+//   - Percentile([]float64{10, 20, 30, 40}, 50) → 25
+//   - Percentile([]float64{10, 20, 30, 40}, 25) → 17.5
+//   - Percentile([]float64{}, 50) → 0
+//
+// The input slice is not modified. p is clamped to [0, 100].
+func Percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	if p <= 0 {
+		return sorted[0]
+	}
+	if p >= 100 {
+		return sorted[len(sorted)-1]
+	}
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(rank)
+	if lower+1 >= len(sorted) {
+		return sorted[lower]
+	}
+	fraction := rank - float64(lower)
+	return sorted[lower] + fraction*(sorted[lower+1]-sorted[lower])
+}
@@ -0,0 +1,42 @@
+package metrics
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPercentile(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		p        float64
+		expected float64
+	}{
+		{name: "empty slice", values: []float64{}, p: 50, expected: 0},
+		{name: "single value", values: []float64{42}, p: 75, expected: 42},
+		{name: "median of even count interpolates", values: []float64{40, 10, 30, 20}, p: 50, expected: 25},
+		{name: "median of odd count", values: []float64{50, 10, 30}, p: 50, expected: 30},
+		{name: "p25 interpolates", values: []float64{10, 20, 30, 40}, p: 25, expected: 17.5},
+		{name: "p75 interpolates", values: []float64{10, 20, 30, 40}, p: 75, expected: 32.5},
+		{name: "p0 is min", values: []float64{30, 10, 20}, p: 0, expected: 10},
+		{name: "p100 is max", values: []float64{30, 10, 20}, p: 100, expected: 30},
+		{name: "out of range p is clamped", values: []float64{30, 10, 20}, p: 150, expected: 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Percentile(tt.values, tt.p)
+			if math.Abs(got-tt.expected) > 1e-9 {
+				t.Errorf("Percentile(%v, %v) = %v, want %v", tt.values, tt.p, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPercentileDoesNotModifyInput(t *testing.T) {
+	values := []float64{3, 1, 2}
+	Percentile(values, 50)
+	if values[0] != 3 || values[1] != 1 || values[2] != 2 {
+		t.Errorf("Percentile modified its input: %v", values)
+	}
+}
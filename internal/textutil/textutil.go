@@ -0,0 +1,11 @@
+// Package textutil holds text helpers shared by the graders and the report parsers
+package textutil
+
+import "strings"
+
+// NormalizeLineEndings strips a leading UTF-8 BOM and converts CRLF line endings to LF,
+// so line-anchored patterns match files authored on Windows
+func NormalizeLineEndings(content string) string {
+	content = strings.TrimPrefix(content, "\ufeff")
+	return strings.ReplaceAll(content, "\r\n", "\n")
+}
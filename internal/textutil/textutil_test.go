@@ -0,0 +1,25 @@
+package textutil
+
+import "testing"
+
+// TestNormalizeLineEndings verifies the BOM is stripped and CRLF becomes LF
+func TestNormalizeLineEndings(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", "a\nb\n", "a\nb\n"},
+		{"crlf", "a\r\nb\r\n", "a\nb\n"},
+		{"bom and crlf", "\ufeff# Title\r\nbody\r\n", "# Title\nbody\n"},
+		{"bom only at start", "a\ufeffb", "a\ufeffb"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeLineEndings(tt.in); got != tt.want {
+				t.Errorf("NormalizeLineEndings(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,23 @@
+// Package version holds the build information of the kaizen binary, injected at build time:
+//
+//	go build -ldflags "-X github.com/srstomp/kaizen/internal/version.Version=v1.2.0 \
+//	  -X github.com/srstomp/kaizen/internal/version.Commit=$(git rev-parse --short HEAD) \
+//	  -X github.com/srstomp/kaizen/internal/version.Date=$(date -u +%Y-%m-%dT%H:%M:%SZ)" ./cmd/kaizen
+package version
+
+import "fmt"
+
+// Build information; overridden with -ldflags -X, otherwise the defaults of a local build
+var (
+	// Version is the release version, e.g. v1.2.0
+	Version = "dev"
+	// Commit is the git commit the binary was built from
+	Commit = "unknown"
+	// Date is the build date, e.g. 2026-01-27T18:29:07Z
+	Date = "unknown"
+)
+
+// String returns the build information on one line, e.g. "kaizen v1.2.0 (commit abc1234, built 2026-01-27T18:29:07Z)"
+func String() string {
+	return fmt.Sprintf("kaizen %s (commit %s, built %s)", Version, Commit, Date)
+}
@@ -0,0 +1,18 @@
+package version
+
+import "testing"
+
+// TestString verifies the defaults and injected values are printed
+func TestString(t *testing.T) {
+	if got, want := String(), "kaizen dev (commit unknown, built unknown)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+
+	origVersion, origCommit, origDate := Version, Commit, Date
+	defer func() { Version, Commit, Date = origVersion, origCommit, origDate }()
+	Version, Commit, Date = "v1.2.0", "abc1234", "2026-01-27T18:29:07Z"
+
+	if got, want := String(), "kaizen v1.2.0 (commit abc1234, built 2026-01-27T18:29:07Z)"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}